@@ -0,0 +1,19 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"log"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+)
+
+func (s *Server) CheckCloneSupport(ctx context.Context, req *idl.CheckCloneSupportRequest) (*idl.CheckCloneSupportReply, error) {
+	log.Printf("starting %s", idl.Substep_check_clone_support)
+
+	err := upgrade.CheckCloneSupport(req.GetDirectories())
+	return &idl.CheckCloneSupportReply{}, err
+}
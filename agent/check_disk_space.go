@@ -22,3 +22,14 @@ func (s *Server) CheckDiskSpace(ctx context.Context, in *idl.CheckSegmentDiskSpa
 
 	return &idl.CheckDiskSpaceReply{Usages: usage}, nil
 }
+
+func (s *Server) GetDiskUsage(ctx context.Context, in *idl.GetDiskUsageRequest) (*idl.GetDiskUsageReply, error) {
+	log.Printf("starting to get disk usage of %q", in.GetDirs())
+
+	usages, err := disk.Usages(disk.Local, in.GetDirs()...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &idl.GetDiskUsageReply{Usages: usages}, nil
+}
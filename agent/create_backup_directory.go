@@ -28,3 +28,19 @@ func (s *Server) CreateBackupDirectory(ctx context.Context, req *idl.CreateBacku
 
 	return &idl.CreateBackupDirectoryReply{}, nil
 }
+
+func (s *Server) MoveBackupDirectory(ctx context.Context, req *idl.MoveBackupDirectoryRequest) (*idl.MoveBackupDirectoryReply, error) {
+	log.Printf("starting move of backup directory %q to %q", req.GetSource(), req.GetTarget())
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return &idl.MoveBackupDirectoryReply{}, err
+	}
+
+	err = hub.MoveBackupDirectory(req.GetSource(), req.GetTarget())
+	if err != nil {
+		return &idl.MoveBackupDirectoryReply{}, fmt.Errorf("on host %q: %w", hostname, err)
+	}
+
+	return &idl.MoveBackupDirectoryReply{}, nil
+}
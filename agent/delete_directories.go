@@ -10,7 +10,6 @@ import (
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/upgrade"
-	"github.com/greenplum-db/gpupgrade/utils"
 )
 
 var DeleteDirectoriesFunc = upgrade.DeleteDirectories
@@ -18,9 +17,13 @@ var DeleteDirectoriesFunc = upgrade.DeleteDirectories
 func (s *Server) DeleteStateDirectory(ctx context.Context, in *idl.DeleteStateDirectoryRequest) (*idl.DeleteStateDirectoryReply, error) {
 	log.Printf("starting %s", idl.Substep_delete_segment_statedirs)
 
+	s.mutex.Lock()
+	stateDir := s.stateDir
+	s.mutex.Unlock()
+
 	// pass an empty []string to avoid check for any pre-existing files,
 	// this call might come in before any stateDir files are created
-	err := DeleteDirectoriesFunc([]string{utils.GetStateDir()}, []string{}, step.DevNullStream)
+	err := DeleteDirectoriesFunc([]string{stateDir}, []string{}, step.DevNullStream)
 	return &idl.DeleteStateDirectoryReply{}, err
 }
 
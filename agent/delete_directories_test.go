@@ -6,6 +6,7 @@ package agent_test
 import (
 	"context"
 	"errors"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -104,6 +105,38 @@ func TestDeleteStateDirectory(t *testing.T) {
 		}
 	})
 
+	t.Run("deletes the state directory the agent was started with for a named cluster", func(t *testing.T) {
+		agent.DeleteDirectoriesFunc = upgrade.DeleteDirectories
+		defer func() { agent.DeleteDirectoriesFunc = upgrade.DeleteDirectories }()
+
+		resetClusterName := testutils.SetEnv(t, utils.ClusterNameEnv, "prod")
+		defer resetClusterName()
+
+		// Starting the agent sets GPUPGRADE_HOME so restore it afterwards.
+		resetHome := testutils.SetEnv(t, "GPUPGRADE_HOME", stateDir)
+		defer resetHome()
+
+		namespacedStateDir := filepath.Join(stateDir, ".gpupgrade-prod")
+
+		agentServer := agent.New()
+		go func() {
+			_ = agentServer.Start(testutils.MustGetPort(t), namespacedStateDir, false)
+		}()
+		defer agentServer.Stop()
+
+		exists, err := doesPathEventuallyExist(t, namespacedStateDir)
+		if err != nil || !exists {
+			t.Fatalf("expected state directory %q to be created: %v", namespacedStateDir, err)
+		}
+
+		_, err = agentServer.DeleteStateDirectory(context.Background(), &idl.DeleteStateDirectoryRequest{})
+		if err != nil {
+			t.Fatalf("DeleteStateDirectory returned error %+v", err)
+		}
+
+		testutils.PathMustNotExist(t, namespacedStateDir)
+	})
+
 	t.Run("returns error on failure", func(t *testing.T) {
 		expected := errors.New("error")
 		agent.DeleteDirectoriesFunc = func(directories []string, requiredPaths []string, streams step.OutStreams) error {
@@ -0,0 +1,15 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+)
+
+func (s *Server) GetHostInfo(ctx context.Context, req *idl.GetHostInfoRequest) (*idl.GetHostInfoReply, error) {
+	return &idl.GetHostInfoReply{CpuCount: int32(runtime.NumCPU())}, nil
+}
@@ -0,0 +1,71 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"log"
+	"os"
+	"sync"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+	"github.com/greenplum-db/gpupgrade/utils/rsync"
+)
+
+// RelayCoordinatorBackup copies the coordinator backup this host received to
+// the target hosts in parallel, such that the hub does not need to copy it to
+// every host itself.
+func (s *Server) RelayCoordinatorBackup(ctx context.Context, req *idl.RelayCoordinatorBackupRequest) (*idl.RelayCoordinatorBackupReply, error) {
+	log.Printf("starting relay coordinator backup to %d hosts", len(req.GetTargets()))
+
+	tablespaceDir := utils.GetTablespaceBackupDir(req.GetBackupDir())
+	hasTablespaces, err := upgrade.PathExist(tablespaceDir)
+	if err != nil {
+		return &idl.RelayCoordinatorBackupReply{}, err
+	}
+
+	var wg sync.WaitGroup
+	errs := make(chan error, len(req.GetTargets()))
+
+	for _, target := range req.GetTargets() {
+		target := target
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			err := relay(utils.GetCoordinatorPostUpgradeBackupDir(req.GetBackupDir()), target.GetHost(), utils.GetCoordinatorPostUpgradeBackupDir(target.GetBackupDir()))
+			if err == nil && hasTablespaces {
+				err = relay(tablespaceDir, target.GetHost(), utils.GetTablespaceBackupDir(target.GetBackupDir()))
+			}
+
+			if err != nil {
+				errs <- xerrors.Errorf("relay coordinator backup to host %s: %w", target.GetHost(), err)
+			}
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+
+	for e := range errs {
+		err = errorlist.Append(err, e)
+	}
+
+	return &idl.RelayCoordinatorBackupReply{}, err
+}
+
+func relay(sourceDir string, host string, destinationDir string) error {
+	return rsync.Rsync(
+		rsync.WithSources(sourceDir+string(os.PathSeparator)),
+		rsync.WithDestinationHost(host),
+		rsync.WithDestination(destinationDir),
+		rsync.WithOptions("--archive", "--compress", "--delete", "--stats"),
+	)
+}
@@ -29,4 +29,25 @@ func TestRenameDirectories(t *testing.T) {
 			t.Errorf("returned error %#v, want %#v", err, expected)
 		}
 	})
+
+	t.Run("archives only when requested", func(t *testing.T) {
+		renamed, archived := false, false
+		agent.RenameDirectories = func(source, target string) error {
+			renamed = true
+			return nil
+		}
+		agent.ArchiveDirectory = func(source, target string) error {
+			archived = true
+			return nil
+		}
+
+		_, err := agentServer.RenameDirectories(context.Background(), &idl.RenameDirectoriesRequest{Dirs: []*idl.RenameDirectories{{ArchiveOnly: true}}})
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		if renamed || !archived {
+			t.Errorf("got renamed %t archived %t want false and true", renamed, archived)
+		}
+	})
 }
@@ -27,3 +27,21 @@ func (s *Server) RestorePrimariesPgControl(ctx context.Context, in *idl.RestoreP
 
 	return &idl.RestorePgControlReply{}, mErr
 }
+
+func (s *Server) FindLinkedDataDirectories(ctx context.Context, in *idl.FindLinkedDataDirectoriesRequest) (*idl.FindLinkedDataDirectoriesReply, error) {
+	log.Print("starting find linked data directories")
+
+	var linked []string
+	for _, dir := range in.GetDatadirs() {
+		isLinked, err := upgrade.IsLinked(dir)
+		if err != nil {
+			return &idl.FindLinkedDataDirectoriesReply{}, err
+		}
+
+		if isLinked {
+			linked = append(linked, dir)
+		}
+	}
+
+	return &idl.FindLinkedDataDirectoriesReply{Datadirs: linked}, nil
+}
@@ -17,6 +17,8 @@ import (
 	"google.golang.org/grpc/reflection"
 
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/tracing"
+	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/daemon"
 	"github.com/greenplum-db/gpupgrade/utils/logger"
 )
@@ -26,15 +28,25 @@ type Server struct {
 	gRPCserver  *grpc.Server
 	listener    net.Listener
 	stoppedChan chan struct{}
+
+	// stateDir is the state directory the agent was started with. It is
+	// already namespaced by the cluster name and must be used as is rather
+	// than calling utils.GetStateDir which would namespace it again.
+	stateDir string
 }
 
 func New() *Server {
 	return &Server{
 		stoppedChan: make(chan struct{}, 1),
+		stateDir:    utils.GetStateDir(),
 	}
 }
 
 func (s *Server) Start(port int, stateDir string, daemonize bool) error {
+	s.mutex.Lock()
+	s.stateDir = stateDir
+	s.mutex.Unlock()
+
 	err := createStateDirectory(stateDir)
 	if err != nil {
 		return err
@@ -49,7 +61,10 @@ func (s *Server) Start(port int, stateDir string, daemonize bool) error {
 		defer logger.WritePanics()
 		return handler(ctx, req)
 	}
-	gRPCserver := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
+	gRPCserver := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptor, tracing.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(tracing.StreamServerInterceptor),
+	)
 
 	s.mutex.Lock()
 	s.gRPCserver = gRPCserver
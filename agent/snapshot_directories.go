@@ -0,0 +1,30 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"log"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/upgrade/snapshot"
+)
+
+func (s *Server) SnapshotDirectories(ctx context.Context, in *idl.SnapshotDirectoriesRequest) (*idl.SnapshotDirectoriesReply, error) {
+	log.Printf("starting %s snapshot directories", in.GetAction())
+
+	provider, err := snapshot.NewProvider(in.GetSettings(), in.GetBackupDir())
+	if err != nil {
+		return &idl.SnapshotDirectoriesReply{}, err
+	}
+
+	stream := &step.BufferedStreams{}
+	err = snapshot.Run(stream, provider, in.GetAction(), in.GetDirectories())
+	if stream.StdoutBuf.Len() > 0 || stream.StderrBuf.Len() > 0 {
+		log.Printf("%s snapshot directories stdout: %q stderr: %q", in.GetAction(), stream.StdoutBuf.String(), stream.StderrBuf.String())
+	}
+
+	return &idl.SnapshotDirectoriesReply{}, err
+}
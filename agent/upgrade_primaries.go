@@ -16,6 +16,7 @@ import (
 
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/tracing"
 	"github.com/greenplum-db/gpupgrade/upgrade"
 	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
@@ -25,7 +26,7 @@ import (
 func (s *Server) UpgradePrimaries(ctx context.Context, req *idl.UpgradePrimariesRequest) (*idl.UpgradePrimariesReply, error) {
 	log.Printf("starting %s", req.GetAction())
 
-	err := upgradePrimariesInParallel(req.GetOpts())
+	err := upgradePrimariesInParallel(ctx, req.GetOpts())
 	if err != nil {
 		return &idl.UpgradePrimariesReply{}, err
 	}
@@ -33,7 +34,7 @@ func (s *Server) UpgradePrimaries(ctx context.Context, req *idl.UpgradePrimaries
 	return &idl.UpgradePrimariesReply{}, nil
 }
 
-func upgradePrimariesInParallel(opts []*idl.PgOptions) error {
+func upgradePrimariesInParallel(ctx context.Context, opts []*idl.PgOptions) error {
 	host, err := utils.System.Hostname()
 	if err != nil {
 		return err
@@ -47,7 +48,11 @@ func upgradePrimariesInParallel(opts []*idl.PgOptions) error {
 		go func(host string, opt *idl.PgOptions) {
 			defer wg.Done()
 
-			errs <- upgradePrimarySegment(host, opt)
+			_, span := tracing.Start(ctx, "pg_upgrade", tracing.KindInternal, tracing.Int(tracing.ContentIDKey, int(opt.GetContentID())))
+			err := upgradePrimarySegment(host, opt)
+			span.End(err)
+
+			errs <- err
 		}(host, opt)
 	}
 
@@ -0,0 +1,52 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"errors"
+	"io"
+	"log"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+func (s *Server) VerifyCoordinatorBackup(stream idl.Agent_VerifyCoordinatorBackupServer) error {
+	log.Printf("starting %s", idl.Substep_verify_master_backup)
+
+	var backupDir string
+	var dataDirectory, tablespaces []*idl.ManifestEntry
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+
+		if req.GetBackupDir() != "" {
+			backupDir = req.GetBackupDir()
+		}
+
+		dataDirectory = append(dataDirectory, req.GetDataDirectory()...)
+		tablespaces = append(tablespaces, req.GetTablespaces()...)
+	}
+
+	var err error
+	err = errorlist.Append(err, upgrade.VerifyManifest(utils.GetCoordinatorPostUpgradeBackupDir(backupDir), dataDirectory))
+
+	if len(tablespaces) > 0 {
+		err = errorlist.Append(err, upgrade.VerifyManifest(utils.GetTablespaceBackupDir(backupDir), tablespaces))
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return stream.SendAndClose(&idl.VerifyCoordinatorBackupReply{})
+}
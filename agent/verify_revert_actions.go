@@ -0,0 +1,21 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package agent
+
+import (
+	"context"
+	"log"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+)
+
+func (s *Server) VerifyRevertActions(ctx context.Context, in *idl.VerifyRevertActionsRequest) (*idl.VerifyRevertActionsReply, error) {
+	log.Print("starting verify revert actions")
+
+	actions := in.GetActions()
+	upgrade.VerifyRevertActions(actions)
+
+	return &idl.VerifyRevertActionsReply{Actions: actions}, nil
+}
@@ -360,6 +360,59 @@ __gpupgrade_handle_word()
     __gpupgrade_handle_word
 }
 
+_gpupgrade_add-mirrors_help()
+{
+    last_command="gpupgrade_add-mirrors_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_add-mirrors()
+{
+    last_command="gpupgrade_add-mirrors"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--?")
+    flags+=("-?")
+    local_nonpersistent_flags+=("--?")
+    local_nonpersistent_flags+=("-?")
+    flags+=("--verbose")
+    flags+=("-v")
+    local_nonpersistent_flags+=("--verbose")
+    local_nonpersistent_flags+=("-v")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
 _gpupgrade_apply_help()
 {
     last_command="gpupgrade_apply_help"
@@ -374,6 +427,8 @@ _gpupgrade_apply_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -399,6 +454,16 @@ _gpupgrade_apply()
     flags+=("-?")
     local_nonpersistent_flags+=("--?")
     local_nonpersistent_flags+=("-?")
+    flags+=("--continue-on-error")
+    local_nonpersistent_flags+=("--continue-on-error")
+    flags+=("--databases=")
+    two_word_flags+=("--databases")
+    local_nonpersistent_flags+=("--databases")
+    local_nonpersistent_flags+=("--databases=")
+    flags+=("--exclude-databases=")
+    two_word_flags+=("--exclude-databases")
+    local_nonpersistent_flags+=("--exclude-databases")
+    local_nonpersistent_flags+=("--exclude-databases=")
     flags+=("--gphome=")
     two_word_flags+=("--gphome")
     local_nonpersistent_flags+=("--gphome")
@@ -407,6 +472,10 @@ _gpupgrade_apply()
     two_word_flags+=("--input-dir")
     local_nonpersistent_flags+=("--input-dir")
     local_nonpersistent_flags+=("--input-dir=")
+    flags+=("--jobs=")
+    two_word_flags+=("--jobs")
+    local_nonpersistent_flags+=("--jobs")
+    local_nonpersistent_flags+=("--jobs=")
     flags+=("--phase=")
     two_word_flags+=("--phase")
     local_nonpersistent_flags+=("--phase")
@@ -415,6 +484,123 @@ _gpupgrade_apply()
     two_word_flags+=("--port")
     local_nonpersistent_flags+=("--port")
     local_nonpersistent_flags+=("--port=")
+    flags+=("--resume")
+    local_nonpersistent_flags+=("--resume")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_cleanup_help()
+{
+    last_command="gpupgrade_cleanup_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_cleanup()
+{
+    last_command="gpupgrade_cleanup"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--?")
+    flags+=("-?")
+    local_nonpersistent_flags+=("--?")
+    local_nonpersistent_flags+=("-?")
+    flags+=("--gphome=")
+    two_word_flags+=("--gphome")
+    local_nonpersistent_flags+=("--gphome")
+    local_nonpersistent_flags+=("--gphome=")
+    flags+=("--port=")
+    two_word_flags+=("--port")
+    local_nonpersistent_flags+=("--port")
+    local_nonpersistent_flags+=("--port=")
+    flags+=("--upgrade-id=")
+    two_word_flags+=("--upgrade-id")
+    local_nonpersistent_flags+=("--upgrade-id")
+    local_nonpersistent_flags+=("--upgrade-id=")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_flag+=("--gphome=")
+    must_have_one_flag+=("--port=")
+    must_have_one_flag+=("--upgrade-id=")
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_config_set_help()
+{
+    last_command="gpupgrade_config_set_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_config_set()
+{
+    last_command="gpupgrade_config_set"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--?")
+    flags+=("-?")
+    local_nonpersistent_flags+=("--?")
+    local_nonpersistent_flags+=("-?")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -435,6 +621,8 @@ _gpupgrade_config_show_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -460,6 +648,14 @@ _gpupgrade_config_show()
     flags+=("-?")
     local_nonpersistent_flags+=("--?")
     local_nonpersistent_flags+=("-?")
+    flags+=("--cluster=")
+    two_word_flags+=("--cluster")
+    local_nonpersistent_flags+=("--cluster")
+    local_nonpersistent_flags+=("--cluster=")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
     flags+=("--source-gphome")
     local_nonpersistent_flags+=("--source-gphome")
     flags+=("--target-datadir")
@@ -470,6 +666,57 @@ _gpupgrade_config_show()
     local_nonpersistent_flags+=("--target-port")
     flags+=("--upgrade-id")
     local_nonpersistent_flags+=("--upgrade-id")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_config_validate_help()
+{
+    last_command="gpupgrade_config_validate_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_config_validate()
+{
+    last_command="gpupgrade_config_validate"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--?")
+    flags+=("-?")
+    local_nonpersistent_flags+=("--?")
+    local_nonpersistent_flags+=("-?")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -483,7 +730,9 @@ _gpupgrade_config()
     command_aliases=()
 
     commands=()
+    commands+=("set")
     commands+=("show")
+    commands+=("validate")
 
     flags=()
     two_word_flags=()
@@ -491,6 +740,8 @@ _gpupgrade_config()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -511,6 +762,8 @@ _gpupgrade_execute_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -546,6 +799,8 @@ _gpupgrade_execute()
     flags+=("-v")
     local_nonpersistent_flags+=("--verbose")
     local_nonpersistent_flags+=("-v")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -566,6 +821,8 @@ _gpupgrade_finalize_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -591,10 +848,14 @@ _gpupgrade_finalize()
     flags+=("-?")
     local_nonpersistent_flags+=("--?")
     local_nonpersistent_flags+=("-?")
+    flags+=("--defer-mirrors")
+    local_nonpersistent_flags+=("--defer-mirrors")
     flags+=("--verbose")
     flags+=("-v")
     local_nonpersistent_flags+=("--verbose")
     local_nonpersistent_flags+=("-v")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -615,6 +876,8 @@ _gpupgrade_generate_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -640,10 +903,24 @@ _gpupgrade_generate()
     flags+=("-?")
     local_nonpersistent_flags+=("--?")
     local_nonpersistent_flags+=("-?")
+    flags+=("--databases=")
+    two_word_flags+=("--databases")
+    local_nonpersistent_flags+=("--databases")
+    local_nonpersistent_flags+=("--databases=")
+    flags+=("--diff")
+    local_nonpersistent_flags+=("--diff")
+    flags+=("--exclude-databases=")
+    two_word_flags+=("--exclude-databases")
+    local_nonpersistent_flags+=("--exclude-databases")
+    local_nonpersistent_flags+=("--exclude-databases=")
     flags+=("--gphome=")
     two_word_flags+=("--gphome")
     local_nonpersistent_flags+=("--gphome")
     local_nonpersistent_flags+=("--gphome=")
+    flags+=("--jobs=")
+    two_word_flags+=("--jobs")
+    local_nonpersistent_flags+=("--jobs")
+    local_nonpersistent_flags+=("--jobs=")
     flags+=("--output-dir=")
     two_word_flags+=("--output-dir")
     local_nonpersistent_flags+=("--output-dir")
@@ -652,6 +929,8 @@ _gpupgrade_generate()
     two_word_flags+=("--port")
     local_nonpersistent_flags+=("--port")
     local_nonpersistent_flags+=("--port=")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -672,6 +951,111 @@ _gpupgrade_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_history_help()
+{
+    last_command="gpupgrade_history_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_history_list()
+{
+    last_command="gpupgrade_history_list"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_history_show()
+{
+    last_command="gpupgrade_history_show"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_history()
+{
+    last_command="gpupgrade_history"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+    commands+=("list")
+    commands+=("show")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--?")
+    flags+=("-?")
+    local_nonpersistent_flags+=("--?")
+    local_nonpersistent_flags+=("-?")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -692,6 +1076,8 @@ _gpupgrade_initialize_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -721,6 +1107,14 @@ _gpupgrade_initialize()
     two_word_flags+=("--agent-port")
     local_nonpersistent_flags+=("--agent-port")
     local_nonpersistent_flags+=("--agent-port=")
+    flags+=("--copy-depth=")
+    two_word_flags+=("--copy-depth")
+    local_nonpersistent_flags+=("--copy-depth")
+    local_nonpersistent_flags+=("--copy-depth=")
+    flags+=("--copy-fan-out=")
+    two_word_flags+=("--copy-fan-out")
+    local_nonpersistent_flags+=("--copy-fan-out")
+    local_nonpersistent_flags+=("--copy-fan-out=")
     flags+=("--disk-free-ratio=")
     two_word_flags+=("--disk-free-ratio")
     local_nonpersistent_flags+=("--disk-free-ratio")
@@ -739,6 +1133,14 @@ _gpupgrade_initialize()
     two_word_flags+=("--hub-port")
     local_nonpersistent_flags+=("--hub-port")
     local_nonpersistent_flags+=("--hub-port=")
+    flags+=("--master-pg-upgrade-jobs=")
+    two_word_flags+=("--master-pg-upgrade-jobs")
+    local_nonpersistent_flags+=("--master-pg-upgrade-jobs")
+    local_nonpersistent_flags+=("--master-pg-upgrade-jobs=")
+    flags+=("--metrics-port=")
+    two_word_flags+=("--metrics-port")
+    local_nonpersistent_flags+=("--metrics-port")
+    local_nonpersistent_flags+=("--metrics-port=")
     flags+=("--mode=")
     two_word_flags+=("--mode")
     local_nonpersistent_flags+=("--mode")
@@ -753,6 +1155,30 @@ _gpupgrade_initialize()
     local_nonpersistent_flags+=("--pg-upgrade-jobs=")
     flags+=("--pg-upgrade-verbose")
     local_nonpersistent_flags+=("--pg-upgrade-verbose")
+    flags+=("--primary-pg-upgrade-jobs=")
+    two_word_flags+=("--primary-pg-upgrade-jobs")
+    local_nonpersistent_flags+=("--primary-pg-upgrade-jobs")
+    local_nonpersistent_flags+=("--primary-pg-upgrade-jobs=")
+    flags+=("--rsync-jobs=")
+    two_word_flags+=("--rsync-jobs")
+    local_nonpersistent_flags+=("--rsync-jobs")
+    local_nonpersistent_flags+=("--rsync-jobs=")
+    flags+=("--snapshot-command=")
+    two_word_flags+=("--snapshot-command")
+    local_nonpersistent_flags+=("--snapshot-command")
+    local_nonpersistent_flags+=("--snapshot-command=")
+    flags+=("--snapshot-delete-command=")
+    two_word_flags+=("--snapshot-delete-command")
+    local_nonpersistent_flags+=("--snapshot-delete-command")
+    local_nonpersistent_flags+=("--snapshot-delete-command=")
+    flags+=("--snapshot-provider=")
+    two_word_flags+=("--snapshot-provider")
+    local_nonpersistent_flags+=("--snapshot-provider")
+    local_nonpersistent_flags+=("--snapshot-provider=")
+    flags+=("--snapshot-restore-command=")
+    two_word_flags+=("--snapshot-restore-command")
+    local_nonpersistent_flags+=("--snapshot-restore-command")
+    local_nonpersistent_flags+=("--snapshot-restore-command=")
     flags+=("--source-gphome=")
     two_word_flags+=("--source-gphome")
     local_nonpersistent_flags+=("--source-gphome")
@@ -775,6 +1201,12 @@ _gpupgrade_initialize()
     flags+=("-v")
     local_nonpersistent_flags+=("--verbose")
     local_nonpersistent_flags+=("-v")
+    flags+=("--webhook-urls=")
+    two_word_flags+=("--webhook-urls")
+    local_nonpersistent_flags+=("--webhook-urls")
+    local_nonpersistent_flags+=("--webhook-urls=")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -795,6 +1227,65 @@ _gpupgrade_kill-services()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_report_help()
+{
+    last_command="gpupgrade_report_help"
+
+    command_aliases=()
+
+    commands=()
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
+
+    must_have_one_flag=()
+    must_have_one_noun=()
+    noun_aliases=()
+}
+
+_gpupgrade_report()
+{
+    last_command="gpupgrade_report"
+
+    command_aliases=()
+
+    commands=()
+    commands+=("help")
+
+    flags=()
+    two_word_flags=()
+    local_nonpersistent_flags=()
+    flags_with_completion=()
+    flags_completion=()
+
+    flags+=("--?")
+    flags+=("-?")
+    local_nonpersistent_flags+=("--?")
+    local_nonpersistent_flags+=("-?")
+    flags+=("--format=")
+    two_word_flags+=("--format")
+    local_nonpersistent_flags+=("--format")
+    local_nonpersistent_flags+=("--format=")
+    flags+=("--output=")
+    two_word_flags+=("--output")
+    local_nonpersistent_flags+=("--output")
+    local_nonpersistent_flags+=("--output=")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -815,6 +1306,8 @@ _gpupgrade_restart-services()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -835,6 +1328,8 @@ _gpupgrade_revert_help()
     flags_with_completion=()
     flags_completion=()
 
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -860,10 +1355,16 @@ _gpupgrade_revert()
     flags+=("-?")
     local_nonpersistent_flags+=("--?")
     local_nonpersistent_flags+=("-?")
+    flags+=("--dry-run")
+    local_nonpersistent_flags+=("--dry-run")
+    flags+=("--partial")
+    local_nonpersistent_flags+=("--partial")
     flags+=("--verbose")
     flags+=("-v")
     local_nonpersistent_flags+=("--verbose")
     local_nonpersistent_flags+=("-v")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -888,6 +1389,8 @@ _gpupgrade_version()
     two_word_flags+=("--format")
     local_nonpersistent_flags+=("--format")
     local_nonpersistent_flags+=("--format=")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
 
     must_have_one_flag=()
     must_have_one_noun=()
@@ -901,14 +1404,18 @@ _gpupgrade_root_command()
     command_aliases=()
 
     commands=()
+    commands+=("add-mirrors")
     commands+=("apply")
+    commands+=("cleanup")
     commands+=("config")
     commands+=("execute")
     commands+=("finalize")
     commands+=("generate")
     commands+=("help")
+    commands+=("history")
     commands+=("initialize")
     commands+=("kill-services")
+    commands+=("report")
     commands+=("restart-services")
     commands+=("revert")
     commands+=("version")
@@ -923,6 +1430,8 @@ _gpupgrade_root_command()
     flags+=("-?")
     local_nonpersistent_flags+=("--?")
     local_nonpersistent_flags+=("-?")
+    flags+=("--cluster-name=")
+    two_word_flags+=("--cluster-name")
     flags+=("--format=")
     two_word_flags+=("--format")
     local_nonpersistent_flags+=("--format")
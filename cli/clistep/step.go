@@ -5,10 +5,12 @@ package clistep
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -19,6 +21,7 @@ import (
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/substeps"
+	"github.com/greenplum-db/gpupgrade/tracing"
 	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
 	"github.com/greenplum-db/gpupgrade/utils/stopwatch"
@@ -29,10 +32,11 @@ const StepsFileName = "steps.json"
 const nextActionRunRevertText = "If you would like to return the cluster to its original state, please run \"gpupgrade revert\".\n"
 
 var additionalNextActions = map[idl.Step]string{
-	idl.Step_initialize: nextActionRunRevertText,
-	idl.Step_execute:    nextActionRunRevertText,
-	idl.Step_finalize:   "",
-	idl.Step_revert:     "",
+	idl.Step_initialize:  nextActionRunRevertText,
+	idl.Step_execute:     nextActionRunRevertText,
+	idl.Step_finalize:    "",
+	idl.Step_revert:      "",
+	idl.Step_add_mirrors: "",
 }
 
 type Step struct {
@@ -43,11 +47,18 @@ type Step struct {
 	streams      step.OutStreams
 	verbose      bool
 	stepTimer    *stopwatch.Stopwatch
+	span         *tracing.Span
+	deactivate   func()
 	lastSubstep  idl.Substep
 	err          error
 }
 
 func NewStep(currentStep idl.Step, stepName string, stepStore StepStore, substepStore step.SubstepStore, streams step.OutStreams, verbose bool) (*Step, error) {
+	// The step span is the root of the trace of the step across the hub and
+	// agents.
+	_, span := tracing.Start(context.Background(), currentStep.String(), tracing.KindInternal)
+	span.SetBaggage(tracing.StepKey, currentStep.String())
+
 	return &Step{
 		stepName:     stepName,
 		step:         currentStep,
@@ -56,6 +67,8 @@ func NewStep(currentStep idl.Step, stepName string, stepStore StepStore, substep
 		streams:      streams,
 		verbose:      verbose,
 		stepTimer:    stopwatch.Start(),
+		span:         span,
+		deactivate:   tracing.Activate(span),
 	}, nil
 }
 
@@ -189,12 +202,14 @@ func (s *Step) run(substep idl.Substep, f func(streams step.OutStreams) error, a
 	}
 
 	substepTimer := stopwatch.Start()
+	endSpan := step.TraceSubstep(s.step, substep)
 	defer func() {
-		pErr := s.printDuration(substeps.SubstepDescriptions[substep].OutputText, substepTimer.Stop().String())
-		if pErr != nil {
+		if pErr := s.printDuration(substeps.SubstepDescriptions[substep].OutputText, substepTimer.Stop().String()); pErr != nil {
 			err = errorlist.Append(err, pErr)
-			return
 		}
+
+		step.RecordDuration(s.substepStore, s.step, substep, substepTimer, err)
+		endSpan(err)
 	}()
 
 	if pErr := s.printStatus(substep, idl.Status_running); pErr != nil {
@@ -244,6 +259,8 @@ func (s *Step) Complete(completedText string) error {
 		status = idl.Status_failed
 	}
 
+	s.endSpan()
+
 	if s.stepStore != nil {
 		if wErr := s.stepStore.Write(s.step, status); wErr != nil {
 			s.err = errorlist.Append(s.err, wErr)
@@ -262,12 +279,14 @@ func (s *Step) Complete(completedText string) error {
 
 		genericNextAction := fmt.Sprintf("Please address the above issue and run \"gpupgrade %s\" again.\n"+additionalNextActions[s.step], strings.ToLower(s.stepName))
 
+		nextAction := genericNextAction
 		var nextActionErr utils.NextActionErr
 		if errors.As(s.Err(), &nextActionErr) {
-			return utils.NewNextActionErr(s.Err(), nextActionErr.NextAction+"\n\n"+genericNextAction)
+			nextAction = nextActionErr.NextAction + "\n\n" + genericNextAction
 		}
 
-		return utils.NewNextActionErr(s.Err(), genericNextAction)
+		s.recordIssue(nextAction)
+		return utils.NewNextActionErr(s.Err(), nextAction)
 	}
 
 	if s.verbose {
@@ -282,6 +301,35 @@ func (s *Step) Complete(completedText string) error {
 	return nil
 }
 
+func (s *Step) endSpan() {
+	if s.span == nil {
+		return
+	}
+
+	s.deactivate()
+	s.span.End(s.Err())
+}
+
+// recordIssue records the error and next actions for the upgrade report if
+// the store supports it. Failing to record is logged rather than masking the
+// error of the step.
+func (s *Step) recordIssue(nextAction string) {
+	recorder, ok := s.substepStore.(step.IssueRecorder)
+	if !ok {
+		return
+	}
+
+	err := recorder.RecordIssue(step.Issue{
+		Time:       time.Now(),
+		Step:       s.step.String(),
+		Error:      s.Err().Error(),
+		NextAction: strings.TrimSpace(nextAction),
+	})
+	if err != nil {
+		log.Printf("recording issue of step %q: %v", s.step, err)
+	}
+}
+
 func (s *Step) printStatus(substep idl.Substep, status idl.Status) error {
 	if substep == s.lastSubstep {
 		// For the same substep reset the cursor to overwrite the current status.
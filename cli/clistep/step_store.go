@@ -108,6 +108,8 @@ To return the cluster to its original state, run "gpupgrade revert".`
 
 const RunFinalize = `To proceed with the upgrade, run "gpupgrade finalize".`
 
+const RunAddMirrors = `The mirrors and standby were deferred. To add them run "gpupgrade add-mirrors".`
+
 const RunRevert = `Revert is in progress. Please continue by running "gpupgrade revert".`
 
 // conditions expected to have been met for the current step. The next action
@@ -115,6 +117,7 @@ const RunRevert = `Revert is in progress. Please continue by running "gpupgrade
 var validate = map[idl.Step][]stepCondition{
 	idl.Step_initialize: {
 		{idl.Step_revert, (*StepStoreFileStore).HasStepNotStarted, RunRevert},
+		{idl.Step_add_mirrors, (*StepStoreFileStore).HasStepNotStarted, RunAddMirrors},
 		{idl.Step_finalize, (*StepStoreFileStore).HasStepNotStarted, RunFinalize},
 		{idl.Step_execute, (*StepStoreFileStore).HasStepNotStarted, RunExecute},
 	},
@@ -132,6 +135,9 @@ var validate = map[idl.Step][]stepCondition{
 		{idl.Step_initialize, (*StepStoreFileStore).HasStepStarted, RunInitialize},
 		{idl.Step_finalize, (*StepStoreFileStore).HasStepNotStarted, RunFinalize},
 	},
+	idl.Step_add_mirrors: {
+		{idl.Step_finalize, (*StepStoreFileStore).HasStepCompleted, RunFinalize},
+	},
 }
 
 func (s *StepStoreFileStore) ValidateStep(currentStep idl.Step) (err error) {
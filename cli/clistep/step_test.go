@@ -515,6 +515,26 @@ func TestSubstep(t *testing.T) {
 		}
 	})
 
+	t.Run("records the substep duration when printing it fails", func(t *testing.T) {
+		d := BufferStandardDescriptors(t)
+		defer d.Close()
+
+		substepStore := &MockSubstepStore{}
+		streams := failingStreams{err: errors.New("write failed")}
+		st, err := clistep.NewStep(idl.Step_initialize, idl.Step_initialize.String(), &MockStepStore{}, substepStore, streams, false)
+		if err != nil {
+			t.Errorf("unexpected err %#v", err)
+		}
+
+		st.Run(idl.Substep_saving_source_cluster_config, func(streams step.OutStreams) error {
+			return nil
+		})
+
+		if len(substepStore.Durations) != 1 || substepStore.Durations[0].Substep != idl.Substep_saving_source_cluster_config.String() {
+			t.Errorf("got durations %+v want one for %q", substepStore.Durations, idl.Substep_saving_source_cluster_config)
+		}
+	})
+
 	t.Run("the step returns next actions when a substep fails", func(t *testing.T) {
 		st, err := clistep.NewStep(idl.Step_initialize, idl.Step_initialize.String(), &MockStepStore{}, &MockSubstepStore{}, step.NewLogStdStreams(false), false)
 		if err != nil {
@@ -801,8 +821,26 @@ func (s *MockStepStore) HasStatus(step idl.Step, check func(status idl.Status) b
 }
 
 type MockSubstepStore struct {
-	Status   idl.Status
-	WriteErr error
+	Status    idl.Status
+	WriteErr  error
+	Durations []step.SubstepDuration
+}
+
+func (t *MockSubstepStore) RecordDuration(duration step.SubstepDuration) error {
+	t.Durations = append(t.Durations, duration)
+	return nil
+}
+
+type failingStreams struct {
+	err error
+}
+
+func (f failingStreams) Stdout() io.Writer {
+	return &testutils.FailingWriter{Err: f.err}
+}
+
+func (f failingStreams) Stderr() io.Writer {
+	return &testutils.FailingWriter{Err: f.err}
 }
 
 func (t *MockSubstepStore) Read(_ idl.Step, substep idl.Substep) (idl.Status, error) {
@@ -0,0 +1,300 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os/exec"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/disk"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+// HostArchive is an archive on a host.
+type HostArchive struct {
+	Host string
+	upgrade.Archive
+}
+
+var archivesCommand = exec.Command
+
+// XXX: for internal testing only
+func SetArchivesCommand(command exectest.Command) {
+	archivesCommand = command
+}
+
+// XXX: for internal testing only
+func ResetArchivesCommand() {
+	archivesCommand = exec.Command
+}
+
+// Cleanup finds the source data directories and tablespace directories that
+// finalize archived for the upgrade ID on all hosts of the upgraded cluster,
+// and deletes them after confirmation. It does not need the hub or the state
+// directory, rather the upgraded cluster must be running.
+func Cleanup(streams step.OutStreams, nonInteractive bool, reader *bufio.Reader, gphome string, port int, upgradeID string) (err error) {
+	db, err := bootstrapConnectionFunc(idl.ClusterDestination_target, gphome, port)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := db.Close(); cErr != nil {
+			err = errorlist.Append(err, cErr)
+		}
+	}()
+
+	cluster, err := greenplum.ClusterFromDB(db, gphome, idl.ClusterDestination_target)
+	if err != nil {
+		return xerrors.Errorf("retrieve upgraded cluster configuration: %w", err)
+	}
+
+	hostSegments, err := ArchivedSegments(&cluster, upgradeID)
+	if err != nil {
+		return err
+	}
+
+	archives, err := HostArchives(cluster.CoordinatorHostname(), hostSegments, cluster.Version.Major, false)
+	if err != nil {
+		return err
+	}
+
+	if len(archives) == 0 {
+		fmt.Fprintf(streams.Stdout(), "No archived directories found for upgrade ID %s.\n", upgradeID)
+		return nil
+	}
+
+	if err := WriteArchives(streams.Stdout(), archives); err != nil {
+		return err
+	}
+
+	if failed := failedArchives(archives); failed > 0 {
+		return xerrors.Errorf("%d of %d archived directories failed verification. Nothing has been deleted.", failed, len(archives))
+	}
+
+	if !nonInteractive {
+		proceed, err := confirmCleanup(streams.Stdout(), reader)
+		if err != nil {
+			return err
+		}
+
+		if !proceed {
+			fmt.Fprintln(streams.Stdout(), "Canceling...")
+			return nil
+		}
+	}
+
+	archives, err = HostArchives(cluster.CoordinatorHostname(), hostSegments, cluster.Version.Major, true)
+	if err != nil {
+		return err
+	}
+
+	if failed := failedArchives(archives); failed > 0 {
+		if err := WriteArchives(streams.Stdout(), archives); err != nil {
+			return err
+		}
+
+		return xerrors.Errorf("failed to delete %d of %d archived directories", failed, len(archives))
+	}
+
+	fmt.Fprintf(streams.Stdout(), "\nDeleted %d archived directories for upgrade ID %s.\n", len(archives), upgradeID)
+	return nil
+}
+
+// ArchivedSegments returns the segments of each host with the path of the
+// data directory archived by finalize for the upgrade ID. This mirrors how
+// finalize names the archive from the intermediate data directory.
+func ArchivedSegments(cluster *greenplum.Cluster, upgradeID string) (map[string][]upgrade.ArchivedSegment, error) {
+	segPrefix, err := greenplum.GetCoordinatorSegPrefix(cluster.CoordinatorDataDir())
+	if err != nil {
+		return nil, err
+	}
+
+	hostSegments := make(map[string][]upgrade.ArchivedSegment)
+	for _, seg := range cluster.SelectSegments(func(*greenplum.SegConfig) bool { return true }) {
+		hostSegments[seg.Hostname] = append(hostSegments[seg.Hostname], upgrade.ArchivedSegment{
+			DataDir:        seg.DataDir,
+			ArchiveDataDir: upgrade.TempDataDir(seg.DataDir, segPrefix, upgradeID) + upgrade.OldSuffix,
+		})
+	}
+
+	return hostSegments, nil
+}
+
+// HostArchives finds, and optionally deletes, the archives on each host in
+// parallel. The coordinator host is handled locally, and all other hosts by
+// running gpupgrade over ssh.
+func HostArchives(coordinatorHost string, hostSegments map[string][]upgrade.ArchivedSegment, targetMajorVersion uint64, deleteArchives bool) ([]HostArchive, error) {
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var archives []HostArchive
+	errs := make(chan error, len(hostSegments))
+
+	for host, segments := range hostSegments {
+		wg.Add(1)
+		go func(host string, segments []upgrade.ArchivedSegment) {
+			defer wg.Done()
+
+			request := upgrade.ArchivesRequest{Segments: segments, TargetMajorVersion: targetMajorVersion, Delete: deleteArchives}
+
+			var found []upgrade.Archive
+			var err error
+			if host == coordinatorHost {
+				found = upgrade.Archives(request)
+			} else {
+				found, err = remoteArchives(host, request)
+			}
+
+			if err != nil {
+				errs <- xerrors.Errorf("host %s: %w", host, err)
+				return
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			for _, archive := range found {
+				archives = append(archives, HostArchive{Host: host, Archive: archive})
+			}
+		}(host, segments)
+	}
+
+	wg.Wait()
+	close(errs)
+
+	var err error
+	for e := range errs {
+		err = errorlist.Append(err, e)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(archives, func(i, j int) bool {
+		if archives[i].Host != archives[j].Host {
+			return archives[i].Host < archives[j].Host
+		}
+
+		return archives[i].Path < archives[j].Path
+	})
+
+	return archives, nil
+}
+
+func remoteArchives(host string, request upgrade.ArchivesRequest) ([]upgrade.Archive, error) {
+	gpupgradePath, err := utils.GetGpupgradePath()
+	if err != nil {
+		return nil, xerrors.Errorf("getting gpupgrade binary path: %w", err)
+	}
+
+	input, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	cmd := archivesCommand("ssh", "-q", host, fmt.Sprintf(`bash -c "%s cleanup-archives"`, gpupgradePath))
+	cmd.Stdin = bytes.NewReader(input)
+
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	log.Printf("Executing: %q", cmd.String())
+	output, err := cmd.Output()
+	if err != nil {
+		return nil, xerrors.Errorf("%q failed with %q: %w", cmd.String(), stderr.String(), err)
+	}
+
+	var archives []upgrade.Archive
+	if err := json.Unmarshal(output, &archives); err != nil {
+		return nil, xerrors.Errorf("parse archives: %w", err)
+	}
+
+	return archives, nil
+}
+
+// WriteArchives writes the archives of each host and their sizes.
+func WriteArchives(w io.Writer, archives []HostArchive) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "HOST\tARCHIVED DIRECTORY\tSIZE\tRECLAIMABLE\tVERIFICATION")
+
+	var total, reclaimable int64
+	for _, archive := range archives {
+		verification := "ok"
+		if archive.Error != "" {
+			verification = archive.Error
+		}
+
+		kind := "data"
+		if archive.Tablespace {
+			kind = "tablespace"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s (%s)\t%s\t%s\t%s\n", archive.Host, archive.Path, kind,
+			formatSize(archive.Bytes), formatSize(archive.ReclaimableBytes), verification)
+
+		total += archive.Bytes
+		reclaimable += archive.ReclaimableBytes
+	}
+
+	fmt.Fprintf(tw, "\t%s\t%s\t%s\t\n", "total", formatSize(total), formatSize(reclaimable))
+
+	return tw.Flush()
+}
+
+func formatSize(bytes int64) string {
+	if bytes < 1024 {
+		return fmt.Sprintf("%d B", bytes)
+	}
+
+	return disk.FormatBytes(uint64(bytes) / 1024)
+}
+
+func failedArchives(archives []HostArchive) int {
+	failed := 0
+	for _, archive := range archives {
+		if archive.Error != "" {
+			failed++
+		}
+	}
+
+	return failed
+}
+
+func confirmCleanup(w io.Writer, reader *bufio.Reader) (bool, error) {
+	for {
+		fmt.Fprint(w, `
+Reclaimable excludes files hard linked with the upgraded cluster in link mode.
+The archived directories cannot be recovered once deleted.
+
+Delete the archived directories? [y/n]: `)
+
+		input, err := reader.ReadString('\n')
+		if err != nil {
+			return false, err
+		}
+
+		switch strings.ToLower(strings.TrimSpace(input)) {
+		case "y":
+			return true, nil
+		case "n":
+			return false, nil
+		}
+	}
+}
@@ -0,0 +1,123 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders_test
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+)
+
+func RemoteArchives() {
+	os.Stdout.WriteString(`[{"path":"/data/dbfast1/seg.ABC.1.old","bytes":2048,"reclaimableBytes":1024}]`)
+}
+
+func init() {
+	exectest.RegisterMains(
+		RemoteArchives,
+	)
+}
+
+func TestArchivedSegments(t *testing.T) {
+	cluster := MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "cdw", DataDir: "/data/qddir/seg-1", Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: -1, Hostname: "scdw", DataDir: "/data/standby", Role: greenplum.MirrorRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg0", Role: greenplum.PrimaryRole},
+		{DbID: 4, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast_mirror1/seg0", Role: greenplum.MirrorRole},
+	})
+
+	hostSegments, err := commanders.ArchivedSegments(cluster, "ABC")
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	expected := map[string][]upgrade.ArchivedSegment{
+		"cdw":  {{DataDir: "/data/qddir/seg-1", ArchiveDataDir: "/data/qddir/seg.ABC.-1.old"}},
+		"scdw": {{DataDir: "/data/standby", ArchiveDataDir: "/data/standby.ABC.old"}},
+		"sdw1": {
+			{DataDir: "/data/dbfast1/seg0", ArchiveDataDir: "/data/dbfast1/seg.ABC.0.old"},
+			{DataDir: "/data/dbfast_mirror1/seg0", ArchiveDataDir: "/data/dbfast_mirror1/seg.ABC.0.old"},
+		},
+	}
+
+	if !reflect.DeepEqual(hostSegments, expected) {
+		t.Errorf("got %v want %v", hostSegments, expected)
+	}
+}
+
+func TestHostArchives(t *testing.T) {
+	t.Run("finds the archives on remote hosts over ssh", func(t *testing.T) {
+		var args []string
+		commanders.SetArchivesCommand(exectest.NewCommandWithVerifier(RemoteArchives, func(name string, arg ...string) {
+			args = append([]string{name}, arg...)
+		}))
+		defer commanders.ResetArchivesCommand()
+
+		hostSegments := map[string][]upgrade.ArchivedSegment{
+			"sdw1": {{DataDir: "/data/dbfast1/seg1", ArchiveDataDir: "/data/dbfast1/seg.ABC.1.old"}},
+		}
+
+		archives, err := commanders.HostArchives("cdw", hostSegments, 7, false)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := []commanders.HostArchive{
+			{Host: "sdw1", Archive: upgrade.Archive{Path: "/data/dbfast1/seg.ABC.1.old", Bytes: 2048, ReclaimableBytes: 1024}},
+		}
+		if !reflect.DeepEqual(archives, expected) {
+			t.Errorf("got %+v want %+v", archives, expected)
+		}
+
+		if len(args) != 4 || args[0] != "ssh" || args[2] != "sdw1" || !strings.HasSuffix(args[3], `cleanup-archives"`) {
+			t.Errorf("unexpected command %q", args)
+		}
+	})
+
+	t.Run("errors when finding the archives on a host fails", func(t *testing.T) {
+		commanders.SetArchivesCommand(exectest.NewCommand(FailedMain))
+		defer commanders.ResetArchivesCommand()
+
+		hostSegments := map[string][]upgrade.ArchivedSegment{
+			"sdw1": {{DataDir: "/data/dbfast1/seg1", ArchiveDataDir: "/data/dbfast1/seg.ABC.1.old"}},
+		}
+
+		_, err := commanders.HostArchives("cdw", hostSegments, 7, false)
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			t.Errorf("got error %#v want type %T", err, exitErr)
+		}
+	})
+}
+
+func TestWriteArchives(t *testing.T) {
+	archives := []commanders.HostArchive{
+		{Host: "sdw1", Archive: upgrade.Archive{Path: "/data/dbfast1/seg.ABC.1.old", Bytes: 2 << 20, ReclaimableBytes: 1 << 20}},
+		{Host: "sdw1", Archive: upgrade.Archive{Path: "/ts/2/GPDB_6_301908232", Tablespace: true, Error: "permission denied"}},
+	}
+
+	var buf bytes.Buffer
+	err := commanders.WriteArchives(&buf, archives)
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	expected := `HOST  ARCHIVED DIRECTORY                   SIZE      RECLAIMABLE  VERIFICATION
+sdw1  /data/dbfast1/seg.ABC.1.old (data)   2.048 MB  1.024 MB     ok
+sdw1  /ts/2/GPDB_6_301908232 (tablespace)  0 B       0 B          permission denied
+      total                                2.048 MB  1.024 MB     
+`
+	if buf.String() != expected {
+		t.Errorf("got\n%s\nwant\n%s", buf.String(), expected)
+	}
+}
@@ -29,6 +29,11 @@ func SuccessScript() {
 	os.Exit(0)
 }
 
+func UnparseableStatsScript() {
+	os.Stdout.WriteString(" hostname | primaries\n----------+-----------\n sdw1     | lots\n(1 row)\n")
+	os.Exit(0)
+}
+
 func FailedSqlAlreadyExists() {
 	os.Stdout.WriteString("ERROR:  language \"plpythonu\" already exists\n")
 	os.Exit(1)
@@ -39,6 +44,7 @@ func init() {
 		Success,
 		FailedMain,
 		SuccessScript,
+		UnparseableStatsScript,
 		FailedSqlAlreadyExists,
 	)
 }
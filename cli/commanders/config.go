@@ -0,0 +1,206 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/idl"
+)
+
+const (
+	ConfigFormatTable = "table"
+	ConfigFormatJSON  = "json"
+)
+
+// ShowConfig writes the persisted configuration in either table or JSON
+// format. When a cluster is specified only its segments and tablespaces are
+// shown similar to gp_segment_configuration.
+func ShowConfig(w io.Writer, conf *config.Config, format string, cluster string) error {
+	if err := validateFormat(format); err != nil {
+		return err
+	}
+
+	if cluster == "" {
+		if format == ConfigFormatJSON {
+			return writeJSON(w, conf)
+		}
+
+		return writeConfigTable(w, conf)
+	}
+
+	c, err := selectCluster(conf, cluster)
+	if err != nil {
+		return err
+	}
+
+	if format == ConfigFormatJSON {
+		return writeJSON(w, clusterView{Segments: segments(c), Tablespaces: tablespaceRows(c)})
+	}
+
+	return writeClusterTable(w, c)
+}
+
+// clusterView is the JSON representation of a single cluster.
+type clusterView struct {
+	Segments    greenplum.SegConfigs
+	Tablespaces []tablespaceRow
+}
+
+type tablespaceRow struct {
+	DbID        int32
+	Oid         int32
+	Location    string
+	UserDefined bool
+}
+
+func selectCluster(conf *config.Config, cluster string) (*greenplum.Cluster, error) {
+	var c *greenplum.Cluster
+	switch strings.ToLower(strings.TrimSpace(cluster)) {
+	case idl.ClusterDestination_source.String():
+		c = conf.Source
+	case idl.ClusterDestination_intermediate.String():
+		c = conf.Intermediate
+	case idl.ClusterDestination_target.String():
+		c = conf.Target
+	default:
+		return nil, xerrors.Errorf("invalid cluster %q. Please specify either %s, %s, or %s.", cluster,
+			idl.ClusterDestination_source, idl.ClusterDestination_intermediate, idl.ClusterDestination_target)
+	}
+
+	if c == nil {
+		return nil, xerrors.Errorf("The %s cluster is not yet configured.", cluster)
+	}
+
+	return c, nil
+}
+
+func writeJSON(w io.Writer, v any) error {
+	contents, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		return xerrors.Errorf("marshal configuration: %w", err)
+	}
+
+	_, err = fmt.Fprintln(w, string(contents))
+	return err
+}
+
+func writeConfigTable(w io.Writer, conf *config.Config) error {
+	var tw tabwriter.Writer
+	tw.Init(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(&tw, "upgrade_id\t%s\n", conf.UpgradeID)
+	fmt.Fprintf(&tw, "mode\t%s\n", conf.Mode)
+	fmt.Fprintf(&tw, "hub_port\t%d\n", conf.HubPort)
+	fmt.Fprintf(&tw, "agent_port\t%d\n", conf.AgentPort)
+	fmt.Fprintf(&tw, "use_hba_hostnames\t%t\n", conf.UseHbaHostnames)
+	fmt.Fprintf(&tw, "pg_upgrade_jobs\t%s\n", config.FormatPgUpgradeJobs(conf.PgUpgradeJobs))
+	fmt.Fprintf(&tw, "master_pg_upgrade_jobs\t%d\n", conf.CoordinatorPgUpgradeJobs)
+	fmt.Fprintf(&tw, "primary_pg_upgrade_jobs\t%d\n", conf.PrimaryPgUpgradeJobs)
+	fmt.Fprintf(&tw, "rsync_jobs\t%d\n", conf.RsyncJobs)
+	fmt.Fprintf(&tw, "copy_fan_out\t%d\n", conf.CopyFanOut)
+	fmt.Fprintf(&tw, "copy_depth\t%d\n", conf.CopyDepth)
+	fmt.Fprintf(&tw, "snapshot_provider\t%s\n", conf.Snapshot.Provider)
+	fmt.Fprintf(&tw, "webhook_urls\t%s\n", strings.Join(conf.WebhookURLs, ","))
+	fmt.Fprintf(&tw, "metrics_port\t%d\n", conf.MetricsPort)
+	fmt.Fprintf(&tw, "coordinator_backup_dir\t%s\n", conf.BackupDirs.CoordinatorBackupDir)
+
+	var hosts []string
+	for host := range conf.BackupDirs.AgentHostsToBackupDir {
+		hosts = append(hosts, host)
+	}
+
+	sort.Strings(hosts)
+	for _, host := range hosts {
+		fmt.Fprintf(&tw, "backup_dir (%s)\t%s\n", host, conf.BackupDirs.AgentHostsToBackupDir[host])
+	}
+
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	for _, c := range []*greenplum.Cluster{conf.Source, conf.Intermediate, conf.Target} {
+		if c == nil {
+			continue
+		}
+
+		fmt.Fprintf(w, "\n%s cluster\n", c.Destination)
+		fmt.Fprintf(w, "gphome: %s\nversion: %s\ncatalog_version: %s\n\n", c.GPHome, c.Version, c.CatalogVersion)
+
+		err = writeClusterTable(w, c)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func writeClusterTable(w io.Writer, c *greenplum.Cluster) error {
+	var tw tabwriter.Writer
+	tw.Init(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(&tw, "dbid\tcontent\trole\tport\thostname\taddress\tdatadir")
+	for _, seg := range segments(c) {
+		fmt.Fprintf(&tw, "%d\t%d\t%s\t%d\t%s\t%s\t%s\n", seg.DbID, seg.ContentID, seg.Role, seg.Port, seg.Hostname, seg.Address, seg.DataDir)
+	}
+
+	tablespaces := tablespaceRows(c)
+	if len(tablespaces) > 0 {
+		fmt.Fprintln(&tw)
+		fmt.Fprintln(&tw, "dbid\ttablespace_oid\tuser_defined\tlocation")
+		for _, ts := range tablespaces {
+			fmt.Fprintf(&tw, "%d\t%d\t%t\t%s\n", ts.DbID, ts.Oid, ts.UserDefined, ts.Location)
+		}
+	}
+
+	return tw.Flush()
+}
+
+// segments returns all segments of the cluster ordered by dbid.
+func segments(c *greenplum.Cluster) greenplum.SegConfigs {
+	segs := greenplum.SegConfigs{}
+	for _, seg := range c.Primaries {
+		segs = append(segs, seg)
+	}
+
+	for _, seg := range c.Mirrors {
+		segs = append(segs, seg)
+	}
+
+	sort.Slice(segs, func(i, j int) bool {
+		return segs[i].DbID < segs[j].DbID
+	})
+
+	return segs
+}
+
+func tablespaceRows(c *greenplum.Cluster) []tablespaceRow {
+	rows := []tablespaceRow{}
+	for dbid, segTablespaces := range c.Tablespaces {
+		for oid, info := range segTablespaces {
+			rows = append(rows, tablespaceRow{DbID: dbid, Oid: oid, Location: info.GetLocation(), UserDefined: info.GetUserDefined()})
+		}
+	}
+
+	sort.Slice(rows, func(i, j int) bool {
+		if rows[i].DbID != rows[j].DbID {
+			return rows[i].DbID < rows[j].DbID
+		}
+
+		return rows[i].Oid < rows[j].Oid
+	})
+
+	return rows
+}
@@ -0,0 +1,143 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/idl"
+)
+
+func TestShowConfig(t *testing.T) {
+	source := greenplum.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "cdw", Address: "cdw", DataDir: "/data/qddir/seg-1", Port: 15432, Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: 0, Hostname: "sdw1", Address: "sdw1", DataDir: "/data/dbfast1/seg1", Port: 25432, Role: greenplum.PrimaryRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw2", Address: "sdw2", DataDir: "/data/dbfast_mirror1/seg1", Port: 25433, Role: greenplum.MirrorRole},
+	})
+	source.Destination = idl.ClusterDestination_source
+	source.GPHome = "/usr/local/gpdb5"
+	source.Tablespaces = greenplum.Tablespaces{
+		1: {16386: &idl.TablespaceInfo{Location: "/tmp/tblspc1", UserDefined: true}},
+	}
+
+	conf := &config.Config{
+		BackupDirs: backupdir.BackupDirs{
+			CoordinatorBackupDir:  "/data/.gpupgrade",
+			AgentHostsToBackupDir: backupdir.AgentHostsToBackupDir{"sdw1": "/data/.gpupgrade"},
+		},
+		Source:        source,
+		HubPort:       7527,
+		AgentPort:     6416,
+		Mode:          idl.Mode_link,
+		UpgradeID:     "ABC123",
+		PgUpgradeJobs: 4,
+	}
+
+	t.Run("shows the entire configuration as a table", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := commanders.ShowConfig(&buf, conf, commanders.ConfigFormatTable, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		for _, expected := range []string{
+			"upgrade_id               ABC123\n",
+			"mode                     link\n",
+			"pg_upgrade_jobs          4\n",
+			"backup_dir (sdw1)        /data/.gpupgrade\n",
+			"\nsource cluster\ngphome: /usr/local/gpdb5\n",
+			"dbid  content  role  port   hostname  address  datadir\n",
+			"3     0        m     25433  sdw2      sdw2     /data/dbfast_mirror1/seg1\n",
+			"1     16386           true          /tmp/tblspc1\n",
+		} {
+			if !strings.Contains(buf.String(), expected) {
+				t.Errorf("expected output %q to contain %q", buf.String(), expected)
+			}
+		}
+
+		if strings.Contains(buf.String(), "intermediate cluster") {
+			t.Errorf("expected output %q to not contain the unconfigured intermediate cluster", buf.String())
+		}
+	})
+
+	t.Run("shows the entire configuration as json", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := commanders.ShowConfig(&buf, conf, commanders.ConfigFormatJSON, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		var actual config.Config
+		err = json.Unmarshal(buf.Bytes(), &actual)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if actual.UpgradeID != conf.UpgradeID || !reflect.DeepEqual(actual.Source.Primaries, conf.Source.Primaries) {
+			t.Errorf("got %+v want %+v", actual, conf)
+		}
+	})
+
+	t.Run("shows a single cluster as json", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := commanders.ShowConfig(&buf, conf, commanders.ConfigFormatJSON, "source")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		var actual struct {
+			Segments    greenplum.SegConfigs
+			Tablespaces []map[string]any
+		}
+		err = json.Unmarshal(buf.Bytes(), &actual)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		var dbids []int
+		for _, seg := range actual.Segments {
+			dbids = append(dbids, seg.DbID)
+		}
+
+		if !reflect.DeepEqual(dbids, []int{1, 2, 3}) {
+			t.Errorf("got dbids %v want %v", dbids, []int{1, 2, 3})
+		}
+
+		if len(actual.Tablespaces) != 1 || actual.Tablespaces[0]["Location"] != "/tmp/tblspc1" {
+			t.Errorf("got tablespaces %v", actual.Tablespaces)
+		}
+	})
+
+	t.Run("errors when the cluster is not yet configured", func(t *testing.T) {
+		err := commanders.ShowConfig(&bytes.Buffer{}, conf, commanders.ConfigFormatTable, "target")
+		expected := "The target cluster is not yet configured."
+		if err == nil || err.Error() != expected {
+			t.Errorf("got error %#v want %q", err, expected)
+		}
+	})
+
+	t.Run("errors on an invalid cluster", func(t *testing.T) {
+		err := commanders.ShowConfig(&bytes.Buffer{}, conf, commanders.ConfigFormatTable, "other")
+		expected := `invalid cluster "other"`
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("got error %#v want %q", err, expected)
+		}
+	})
+
+	t.Run("errors on an invalid format", func(t *testing.T) {
+		err := commanders.ShowConfig(&bytes.Buffer{}, conf, "yaml", "")
+		expected := `invalid format "yaml"`
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("got error %#v want %q", err, expected)
+		}
+	})
+}
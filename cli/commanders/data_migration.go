@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"strconv"
+	"strings"
 
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/testutils/exectest"
@@ -16,6 +17,16 @@ import (
 
 var MigrationScriptPhases = []idl.Step{idl.Step_initialize, idl.Step_finalize, idl.Step_revert, idl.Step_stats}
 
+// DefaultDataMigrationJobs is the default number of databases to generate, or
+// script directories to apply, in parallel when running generate and apply
+// manually.
+const DefaultDataMigrationJobs = 4
+
+// AllDataMigrationJobs generates every database, or applies every script
+// directory, in parallel. It is used by initialize, finalize, and revert to
+// keep their built-in data migration unlimited.
+const AllDataMigrationJobs = 0
+
 var psqlCommand = exec.Command
 
 func SetPsqlCommand(command exectest.Command) {
@@ -71,6 +82,28 @@ func ApplySQLFile(gphome string, port int, database string, path string, args ..
 	return output, nil
 }
 
+// applySQL executes the input as if it were a SQL file such that psql
+// meta-commands can be used.
+func applySQL(gphome string, port int, database string, input string, args ...string) ([]byte, error) {
+	args = append(args,
+		"--no-psqlrc", "--quiet",
+		"-d", database,
+		"-p", strconv.Itoa(port),
+		"-f", "-")
+
+	cmd := psqlFileCommand(filepath.Join(gphome, "bin", "psql"), args...)
+	cmd.Env = []string{}
+	cmd.Stdin = strings.NewReader(input)
+
+	log.Printf("Executing: %q", cmd.String())
+	output, err := cmd.CombinedOutput()
+	if err != nil {
+		return nil, fmt.Errorf("%s failed with %s: %w", cmd.String(), string(output), err)
+	}
+
+	return output, nil
+}
+
 var bashCommand = exec.Command
 
 func SetBashCommand(command exectest.Command) {
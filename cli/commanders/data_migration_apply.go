@@ -5,6 +5,7 @@ package commanders
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"io/fs"
@@ -26,7 +27,15 @@ import (
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
 )
 
-func ApplyDataMigrationScripts(streams step.OutStreams, nonInteractive bool, gphome string, port int, logDir string, currentScriptDirFS fs.FS, currentScriptDir string, phase idl.Step) error {
+// ApplyDataMigrationScripts applies the data migration scripts of the phase in
+// parallel across at most jobs script directories, or all of them when jobs is
+// 0. Each successfully applied script is recorded in a checkpoint such that
+// when resume is set previously applied scripts are skipped. By default
+// applying stops on the first error. When continueOnError is set the remaining
+// scripts are applied and all failures are reported at the end. Only scripts
+// for the databases recorded during generate and matching the filter are
+// applied.
+func ApplyDataMigrationScripts(streams step.OutStreams, nonInteractive bool, gphome string, port int, logDir string, currentScriptDirFS fs.FS, currentScriptDir string, phase idl.Step, resume bool, continueOnError bool, filter DatabaseFilter, jobs uint) error {
 	_, err := currentScriptDirFS.Open(phase.String())
 	if err != nil {
 		if errors.Is(err, fs.ErrNotExist) {
@@ -67,10 +76,45 @@ func ApplyDataMigrationScripts(streams step.OutStreams, nonInteractive bool, gph
 		}
 	}()
 
+	checkpoint, err := LoadCheckpoint(CheckpointPath(logDir, phase), resume)
+	if err != nil {
+		return err
+	}
+
+	selection, err := ReadDatabaseSelection(currentScriptDirFS)
+	if err != nil {
+		return err
+	}
+
+	selection.Filter = filter
+	if !selection.All() {
+		_, err = fmt.Fprintf(streams.Stdout(), "\nApplying scripts only for the selected databases.\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	if jobs == 0 || jobs > uint(len(scriptDirsToRun)) {
+		jobs = uint(len(scriptDirsToRun))
+	}
+
+	if resume && checkpoint.Len() > 0 {
+		_, err = fmt.Fprintf(streams.Stdout(), "\nResuming. Skipping %d previously applied data migration scripts recorded in\n%s\n", checkpoint.Len(), utils.Bold.Sprint(CheckpointPath(logDir, phase)))
+		if err != nil {
+			return err
+		}
+	}
+
+	// Cancel the remaining script directories on the first error unless
+	// continuing on error.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
 	progressBar := mpb.New()
 	var wg sync.WaitGroup
 	errChan := make(chan error, len(scriptDirsToRun))
 	outputChan := make(chan []byte, len(scriptDirsToRun))
+	semaphore := make(chan struct{}, jobs)
 
 	_, err = fmt.Fprintf(streams.Stdout(), "\nApplying data migration scripts...\n")
 	if err != nil {
@@ -94,14 +138,20 @@ func ApplyDataMigrationScripts(streams step.OutStreams, nonInteractive bool, gph
 		go func(gphome string, port int, scriptDir string, bar *mpb.Bar) {
 			defer wg.Done()
 
-			output, aErr := ApplyDataMigrationScriptSubDir(gphome, port, utils.System.DirFS(scriptDir), scriptDir, bar)
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			output, aErr := ApplyDataMigrationScriptSubDir(ctx, gphome, port, utils.System.DirFS(scriptDir), scriptDir, checkpoint, continueOnError, selection, bar)
+			outputChan <- output
 			if aErr != nil {
+				if !continueOnError {
+					cancel()
+				}
+
 				errChan <- aErr
 				bar.Abort(false)
 				return
 			}
-
-			outputChan <- output
 		}(gphome, port, scriptDir, bar)
 	}
 
@@ -110,26 +160,51 @@ func ApplyDataMigrationScripts(streams step.OutStreams, nonInteractive bool, gph
 	close(errChan)
 	close(outputChan)
 
+	// Write the output of successfully applied scripts even on failure to aid
+	// in debugging.
+	var outputs []byte
+	for output := range outputChan {
+		if len(output) == 0 {
+			continue
+		}
+
+		log.Println(string(output))
+		outputs = append(outputs, output...)
+
+		_, err = file.Write(output)
+		if err != nil {
+			return err
+		}
+	}
+
 	var errs error
 	for e := range errChan {
 		errs = errorlist.Append(errs, e)
 	}
 
 	if errs != nil {
+		fmt.Printf("\nTo skip the successfully applied scripts when re-applying use --resume. Applied scripts are recorded in:\n%s\n\n", utils.Bold.Sprint(CheckpointPath(logDir, phase)))
 		return errs
 	}
 
-	for output := range outputChan {
-		log.Println(string(output))
-
-		_, err = file.Write(output)
+	if phase == idl.Step_stats {
+		// The stats were applied successfully so only warn when they cannot
+		// be summarized into a report.
+		statsOutput := filepath.Join(logDir, "apply_"+phase.String()+".log")
+		report, err := ParseStats(outputs)
 		if err != nil {
-			return err
+			log.Printf("warning: unable to parse stats for the stats report: %v", err)
+		} else {
+			paths, err := WriteStatsReport(report, logDir, utils.GetStateDir())
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("\nStats reports:\n%s\n", utils.Bold.Sprint(strings.Join(paths, "\n")))
+			statsOutput = filepath.Join(logDir, StatsReportCSVFile)
 		}
-	}
 
-	if phase == idl.Step_stats {
-		fmt.Print(color.YellowString("\nTo receive an upgrade time estimate send the stats output:\n%s\n", utils.Bold.Sprint(filepath.Join(logDir, "apply_"+phase.String()+".log"))))
+		fmt.Print(color.YellowString("\nTo receive an upgrade time estimate send the stats output:\n%s\n", utils.Bold.Sprint(statsOutput)))
 	}
 
 	fmt.Printf("\nLogs: %s\n\n", utils.Bold.Sprint(logDir))
@@ -149,7 +224,10 @@ func countScripts(entries []fs.DirEntry) int {
 	return numScripts
 }
 
-func ApplyDataMigrationScriptSubDir(gphome string, port int, scriptDirFS fs.FS, scriptDir string, bar *mpb.Bar) ([]byte, error) {
+// ApplyDataMigrationScriptSubDir applies the SQL files in scriptDir skipping
+// those already recorded in the checkpoint or for databases not selected. It
+// returns the output of the applied scripts even when an error occurs.
+func ApplyDataMigrationScriptSubDir(ctx context.Context, gphome string, port int, scriptDirFS fs.FS, scriptDir string, checkpoint *Checkpoint, continueOnError bool, selection DatabaseSelection, bar *mpb.Bar) ([]byte, error) {
 	entries, err := utils.System.ReadDirFS(scriptDirFS, ".")
 	if err != nil {
 		return nil, err
@@ -160,23 +238,57 @@ func ApplyDataMigrationScriptSubDir(gphome string, port int, scriptDirFS fs.FS,
 	}
 
 	var outputs []byte
+	var errs error
 	for _, entry := range entries {
 		if filepath.Ext(entry.Name()) != ".sql" {
 			continue
 		}
 
+		if ctx.Err() != nil {
+			return outputs, errorlist.Append(errs, xerrors.Errorf("stopped applying data migration scripts in %q: %w", scriptDir, ctx.Err()))
+		}
+
+		contents, rErr := utils.System.ReadFileFS(scriptDirFS, entry.Name())
+		if rErr != nil {
+			return outputs, errorlist.Append(errs, rErr)
+		}
+
+		if !selection.All() && !selection.Selected(scriptDatabase(string(contents))) {
+			log.Printf("  skipping %s for unselected database\n", entry.Name())
+			bar.Increment()
+			continue
+		}
+
+		script := filepath.Join(scriptDir, entry.Name())
+		hash := ScriptHash(contents)
+		if checkpoint.Applied(script, hash) {
+			log.Printf("  skipping previously applied %s\n", entry.Name())
+			bar.Increment()
+			continue
+		}
+
 		log.Printf("  %s\n", entry.Name())
-		output, err := ApplySQLFile(gphome, port, "postgres", filepath.Join(scriptDir, entry.Name()), "-v", "ON_ERROR_STOP=1", "--echo-queries")
+		output, err := ApplySQLFile(gphome, port, "postgres", script, "-v", "ON_ERROR_STOP=1", "--echo-queries")
 		if err != nil {
-			return nil, err
+			if !continueOnError {
+				return outputs, err
+			}
+
+			errs = errorlist.Append(errs, err)
+			continue
 		}
 
 		outputs = append(outputs, output...)
 
+		err = checkpoint.Record(script, hash)
+		if err != nil {
+			return outputs, errorlist.Append(errs, err)
+		}
+
 		bar.Increment()
 	}
 
-	return outputs, nil
+	return outputs, errs
 }
 
 func ApplyDataMigrationScriptsPrompt(nonInteractive bool, reader *bufio.Reader, currentScriptDir string, currentScriptDirFS fs.FS, phase idl.Step) ([]string, error) {
@@ -5,6 +5,7 @@ package commanders_test
 
 import (
 	"bufio"
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -14,8 +15,10 @@ import (
 	"path/filepath"
 	"reflect"
 	"strings"
+	"sync"
 	"testing"
 	"testing/fstest"
+	"time"
 
 	"github.com/vbauerster/mpb/v8"
 
@@ -25,6 +28,7 @@ import (
 	"github.com/greenplum-db/gpupgrade/testutils"
 	"github.com/greenplum-db/gpupgrade/testutils/exectest"
 	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
 )
 
 func TestApplyDataMigrationScripts(t *testing.T) {
@@ -41,7 +45,7 @@ func TestApplyDataMigrationScripts(t *testing.T) {
 	}
 
 	t.Run("returns when there are no scripts to apply", func(t *testing.T) {
-		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, "", idl.Step_revert)
+		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, "", idl.Step_revert, false, false, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		if err != nil {
 			t.Fatalf("unexpected error %#v", err)
 		}
@@ -66,7 +70,7 @@ func TestApplyDataMigrationScripts(t *testing.T) {
 		commanders.SetPsqlFileCommand(exectest.NewCommand(SuccessScript))
 		defer commanders.ResetPsqlFileCommand()
 
-		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats)
+		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats, false, false, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		if err != nil {
 			t.Errorf("unexpected err %#v", err)
 		}
@@ -86,11 +90,85 @@ func TestApplyDataMigrationScripts(t *testing.T) {
 		}
 	})
 
+	t.Run("does not error when the stats cannot be parsed into a report", func(t *testing.T) {
+		d := BufferStandardDescriptors(t)
+
+		resetStdin := testutils.SetStdin(t, "a\n")
+		defer resetStdin()
+
+		utils.System.DirFS = func(dir string) fs.FS {
+			return fstest.MapFS{"migration_postgres_generate_stats.sql": {}}
+		}
+		defer utils.ResetSystemFunctions()
+
+		commanders.SetPsqlFileCommand(exectest.NewCommand(UnparseableStatsScript))
+		defer commanders.ResetPsqlFileCommand()
+
+		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats, false, false, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
+		if err != nil {
+			t.Errorf("unexpected err %#v", err)
+		}
+
+		stdout, _ := d.Collect()
+		d.Close()
+
+		expected := filepath.Join(logDir, "apply_stats.log")
+		if !strings.Contains(string(stdout), expected) {
+			t.Errorf("expected output %q to contain %q", stdout, expected)
+		}
+	})
+
+	t.Run("applies all script directories at the same time when jobs is 0", func(t *testing.T) {
+		d := BufferStandardDescriptors(t)
+		defer d.Close()
+
+		scriptDirs := []string{"unique_primary_foreign_key_constraint_1", "unique_primary_foreign_key_constraint_2", "unique_primary_foreign_key_constraint_3"}
+		phaseDirFS := fstest.MapFS{idl.Step_initialize.String(): {Mode: os.ModeDir}}
+		for _, dir := range scriptDirs {
+			phaseDirFS[filepath.Join(idl.Step_initialize.String(), dir)] = &fstest.MapFile{Mode: os.ModeDir}
+		}
+
+		utils.System.DirFS = func(dir string) fs.FS {
+			return fstest.MapFS{"migration_postgres_gen_drop_constraint_2_primary_unique.sql": {}}
+		}
+		defer utils.ResetSystemFunctions()
+
+		// Each script waits until the scripts of all directories have started
+		// which only happens when they are applied at the same time.
+		var mutex sync.Mutex
+		started := 0
+		allStarted := make(chan struct{})
+		commanders.SetPsqlFileCommand(exectest.NewCommandWithVerifier(SuccessScript, func(string, ...string) {
+			mutex.Lock()
+			started++
+			if started == len(scriptDirs) {
+				close(allStarted)
+			}
+			mutex.Unlock()
+
+			select {
+			case <-allStarted:
+			case <-time.After(5 * time.Second):
+				t.Errorf("expected all %d script directories to be applied at the same time", len(scriptDirs))
+			}
+		}))
+		defer commanders.ResetPsqlFileCommand()
+
+		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, true, "", 0, logDir, phaseDirFS, currentScriptDir, idl.Step_initialize, false, false, commanders.DatabaseFilter{}, commanders.AllDataMigrationJobs)
+		if err != nil {
+			t.Errorf("unexpected err %#v", err)
+		}
+
+		if started != len(scriptDirs) {
+			t.Errorf("got %d applied script directories want %d", started, len(scriptDirs))
+		}
+	})
+
 	t.Run("does not error when prompt returns skipped", func(t *testing.T) {
 		resetStdin := testutils.SetStdin(t, "n\n")
 		defer resetStdin()
 
-		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats)
+		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats, false, false, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		if err != nil {
 			t.Fatalf("unexpected error %#v", err)
 		}
@@ -103,7 +181,7 @@ func TestApplyDataMigrationScripts(t *testing.T) {
 		}
 		defer utils.ResetSystemFunctions()
 
-		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats)
+		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats, false, false, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		if !errors.Is(err, expected) {
 			t.Errorf("got error %#v want %#v", err, expected)
 		}
@@ -129,7 +207,7 @@ func TestApplyDataMigrationScripts(t *testing.T) {
 		resetStdin := testutils.SetStdin(t, "a\n")
 		defer resetStdin()
 
-		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats)
+		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats, false, false, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		var exitError *exec.ExitError
 		if !errors.As(err, &exitError) {
 			t.Errorf("got %T, want %T", err, exitError)
@@ -153,7 +231,7 @@ func TestApplyDataMigrationScripts(t *testing.T) {
 		resetStdin := testutils.SetStdin(t, "a\n")
 		defer resetStdin()
 
-		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats)
+		err := commanders.ApplyDataMigrationScripts(step.DevNullStream, false, "", 0, logDir, currentDirFS, currentScriptDir, idl.Step_stats, false, false, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		if !errors.Is(err, os.ErrPermission) {
 			t.Errorf("got error %#v want %#v", err, os.ErrPermission)
 		}
@@ -165,13 +243,22 @@ func TestApplyDataMigrationScriptSubDir(t *testing.T) {
 	progressBar := mpb.New()
 	bar := progressBar.AddBar(int64(100))
 
+	logDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, logDir)
+
+	checkpointPath := commanders.CheckpointPath(logDir, idl.Step_initialize)
+	checkpoint, err := commanders.LoadCheckpoint(checkpointPath, false)
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
 	t.Run("errors when failing to read current script directory", func(t *testing.T) {
 		utils.System.ReadDirFS = func(fsys fs.FS, name string) ([]fs.DirEntry, error) {
 			return nil, os.ErrPermission
 		}
 		defer utils.ResetSystemFunctions()
 
-		output, err := commanders.ApplyDataMigrationScriptSubDir("", 0, fstest.MapFS{}, scriptSubDir, bar)
+		output, err := commanders.ApplyDataMigrationScriptSubDir(context.Background(), "", 0, fstest.MapFS{}, scriptSubDir, checkpoint, false, commanders.DatabaseSelection{}, bar)
 		if !errors.Is(err, os.ErrPermission) {
 			t.Errorf("got error %#v want %#v", err, os.ErrPermission)
 		}
@@ -182,7 +269,7 @@ func TestApplyDataMigrationScriptSubDir(t *testing.T) {
 	})
 
 	t.Run("errors when no directories are in the current script directory", func(t *testing.T) {
-		output, err := commanders.ApplyDataMigrationScriptSubDir("", 0, fstest.MapFS{}, scriptSubDir, bar)
+		output, err := commanders.ApplyDataMigrationScriptSubDir(context.Background(), "", 0, fstest.MapFS{}, scriptSubDir, checkpoint, false, commanders.DatabaseSelection{}, bar)
 		expected := fmt.Sprintf("No SQL files found in %q.", scriptSubDir)
 		if !strings.Contains(err.Error(), expected) {
 			t.Errorf("got error %#v, want %#v", err, expected)
@@ -203,7 +290,7 @@ func TestApplyDataMigrationScriptSubDir(t *testing.T) {
 			"drop_postgres_indexes.bash":                                  {},
 		}
 
-		output, err := commanders.ApplyDataMigrationScriptSubDir("", 0, fsys, scriptSubDir, bar)
+		output, err := commanders.ApplyDataMigrationScriptSubDir(context.Background(), "", 0, fsys, scriptSubDir, checkpoint, false, commanders.DatabaseSelection{}, bar)
 		if err != nil {
 			t.Errorf("unexpected err %#v", err)
 		}
@@ -217,16 +304,206 @@ func TestApplyDataMigrationScriptSubDir(t *testing.T) {
 		commanders.SetPsqlFileCommand(exectest.NewCommand(FailedMain))
 		defer commanders.ResetPsqlFileCommand()
 
+		checkpoint, err := commanders.LoadCheckpoint(checkpointPath, false)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
 		fsys := fstest.MapFS{
 			"migration_postgres_gen_drop_constraint_2_primary_unique.sql": {},
 		}
 
-		output, err := commanders.ApplyDataMigrationScriptSubDir("", 0, fsys, scriptSubDir, bar)
+		output, err := commanders.ApplyDataMigrationScriptSubDir(context.Background(), "", 0, fsys, scriptSubDir, checkpoint, false, commanders.DatabaseSelection{}, bar)
 		var exitError *exec.ExitError
 		if !errors.As(err, &exitError) {
 			t.Errorf("got %T, want %T", err, exitError)
 		}
 
+		if output != nil {
+			t.Error("expected nil output")
+		}
+	})
+	t.Run("records applied scripts in the checkpoint", func(t *testing.T) {
+		commanders.SetPsqlFileCommand(exectest.NewCommand(SuccessScript))
+		defer commanders.ResetPsqlFileCommand()
+
+		checkpoint, err := commanders.LoadCheckpoint(checkpointPath, false)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		fsys := fstest.MapFS{
+			"migration_postgres_gen_drop_constraint_2_primary_unique.sql": {},
+		}
+
+		_, err = commanders.ApplyDataMigrationScriptSubDir(context.Background(), "", 0, fsys, scriptSubDir, checkpoint, false, commanders.DatabaseSelection{}, bar)
+		if err != nil {
+			t.Errorf("unexpected err %#v", err)
+		}
+
+		expected := filepath.Join(scriptSubDir, "migration_postgres_gen_drop_constraint_2_primary_unique.sql")
+		hash := commanders.ScriptHash(nil)
+		if !checkpoint.Applied(expected, hash) {
+			t.Errorf("expected %q to be recorded as applied", expected)
+		}
+
+		contents := testutils.MustReadFile(t, checkpointPath)
+		if contents != expected+"\t"+hash+"\n" {
+			t.Errorf("got checkpoint contents %q, want %q", contents, expected+"\t"+hash+"\n")
+		}
+	})
+
+	t.Run("skips scripts previously recorded in the checkpoint when resuming", func(t *testing.T) {
+		testutils.MustWriteToFile(t, checkpointPath, filepath.Join(scriptSubDir, "migration_postgres_gen_drop_constraint_2_primary_unique.sql")+"\t"+commanders.ScriptHash(nil)+"\n")
+
+		checkpoint, err := commanders.LoadCheckpoint(checkpointPath, true)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		commanders.SetPsqlFileCommand(exectest.NewCommand(SuccessScript))
+		defer commanders.ResetPsqlFileCommand()
+
+		fsys := fstest.MapFS{
+			"migration_postgres_gen_drop_constraint_2_primary_unique.sql": {},
+			"migration_testDB_gen_drop_constraint_2_primary_unique.sql":   {},
+		}
+
+		output, err := commanders.ApplyDataMigrationScriptSubDir(context.Background(), "", 0, fsys, scriptSubDir, checkpoint, false, commanders.DatabaseSelection{}, bar)
+		if err != nil {
+			t.Errorf("unexpected err %#v", err)
+		}
+
+		// only the script not previously applied is executed
+		if string(output) != SuccessScriptOutput {
+			t.Errorf("got output %q, want %q", output, SuccessScriptOutput)
+		}
+
+		if checkpoint.Len() != 2 {
+			t.Errorf("got %d applied scripts want %d", checkpoint.Len(), 2)
+		}
+	})
+
+	t.Run("re-applies scripts whose contents changed since they were recorded in the checkpoint", func(t *testing.T) {
+		testutils.MustWriteToFile(t, checkpointPath, filepath.Join(scriptSubDir, "migration_postgres_gen_drop_constraint_2_primary_unique.sql")+"\t"+commanders.ScriptHash([]byte("old contents"))+"\n")
+
+		checkpoint, err := commanders.LoadCheckpoint(checkpointPath, true)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		commanders.SetPsqlFileCommand(exectest.NewCommand(SuccessScript))
+		defer commanders.ResetPsqlFileCommand()
+
+		fsys := fstest.MapFS{
+			"migration_postgres_gen_drop_constraint_2_primary_unique.sql": {Data: []byte("new contents")},
+		}
+
+		output, err := commanders.ApplyDataMigrationScriptSubDir(context.Background(), "", 0, fsys, scriptSubDir, checkpoint, false, commanders.DatabaseSelection{}, bar)
+		if err != nil {
+			t.Errorf("unexpected err %#v", err)
+		}
+
+		if string(output) != SuccessScriptOutput {
+			t.Errorf("got output %q, want %q", output, SuccessScriptOutput)
+		}
+
+		script := filepath.Join(scriptSubDir, "migration_postgres_gen_drop_constraint_2_primary_unique.sql")
+		if !checkpoint.Applied(script, commanders.ScriptHash([]byte("new contents"))) {
+			t.Errorf("expected %q to be recorded as applied with its new contents", script)
+		}
+	})
+
+	t.Run("only applies scripts for selected databases", func(t *testing.T) {
+		commanders.SetPsqlFileCommand(exectest.NewCommand(SuccessScript))
+		defer commanders.ResetPsqlFileCommand()
+
+		checkpoint, err := commanders.LoadCheckpoint(checkpointPath, false)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		fsys := fstest.MapFS{
+			"migration_postgres_gen_drop_constraint_2_primary_unique.sql":  {Data: []byte("\\c postgres\nALTER TABLE t1 DROP CONSTRAINT c1;\n")},
+			"migration_My_DB_gen_drop_constraint_2_primary_unique.sql":     {Data: []byte("\\c \"My DB\"\nALTER TABLE t2 DROP CONSTRAINT c2;\n")},
+			"migration_excluded_gen_drop_constraint_2_primary_unique.sql":  {Data: []byte("\\c excluded\nALTER TABLE t3 DROP CONSTRAINT c3;\n")},
+			"migration_not_generated_drop_constraint_2_primary_unique.sql": {Data: []byte("\\c not_generated\nALTER TABLE t4 DROP CONSTRAINT c4;\n")},
+		}
+
+		selection := commanders.DatabaseSelection{
+			Filter:    commanders.DatabaseFilter{Exclude: []string{"excluded"}},
+			Databases: []string{"postgres", "My DB", "excluded"},
+		}
+
+		_, err = commanders.ApplyDataMigrationScriptSubDir(context.Background(), "", 0, fsys, scriptSubDir, checkpoint, false, selection, bar)
+		if err != nil {
+			t.Errorf("unexpected err %#v", err)
+		}
+
+		for script, expected := range map[string]bool{
+			"migration_postgres_gen_drop_constraint_2_primary_unique.sql":  true,
+			"migration_My_DB_gen_drop_constraint_2_primary_unique.sql":     true,
+			"migration_excluded_gen_drop_constraint_2_primary_unique.sql":  false,
+			"migration_not_generated_drop_constraint_2_primary_unique.sql": false,
+		} {
+			if checkpoint.Applied(filepath.Join(scriptSubDir, script), commanders.ScriptHash(fsys[script].Data)) != expected {
+				t.Errorf("got applied %t for %q want %t", !expected, script, expected)
+			}
+		}
+	})
+
+	t.Run("continues applying and reports all failures when continuing on error", func(t *testing.T) {
+		commanders.SetPsqlFileCommand(exectest.NewCommand(FailedMain))
+		defer commanders.ResetPsqlFileCommand()
+
+		checkpoint, err := commanders.LoadCheckpoint(checkpointPath, false)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		fsys := fstest.MapFS{
+			"migration_postgres_gen_drop_constraint_2_primary_unique.sql": {},
+			"migration_testDB_gen_drop_constraint_2_primary_unique.sql":   {},
+		}
+
+		_, err = commanders.ApplyDataMigrationScriptSubDir(context.Background(), "", 0, fsys, scriptSubDir, checkpoint, true, commanders.DatabaseSelection{}, bar)
+		var errs errorlist.Errors
+		if !errors.As(err, &errs) {
+			t.Fatalf("got error type %T want %T", err, errs)
+		}
+
+		if len(errs) != 2 {
+			t.Errorf("got %d errors want %d", len(errs), 2)
+		}
+
+		for _, err := range errs {
+			var exitError *exec.ExitError
+			if !errors.As(err, &exitError) {
+				t.Errorf("got %T, want %T", err, exitError)
+			}
+		}
+
+		if checkpoint.Len() != 0 {
+			t.Errorf("got %d applied scripts want %d", checkpoint.Len(), 0)
+		}
+	})
+
+	t.Run("stops applying scripts when cancelled", func(t *testing.T) {
+		commanders.SetPsqlFileCommand(exectest.NewCommand(SuccessScript))
+		defer commanders.ResetPsqlFileCommand()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		fsys := fstest.MapFS{
+			"migration_postgres_gen_drop_constraint_2_primary_unique.sql": {},
+		}
+
+		output, err := commanders.ApplyDataMigrationScriptSubDir(ctx, "", 0, fsys, scriptSubDir, checkpoint, false, commanders.DatabaseSelection{}, bar)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("got error %#v want %#v", err, context.Canceled)
+		}
+
 		if output != nil {
 			t.Error("expected nil output")
 		}
@@ -0,0 +1,124 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+// Checkpoint records the data migration scripts of a phase that have been
+// successfully applied. Each script is recorded by its full path and a hash of
+// its contents as soon as it is applied such that a failed apply can later be
+// resumed without re-applying scripts that already succeeded. A script whose
+// contents changed since it was recorded, such as after re-generating the
+// scripts, is applied again.
+type Checkpoint struct {
+	path    string
+	mutex   sync.Mutex
+	applied map[string]string
+}
+
+// ScriptHash returns the hash of the script contents recorded in the
+// checkpoint.
+func ScriptHash(contents []byte) string {
+	sum := sha256.Sum256(contents)
+	return hex.EncodeToString(sum[:])
+}
+
+func CheckpointPath(logDir string, phase idl.Step) string {
+	return filepath.Join(logDir, "apply_"+phase.String()+".checkpoint")
+}
+
+// LoadCheckpoint returns the checkpoint located at path. When not resuming
+// any previous checkpoint is discarded so that all scripts are applied again.
+func LoadCheckpoint(path string, resume bool) (*Checkpoint, error) {
+	checkpoint := &Checkpoint{path: path, applied: make(map[string]string)}
+
+	if !resume {
+		err := utils.System.Remove(path)
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		return checkpoint, nil
+	}
+
+	contents, err := utils.System.ReadFile(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return checkpoint, nil
+		}
+
+		return nil, err
+	}
+
+	for _, line := range strings.Split(string(contents), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		// Entries are the script path followed by a tab and the hash of its
+		// contents. Entries without a hash never match such that the script
+		// is applied again.
+		script, hash, _ := strings.Cut(line, "\t")
+		checkpoint.applied[script] = hash
+	}
+
+	return checkpoint, nil
+}
+
+// Applied returns whether the script with the given contents hash was
+// previously applied.
+func (c *Checkpoint) Applied(script string, hash string) bool {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	applied, ok := c.applied[script]
+	return ok && applied != "" && applied == hash
+}
+
+func (c *Checkpoint) Len() int {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	return len(c.applied)
+}
+
+// Record appends the script and the hash of its contents to the checkpoint
+// file. Scripts are applied in parallel across script directories so writes
+// are serialized.
+func (c *Checkpoint) Record(script string, hash string) (err error) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	file, err := utils.System.OpenFile(c.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := file.Close(); cErr != nil {
+			err = errorlist.Append(err, cErr)
+		}
+	}()
+
+	_, err = file.WriteString(script + "\t" + hash + "\n")
+	if err != nil {
+		return err
+	}
+
+	c.applied[script] = hash
+	return nil
+}
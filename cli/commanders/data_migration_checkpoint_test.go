@@ -0,0 +1,146 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+func TestLoadCheckpoint(t *testing.T) {
+	logDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, logDir)
+
+	path := commanders.CheckpointPath(logDir, idl.Step_initialize)
+
+	t.Run("returns an empty checkpoint when none exists", func(t *testing.T) {
+		checkpoint, err := commanders.LoadCheckpoint(path, true)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if checkpoint.Len() != 0 {
+			t.Errorf("got %d applied scripts want %d", checkpoint.Len(), 0)
+		}
+	})
+
+	t.Run("loads previously applied scripts when resuming", func(t *testing.T) {
+		testutils.MustWriteToFile(t, path, "/dir/a.sql\thash-a\n\n/dir/b.sql\thash-b\n")
+		defer testutils.MustRemoveAll(t, path)
+
+		checkpoint, err := commanders.LoadCheckpoint(path, true)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if checkpoint.Len() != 2 {
+			t.Errorf("got %d applied scripts want %d", checkpoint.Len(), 2)
+		}
+
+		for script, hash := range map[string]string{"/dir/a.sql": "hash-a", "/dir/b.sql": "hash-b"} {
+			if !checkpoint.Applied(script, hash) {
+				t.Errorf("expected %q to be applied", script)
+			}
+		}
+
+		if checkpoint.Applied("/dir/a.sql", "hash-changed") {
+			t.Errorf("expected %q with changed contents to not be applied", "/dir/a.sql")
+		}
+	})
+
+	t.Run("does not consider scripts recorded without a hash as applied", func(t *testing.T) {
+		testutils.MustWriteToFile(t, path, "/dir/a.sql\n")
+		defer testutils.MustRemoveAll(t, path)
+
+		checkpoint, err := commanders.LoadCheckpoint(path, true)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if checkpoint.Applied("/dir/a.sql", "") {
+			t.Errorf("expected %q to not be applied", "/dir/a.sql")
+		}
+	})
+
+	t.Run("discards the previous checkpoint when not resuming", func(t *testing.T) {
+		testutils.MustWriteToFile(t, path, "/dir/a.sql\n")
+
+		checkpoint, err := commanders.LoadCheckpoint(path, false)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if checkpoint.Applied("/dir/a.sql", "") {
+			t.Errorf("expected %q to not be applied", "/dir/a.sql")
+		}
+
+		testutils.PathMustNotExist(t, path)
+	})
+
+	t.Run("errors when failing to read the checkpoint", func(t *testing.T) {
+		utils.System.ReadFile = func(filename string) ([]byte, error) {
+			return nil, os.ErrPermission
+		}
+		defer utils.ResetSystemFunctions()
+
+		checkpoint, err := commanders.LoadCheckpoint(path, true)
+		if !errors.Is(err, os.ErrPermission) {
+			t.Errorf("got error %#v want %#v", err, os.ErrPermission)
+		}
+
+		if checkpoint != nil {
+			t.Error("expected nil checkpoint")
+		}
+	})
+}
+
+func TestCheckpointRecord(t *testing.T) {
+	logDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, logDir)
+
+	path := commanders.CheckpointPath(logDir, idl.Step_finalize)
+
+	t.Run("appends each recorded script", func(t *testing.T) {
+		checkpoint, err := commanders.LoadCheckpoint(path, false)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		for _, script := range []string{"/dir/a.sql", "/dir/b.sql"} {
+			err = checkpoint.Record(script, "hash")
+			if err != nil {
+				t.Errorf("unexpected error %#v", err)
+			}
+		}
+
+		contents := testutils.MustReadFile(t, path)
+		expected := "/dir/a.sql\thash\n/dir/b.sql\thash\n"
+		if contents != expected {
+			t.Errorf("got %q want %q", contents, expected)
+		}
+	})
+
+	t.Run("errors when failing to open the checkpoint", func(t *testing.T) {
+		checkpoint, err := commanders.LoadCheckpoint(filepath.Join(logDir, "does", "not", "exist"), false)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		err = checkpoint.Record("/dir/a.sql", "hash")
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("got error %#v want %#v", err, os.ErrNotExist)
+		}
+
+		if checkpoint.Applied("/dir/a.sql", "hash") {
+			t.Errorf("expected %q to not be applied", "/dir/a.sql")
+		}
+	})
+}
@@ -0,0 +1,189 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+const DatabaseSelectionFile = "database_selection.json"
+
+// DatabaseFilter selects databases using shell patterns such as "sales_*". A
+// database is selected when it matches any include pattern, or when there are
+// no include patterns, and does not match any exclude pattern.
+type DatabaseFilter struct {
+	Include []string
+	Exclude []string
+}
+
+// NewDatabaseFilter parses comma separated lists of include and exclude
+// patterns.
+func NewDatabaseFilter(include string, exclude string) (DatabaseFilter, error) {
+	includes, err := parseDatabasePatterns(include)
+	if err != nil {
+		return DatabaseFilter{}, xerrors.Errorf("databases: %w", err)
+	}
+
+	excludes, err := parseDatabasePatterns(exclude)
+	if err != nil {
+		return DatabaseFilter{}, xerrors.Errorf("exclude databases: %w", err)
+	}
+
+	return DatabaseFilter{Include: includes, Exclude: excludes}, nil
+}
+
+func parseDatabasePatterns(input string) ([]string, error) {
+	var patterns []string
+	for _, pattern := range strings.Split(input, ",") {
+		pattern = strings.TrimSpace(pattern)
+		if pattern == "" {
+			continue
+		}
+
+		// path.Match only reports a malformed pattern when matching, so match
+		// against the empty string to validate it upfront.
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	return patterns, nil
+}
+
+func (f DatabaseFilter) IsEmpty() bool {
+	return len(f.Include) == 0 && len(f.Exclude) == 0
+}
+
+func (f DatabaseFilter) Matches(datname string) bool {
+	if len(f.Include) > 0 && !matchesAny(f.Include, datname) {
+		return false
+	}
+
+	return !matchesAny(f.Exclude, datname)
+}
+
+func (f DatabaseFilter) Filter(databases []DatabaseInfo) []DatabaseInfo {
+	var selected []DatabaseInfo
+	for _, database := range databases {
+		if f.Matches(database.Datname) {
+			selected = append(selected, database)
+		}
+	}
+
+	return selected
+}
+
+func matchesAny(patterns []string, datname string) bool {
+	for _, pattern := range patterns {
+		// Patterns are validated when parsed.
+		if matched, _ := path.Match(pattern, datname); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+// DatabaseSelection records the filter and resulting databases the data
+// migration scripts were generated for. It is written alongside the generated
+// scripts such that apply uses the same set of databases.
+type DatabaseSelection struct {
+	Filter    DatabaseFilter
+	Databases []string
+}
+
+// Selected returns whether the scripts for the database should be applied.
+// When no databases were recorded all databases matching the filter are
+// selected.
+func (s DatabaseSelection) Selected(datname string) bool {
+	if !s.Filter.Matches(datname) {
+		return false
+	}
+
+	if s.Databases == nil {
+		return true
+	}
+
+	for _, database := range s.Databases {
+		if database == datname {
+			return true
+		}
+	}
+
+	return false
+}
+
+// All returns whether every database is selected.
+func (s DatabaseSelection) All() bool {
+	return s.Filter.IsEmpty() && s.Databases == nil
+}
+
+func WriteDatabaseSelection(currentDir string, filter DatabaseFilter, databases []DatabaseInfo) error {
+	selection := DatabaseSelection{Filter: filter, Databases: []string{}}
+	for _, database := range databases {
+		selection.Databases = append(selection.Databases, database.Datname)
+	}
+
+	contents, err := json.MarshalIndent(selection, "", "  ")
+	if err != nil {
+		return xerrors.Errorf("marshal database selection: %w", err)
+	}
+
+	err = utils.System.MkdirAll(currentDir, 0700)
+	if err != nil {
+		return err
+	}
+
+	return utils.System.WriteFile(filepath.Join(currentDir, DatabaseSelectionFile), contents, 0644)
+}
+
+// ReadDatabaseSelection returns the recorded database selection. Scripts
+// generated by previous versions of gpupgrade have no recorded selection in
+// which case all databases are selected.
+func ReadDatabaseSelection(currentDirFS fs.FS) (DatabaseSelection, error) {
+	contents, err := utils.System.ReadFileFS(currentDirFS, DatabaseSelectionFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return DatabaseSelection{}, nil
+		}
+
+		return DatabaseSelection{}, err
+	}
+
+	var selection DatabaseSelection
+	err = json.Unmarshal(contents, &selection)
+	if err != nil {
+		return DatabaseSelection{}, xerrors.Errorf("unmarshal database selection: %w", err)
+	}
+
+	return selection, nil
+}
+
+// scriptDatabase returns the unquoted database name from the leading connect
+// statement of a generated script.
+func scriptDatabase(contents string) string {
+	database, _ := parseGeneratedScript(contents)
+	return unquoteIdent(database)
+}
+
+// unquoteIdent reverses quote_ident().
+func unquoteIdent(ident string) string {
+	if len(ident) < 2 || !strings.HasPrefix(ident, `"`) || !strings.HasSuffix(ident, `"`) {
+		return ident
+	}
+
+	return strings.ReplaceAll(ident[1:len(ident)-1], `""`, `"`)
+}
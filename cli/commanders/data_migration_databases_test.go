@@ -0,0 +1,163 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+func TestNewDatabaseFilter(t *testing.T) {
+	t.Run("parses comma separated patterns", func(t *testing.T) {
+		filter, err := commanders.NewDatabaseFilter(" sales, hr_* ,", "test_*")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := commanders.DatabaseFilter{Include: []string{"sales", "hr_*"}, Exclude: []string{"test_*"}}
+		if !reflect.DeepEqual(filter, expected) {
+			t.Errorf("got %#v want %#v", filter, expected)
+		}
+	})
+
+	t.Run("returns an empty filter when there are no patterns", func(t *testing.T) {
+		filter, err := commanders.NewDatabaseFilter("", "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if !filter.IsEmpty() {
+			t.Errorf("expected filter %#v to be empty", filter)
+		}
+	})
+
+	t.Run("errors on invalid patterns", func(t *testing.T) {
+		_, err := commanders.NewDatabaseFilter("", "test_[")
+		expected := `exclude databases: invalid pattern "test_["`
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("got error %#v want %q", err, expected)
+		}
+	})
+}
+
+func TestDatabaseFilter(t *testing.T) {
+	databases := []commanders.DatabaseInfo{
+		{Datname: "postgres"},
+		{Datname: "sales"},
+		{Datname: "hr_east"},
+		{Datname: "hr_west"},
+		{Datname: "test_hr"},
+	}
+
+	cases := []struct {
+		name     string
+		filter   commanders.DatabaseFilter
+		expected []string
+	}{
+		{
+			name:     "selects all databases when empty",
+			filter:   commanders.DatabaseFilter{},
+			expected: []string{"postgres", "sales", "hr_east", "hr_west", "test_hr"},
+		},
+		{
+			name:     "selects only included databases",
+			filter:   commanders.DatabaseFilter{Include: []string{"sales", "hr_*"}},
+			expected: []string{"sales", "hr_east", "hr_west"},
+		},
+		{
+			name:     "does not select excluded databases",
+			filter:   commanders.DatabaseFilter{Exclude: []string{"*hr*"}},
+			expected: []string{"postgres", "sales"},
+		},
+		{
+			name:     "excludes take precedence over includes",
+			filter:   commanders.DatabaseFilter{Include: []string{"hr_*"}, Exclude: []string{"hr_west"}},
+			expected: []string{"hr_east"},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var actual []string
+			for _, database := range c.filter.Filter(databases) {
+				actual = append(actual, database.Datname)
+			}
+
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("got %v want %v", actual, c.expected)
+			}
+		})
+	}
+}
+
+func TestDatabaseSelection(t *testing.T) {
+	t.Run("writes and reads the selection", func(t *testing.T) {
+		currentDir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, currentDir)
+
+		filter := commanders.DatabaseFilter{Include: []string{"hr_*"}}
+		err := commanders.WriteDatabaseSelection(currentDir, filter, []commanders.DatabaseInfo{{Datname: "hr_east"}, {Datname: "hr_west"}})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		selection, err := commanders.ReadDatabaseSelection(os.DirFS(currentDir))
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := commanders.DatabaseSelection{Filter: filter, Databases: []string{"hr_east", "hr_west"}}
+		if !reflect.DeepEqual(selection, expected) {
+			t.Errorf("got %#v want %#v", selection, expected)
+		}
+
+		testutils.PathMustExist(t, filepath.Join(currentDir, commanders.DatabaseSelectionFile))
+	})
+
+	t.Run("selects all databases when no selection was recorded", func(t *testing.T) {
+		selection, err := commanders.ReadDatabaseSelection(fstest.MapFS{})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if !selection.All() {
+			t.Errorf("expected selection %#v to select all databases", selection)
+		}
+	})
+
+	t.Run("errors when failing to read the selection", func(t *testing.T) {
+		utils.System.ReadFileFS = func(fsys fs.FS, name string) ([]byte, error) {
+			return nil, os.ErrPermission
+		}
+		defer utils.ResetSystemFunctions()
+
+		_, err := commanders.ReadDatabaseSelection(fstest.MapFS{})
+		if !errors.Is(err, os.ErrPermission) {
+			t.Errorf("got error %#v want %#v", err, os.ErrPermission)
+		}
+	})
+
+	t.Run("selects only recorded databases matching the filter", func(t *testing.T) {
+		selection := commanders.DatabaseSelection{
+			Filter:    commanders.DatabaseFilter{Exclude: []string{"hr_west"}},
+			Databases: []string{"hr_east", "hr_west"},
+		}
+
+		for datname, expected := range map[string]bool{"hr_east": true, "hr_west": false, "sales": false} {
+			if selection.Selected(datname) != expected {
+				t.Errorf("got selected %t for %q want %t", !expected, datname, expected)
+			}
+		}
+	})
+}
@@ -0,0 +1,288 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+type DiffStatus string
+
+const (
+	ScriptAdded   DiffStatus = "added"
+	ScriptRemoved DiffStatus = "removed"
+	ScriptChanged DiffStatus = "changed"
+)
+
+// ScriptDiff describes how a single generated data migration script differs
+// between the previously generated scripts and a fresh generation. Added and
+// Removed contain the statements, typically one per object, that differ.
+type ScriptDiff struct {
+	Phase    string
+	Database string
+	Script   string
+	Status   DiffStatus
+	Added    []string
+	Removed  []string
+}
+
+type ScriptDiffs []ScriptDiff
+
+func (d ScriptDiffs) Len() int {
+	return len(d)
+}
+
+func (d ScriptDiffs) Less(i, j int) bool {
+	if d[i].Phase != d[j].Phase {
+		return d[i].Phase < d[j].Phase
+	}
+
+	if d[i].Database != d[j].Database {
+		return d[i].Database < d[j].Database
+	}
+
+	return d[i].Script < d[j].Script
+}
+
+func (d ScriptDiffs) Swap(i, j int) {
+	d[i], d[j] = d[j], d[i]
+}
+
+func (d ScriptDiffs) String() string {
+	if len(d) == 0 {
+		return "  No differences found. The previously generated scripts are up to date.\n"
+	}
+
+	sort.Sort(d)
+
+	var output string
+	var phase, database string
+	for _, diff := range d {
+		if diff.Phase != phase {
+			output += fmt.Sprintf("\n%s\n", diff.Phase)
+			phase = diff.Phase
+			database = ""
+		}
+
+		if diff.Database != database {
+			output += fmt.Sprintf("  database %s\n", diff.Database)
+			database = diff.Database
+		}
+
+		output += fmt.Sprintf("    %s %s\n", diff.Status, diff.Script)
+		for _, statement := range diff.Added {
+			output += fmt.Sprintf("      + %s\n", statement)
+		}
+
+		for _, statement := range diff.Removed {
+			output += fmt.Sprintf("      - %s\n", statement)
+		}
+	}
+
+	return output
+}
+
+// DiffDataMigrationScripts regenerates the data migration scripts for the
+// previously selected databases into a temporary directory and shows how they
+// differ from the previously generated scripts in outputDir without modifying
+// them.
+func DiffDataMigrationScripts(streams step.OutStreams, gphome string, port int, seedDir string, outputDir string, jobs uint) (err error) {
+	currentDir := filepath.Join(outputDir, "current")
+	exist, err := upgrade.PathExist(currentDir)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		return fmt.Errorf("No previously generated data migration scripts found in %q. Run \"gpupgrade generate\" first.", currentDir)
+	}
+
+	selection, err := ReadDatabaseSelection(utils.System.DirFS(currentDir))
+	if err != nil {
+		return err
+	}
+
+	seedDir, err = versionedSeedDir(gphome, seedDir)
+	if err != nil {
+		return err
+	}
+
+	if seedDir == "" {
+		return nil
+	}
+
+	regeneratedDir, err := os.MkdirTemp(outputDir, "diff-")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if rErr := utils.System.RemoveAll(regeneratedDir); rErr != nil {
+			err = errorlist.Append(err, rErr)
+		}
+	}()
+
+	db, err := bootstrapConnectionFunc(idl.ClusterDestination_source, gphome, port)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := db.Close(); cErr != nil {
+			err = errorlist.Append(err, cErr)
+		}
+	}()
+
+	err = generateScripts(streams, db, gphome, port, seedDir, regeneratedDir, selection.Filter, jobs)
+	if err != nil {
+		return err
+	}
+
+	diffs, err := CompareDataMigrationScripts(utils.System.DirFS(currentDir), utils.System.DirFS(filepath.Join(regeneratedDir, "current")))
+	if err != nil {
+		return err
+	}
+
+	_, err = fmt.Fprintf(streams.Stdout(), "\nDifferences between the previously generated scripts in\n%s\nand a fresh generation:\n%s\n", utils.Bold.Sprint(currentDir), diffs)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// CompareDataMigrationScripts compares the generated scripts per phase and
+// database. The generated scripts consist of one statement per line after the
+// header, so statements are compared line by line.
+func CompareDataMigrationScripts(currentFS fs.FS, regeneratedFS fs.FS) (ScriptDiffs, error) {
+	current, err := readGeneratedScripts(currentFS)
+	if err != nil {
+		return nil, err
+	}
+
+	regenerated, err := readGeneratedScripts(regeneratedFS)
+	if err != nil {
+		return nil, err
+	}
+
+	var diffs ScriptDiffs
+	for path, script := range regenerated {
+		previous, ok := current[path]
+		if !ok {
+			diffs = append(diffs, ScriptDiff{Phase: script.phase, Database: script.database, Script: script.name, Status: ScriptAdded, Added: script.statements})
+			continue
+		}
+
+		added := difference(script.statements, previous.statements)
+		removed := difference(previous.statements, script.statements)
+		if len(added) == 0 && len(removed) == 0 {
+			continue
+		}
+
+		diffs = append(diffs, ScriptDiff{Phase: script.phase, Database: script.database, Script: script.name, Status: ScriptChanged, Added: added, Removed: removed})
+	}
+
+	for path, script := range current {
+		if _, ok := regenerated[path]; ok {
+			continue
+		}
+
+		diffs = append(diffs, ScriptDiff{Phase: script.phase, Database: script.database, Script: script.name, Status: ScriptRemoved, Removed: script.statements})
+	}
+
+	sort.Sort(diffs)
+	return diffs, nil
+}
+
+type generatedScript struct {
+	phase      string
+	database   string
+	name       string
+	statements []string
+}
+
+func readGeneratedScripts(fsys fs.FS) (map[string]generatedScript, error) {
+	scripts := make(map[string]generatedScript)
+
+	err := fs.WalkDir(fsys, ".", func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) && path == "." {
+				return fs.SkipDir
+			}
+
+			return err
+		}
+
+		if entry.IsDir() || filepath.Ext(path) != ".sql" {
+			return nil
+		}
+
+		// Generated scripts are located in <phase>/<script directory>/<script>.
+		parts := strings.SplitN(path, "/", 2)
+		if len(parts) != 2 || !isPhase(parts[0]) {
+			return nil
+		}
+
+		contents, err := utils.System.ReadFileFS(fsys, path)
+		if err != nil {
+			return err
+		}
+
+		database, statements := parseGeneratedScript(string(contents))
+		scripts[path] = generatedScript{phase: parts[0], database: database, name: parts[1], statements: statements}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return scripts, nil
+}
+
+// parseGeneratedScript returns the database from the leading connect
+// statement along with the remaining statements ignoring comments.
+func parseGeneratedScript(contents string) (string, []string) {
+	var database string
+	var statements []string
+	for _, line := range strings.Split(contents, "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "" || strings.HasPrefix(line, "--"):
+			continue
+		case strings.HasPrefix(line, `\c `) && database == "":
+			database = strings.TrimSpace(strings.TrimPrefix(line, `\c `))
+		default:
+			statements = append(statements, line)
+		}
+	}
+
+	return database, statements
+}
+
+// difference returns the elements of a not in b preserving order.
+func difference(a []string, b []string) []string {
+	exists := make(map[string]bool, len(b))
+	for _, elem := range b {
+		exists[elem] = true
+	}
+
+	var diff []string
+	for _, elem := range a {
+		if !exists[elem] {
+			diff = append(diff, elem)
+		}
+	}
+
+	return diff
+}
@@ -0,0 +1,155 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+func TestCompareDataMigrationScripts(t *testing.T) {
+	indexes := filepath.Join(idl.Step_initialize.String(), "partitioned_tables_indexes", "migration_postgres_gen_drop_partition_indexes.sql")
+	constraints := filepath.Join(idl.Step_initialize.String(), "unique_primary_foreign_key_constraint", "migration_testdb_gen_drop_constraint_2_primary_unique.sql")
+	tsquery := filepath.Join(idl.Step_revert.String(), "tables_using_tsquery_type", "migration_postgres_gen_change_text_to_tsquery.sql")
+
+	t.Run("returns no differences when the scripts are the same", func(t *testing.T) {
+		fsys := fstest.MapFS{
+			indexes: {Data: []byte("\\c postgres\nDROP INDEX IF EXISTS public.idx1 ;\n")},
+		}
+
+		diffs, err := commanders.CompareDataMigrationScripts(fsys, fsys)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(diffs) != 0 {
+			t.Errorf("got diffs %v want none", diffs)
+		}
+
+		expected := "No differences found"
+		if !strings.Contains(diffs.String(), expected) {
+			t.Errorf("expected %q to contain %q", diffs.String(), expected)
+		}
+	})
+
+	t.Run("returns added, removed, and changed scripts per phase and database", func(t *testing.T) {
+		current := fstest.MapFS{
+			indexes: {Data: []byte("\\c postgres\n-- header comment\nDROP INDEX IF EXISTS public.idx1 ;\nDROP INDEX IF EXISTS public.idx2 ;\n")},
+			tsquery: {Data: []byte("\\c postgres\nALTER TABLE public.t1 ALTER COLUMN c TYPE TSQUERY;\n")},
+		}
+
+		regenerated := fstest.MapFS{
+			indexes:     {Data: []byte("\\c postgres\n-- header comment\nDROP INDEX IF EXISTS public.idx2 ;\nDROP INDEX IF EXISTS public.idx3 ;\n")},
+			constraints: {Data: []byte("\\c testdb\nALTER TABLE public.t2 DROP CONSTRAINT t2_pkey;\n")},
+		}
+
+		diffs, err := commanders.CompareDataMigrationScripts(current, regenerated)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := commanders.ScriptDiffs{
+			{
+				Phase:    idl.Step_initialize.String(),
+				Database: "postgres",
+				Script:   filepath.Join("partitioned_tables_indexes", "migration_postgres_gen_drop_partition_indexes.sql"),
+				Status:   commanders.ScriptChanged,
+				Added:    []string{"DROP INDEX IF EXISTS public.idx3 ;"},
+				Removed:  []string{"DROP INDEX IF EXISTS public.idx1 ;"},
+			},
+			{
+				Phase:    idl.Step_initialize.String(),
+				Database: "testdb",
+				Script:   filepath.Join("unique_primary_foreign_key_constraint", "migration_testdb_gen_drop_constraint_2_primary_unique.sql"),
+				Status:   commanders.ScriptAdded,
+				Added:    []string{"ALTER TABLE public.t2 DROP CONSTRAINT t2_pkey;"},
+			},
+			{
+				Phase:    idl.Step_revert.String(),
+				Database: "postgres",
+				Script:   filepath.Join("tables_using_tsquery_type", "migration_postgres_gen_change_text_to_tsquery.sql"),
+				Status:   commanders.ScriptRemoved,
+				Removed:  []string{"ALTER TABLE public.t1 ALTER COLUMN c TYPE TSQUERY;"},
+			},
+		}
+
+		if !reflect.DeepEqual(diffs, expected) {
+			t.Errorf("got  %#v", diffs)
+			t.Errorf("want %#v", expected)
+		}
+
+		output := diffs.String()
+		for _, part := range []string{
+			"\ninitialize\n  database postgres\n    changed partitioned_tables_indexes/migration_postgres_gen_drop_partition_indexes.sql\n" +
+				"      + DROP INDEX IF EXISTS public.idx3 ;\n      - DROP INDEX IF EXISTS public.idx1 ;\n",
+			"  database testdb\n    added unique_primary_foreign_key_constraint/migration_testdb_gen_drop_constraint_2_primary_unique.sql\n",
+			"\nrevert\n  database postgres\n    removed tables_using_tsquery_type/migration_postgres_gen_change_text_to_tsquery.sql\n",
+		} {
+			if !strings.Contains(output, part) {
+				t.Errorf("expected output %q to contain %q", output, part)
+			}
+		}
+	})
+
+	t.Run("ignores files outside of phase directories", func(t *testing.T) {
+		regenerated := fstest.MapFS{
+			"apply_initialize.checkpoint": {Data: []byte("something")},
+			"notes.sql":                   {Data: []byte("SELECT 1;")},
+		}
+
+		diffs, err := commanders.CompareDataMigrationScripts(fstest.MapFS{}, regenerated)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(diffs) != 0 {
+			t.Errorf("got diffs %v want none", diffs)
+		}
+	})
+
+	t.Run("errors when failing to read a script", func(t *testing.T) {
+		utils.System.ReadFileFS = func(fsys fs.FS, name string) ([]byte, error) {
+			return nil, os.ErrPermission
+		}
+		defer utils.ResetSystemFunctions()
+
+		fsys := fstest.MapFS{
+			indexes: {Data: []byte("\\c postgres\n")},
+		}
+
+		diffs, err := commanders.CompareDataMigrationScripts(fsys, fsys)
+		if !errors.Is(err, os.ErrPermission) {
+			t.Errorf("got error %#v want %#v", err, os.ErrPermission)
+		}
+
+		if diffs != nil {
+			t.Error("expected nil diffs")
+		}
+	})
+}
+
+func TestDiffDataMigrationScripts(t *testing.T) {
+	t.Run("errors when there are no previously generated scripts", func(t *testing.T) {
+		outputDir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, outputDir)
+
+		err := commanders.DiffDataMigrationScripts(step.DevNullStream, "", 0, "", outputDir, commanders.DefaultDataMigrationJobs)
+		expected := "No previously generated data migration scripts found"
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("got error %#v want %q", err, expected)
+		}
+	})
+}
@@ -30,22 +30,17 @@ import (
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
 )
 
-func GenerateDataMigrationScripts(streams step.OutStreams, nonInteractive bool, gphome string, port int, seedDir string, outputDir string, outputDirFS fs.FS) error {
-	version, err := greenplum.Version(gphome)
+// GenerateDataMigrationScripts generates the data migration scripts for the
+// databases selected by the filter generating at most jobs databases in
+// parallel, or all of them when jobs is 0.
+func GenerateDataMigrationScripts(streams step.OutStreams, nonInteractive bool, gphome string, port int, seedDir string, outputDir string, outputDirFS fs.FS, filter DatabaseFilter, jobs uint) error {
+	versionedDir, err := versionedSeedDir(gphome, seedDir)
 	if err != nil {
 		return err
 	}
 
-	switch {
-	case version.Major == 5:
-		seedDir = filepath.Join(seedDir, "5-to-6-seed-scripts")
-	case version.Major == 6:
-		seedDir = filepath.Join(seedDir, "6-to-7-seed-scripts")
-	case version.Major == 7:
-		// seedDir = filepath.Join(seedDir, "7-to-8-seed-scripts")
-		return nil // TODO: Remove once there are 7 > 8 data migration scripts
-	default:
-		return fmt.Errorf("failed to find seed scripts for Greenplum version %s under %q", version, seedDir)
+	if versionedDir == "" {
+		return nil
 	}
 
 	db, err := bootstrapConnectionFunc(idl.ClusterDestination_source, gphome, port)
@@ -63,7 +58,11 @@ func GenerateDataMigrationScripts(streams step.OutStreams, nonInteractive bool,
 		return err
 	}
 
-	err = ArchiveDataMigrationScriptsPrompt(streams, nonInteractive, utils.StdinReader, outputDirFS, outputDir)
+	diff := func() error {
+		return DiffDataMigrationScripts(streams, gphome, port, seedDir, outputDir, jobs)
+	}
+
+	err = ArchiveDataMigrationScriptsPrompt(streams, nonInteractive, utils.StdinReader, outputDirFS, outputDir, diff)
 	if err != nil {
 		if errors.Is(err, step.Skip) {
 			return nil
@@ -72,12 +71,71 @@ func GenerateDataMigrationScripts(streams step.OutStreams, nonInteractive bool,
 		return err
 	}
 
+	err = generateScripts(streams, db, gphome, port, versionedDir, outputDir, filter, jobs)
+	if err != nil {
+		return err
+	}
+
+	logDir, err := utils.GetLogDir()
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("\nGenerated scripts:%s\nLogs: %s\n\n", utils.Bold.Sprint(filepath.Join(outputDir, "current")), utils.Bold.Sprint(logDir))
+
+	return nil
+}
+
+// versionedSeedDir returns the seed scripts directory for the Greenplum
+// version in gphome. An empty directory is returned when there are no seed
+// scripts for the version.
+func versionedSeedDir(gphome string, seedDir string) (string, error) {
+	version, err := greenplum.Version(gphome)
+	if err != nil {
+		return "", err
+	}
+
+	switch {
+	case version.Major == 5:
+		return filepath.Join(seedDir, "5-to-6-seed-scripts"), nil
+	case version.Major == 6:
+		return filepath.Join(seedDir, "6-to-7-seed-scripts"), nil
+	case version.Major == 7:
+		// return filepath.Join(seedDir, "7-to-8-seed-scripts"), nil
+		return "", nil // TODO: Remove once there are 7 > 8 data migration scripts
+	default:
+		return "", fmt.Errorf("failed to find seed scripts for Greenplum version %s under %q", version, seedDir)
+	}
+}
+
+// generateScripts generates the data migration scripts for the databases
+// selected by the filter into outputDir/current and records the selection.
+func generateScripts(streams step.OutStreams, db *sql.DB, gphome string, port int, seedDir string, outputDir string, filter DatabaseFilter, jobs uint) error {
 	databases, err := GetDatabases(db, utils.System.DirFS(seedDir))
 	if err != nil {
 		return err
 	}
 
-	_, err = fmt.Fprintf(streams.Stdout(), "\nGenerating data migration scripts for %d databases...\n", len(databases))
+	selected := filter.Filter(databases)
+	if len(selected) == 0 {
+		return fmt.Errorf("No databases found matching databases %q and exclude databases %q.", filter.Include, filter.Exclude)
+	}
+
+	if excludesGlobalScripts(databases, selected) {
+		_, err = fmt.Fprintf(streams.Stdout(), "\nWarning: The postgres database is not selected. Cluster wide scripts such as stats and gphdfs roles\n"+
+			"are only generated for the postgres database and will not be generated. Include postgres to generate them.\n")
+		if err != nil {
+			return err
+		}
+	}
+
+	databases = selected
+
+	if jobs == 0 || jobs > uint(len(databases)) {
+		jobs = uint(len(databases))
+	}
+
+	_, err = fmt.Fprintf(streams.Stdout(), "\nGenerating data migration scripts for %d databases using %d jobs...\n", len(databases), jobs)
 	if err != nil {
 		return err
 	}
@@ -85,6 +143,7 @@ func GenerateDataMigrationScripts(streams step.OutStreams, nonInteractive bool,
 	progressBar := mpb.New()
 	var wg sync.WaitGroup
 	errChan := make(chan error, len(databases))
+	semaphore := make(chan struct{}, jobs)
 
 	for _, database := range databases {
 		wg.Add(1)
@@ -96,7 +155,10 @@ func GenerateDataMigrationScripts(streams step.OutStreams, nonInteractive bool,
 		go func(streams step.OutStreams, database DatabaseInfo, gphome string, port int, seedDir string, outputDir string, bar *mpb.Bar) {
 			defer wg.Done()
 
-			err = GenerateScriptsPerDatabase(streams, database, gphome, port, seedDir, outputDir, bar)
+			semaphore <- struct{}{}
+			defer func() { <-semaphore }()
+
+			err := GenerateScriptsPerDatabase(streams, database, gphome, port, seedDir, outputDir, bar)
 			if err != nil {
 				errChan <- err
 				bar.Abort(false)
@@ -119,14 +181,7 @@ func GenerateDataMigrationScripts(streams step.OutStreams, nonInteractive bool,
 		return errs
 	}
 
-	logDir, err := utils.GetLogDir()
-	if err != nil {
-		return err
-	}
-
-	fmt.Printf("\nGenerated scripts:%s\nLogs: %s\n\n", utils.Bold.Sprint(filepath.Join(outputDir, "current")), utils.Bold.Sprint(logDir))
-
-	return nil
+	return WriteDatabaseSelection(filepath.Join(outputDir, "current"), filter, databases)
 }
 
 var bootstrapConnectionFunc = connection.Bootstrap
@@ -141,7 +196,11 @@ func ResetBootstrapConnectionFunction() {
 	bootstrapConnectionFunc = connection.Bootstrap
 }
 
-func ArchiveDataMigrationScriptsPrompt(streams step.OutStreams, nonInteractive bool, reader *bufio.Reader, outputDirFS fs.FS, outputDir string) error {
+// ArchiveDataMigrationScriptsPrompt asks whether to archive previously
+// generated scripts. Selecting diff calls the diff function to show how the
+// previously generated scripts differ from a fresh generation before asking
+// again.
+func ArchiveDataMigrationScriptsPrompt(streams step.OutStreams, nonInteractive bool, reader *bufio.Reader, outputDirFS fs.FS, outputDir string, diff func() error) error {
 	outputDirEntries, err := utils.System.ReadDirFS(outputDirFS, ".")
 	if err != nil {
 		return err
@@ -189,6 +248,7 @@ to detect the newly added objects.`, currentDirModTime.Format(time.RFC1123Z), ut
 			fmt.Printf(`
   [a]rchive and re-generate scripts
   [c]ontinue using previously generated scripts
+  [d]iff previously generated scripts against a fresh generation
   [q]uit
 
 Select: `)
@@ -229,6 +289,13 @@ Select: `)
 		case "c":
 			fmt.Printf("\nContinuing with previously generated data migration scripts in\n%s\n", utils.Bold.Sprint(currentDir))
 			return step.Skip
+		case "d":
+			err = diff()
+			if err != nil {
+				return err
+			}
+
+			continue
 		case "q":
 			fmt.Print("\nQuitting...")
 			return step.Quit
@@ -305,6 +372,22 @@ func GenerateScriptsPerDatabase(streams step.OutStreams, database DatabaseInfo,
 	return nil
 }
 
+// excludesGlobalScripts returns whether the postgres database, which the
+// global scripts are generated for, was filtered out of the selected databases.
+func excludesGlobalScripts(databases []DatabaseInfo, selected []DatabaseInfo) bool {
+	return containsDatabase(databases, "postgres") && !containsDatabase(selected, "postgres")
+}
+
+func containsDatabase(databases []DatabaseInfo, name string) bool {
+	for _, database := range databases {
+		if database.Datname == name {
+			return true
+		}
+	}
+
+	return false
+}
+
 func isGlobalScript(script string, database string) bool {
 	// Generate one global script for the postgres database rather than all databases.
 	return database != "postgres" && (script == "gen_alter_gphdfs_roles.sql" || script == "generate_cluster_stats.sh")
@@ -312,6 +395,12 @@ func isGlobalScript(script string, database string) bool {
 
 func GenerateScriptsPerPhase(phase idl.Step, database DatabaseInfo, gphome string, port int, seedDir string, seedDirFS fs.FS, outputDir string, bar *mpb.Bar) error {
 	scriptDirs, err := fs.ReadDir(seedDirFS, phase.String())
+	if phase == idl.Step_revert && errors.Is(err, fs.ErrNotExist) {
+		// Revert scripts are written from the snapshots taken by the
+		// initialize seed scripts rather than generated from seed scripts.
+		return nil
+	}
+
 	if err != nil {
 		return err
 	}
@@ -326,6 +415,7 @@ func GenerateScriptsPerPhase(phase idl.Step, database DatabaseInfo, gphome strin
 			return rErr
 		}
 
+		var revertOutputs [][]byte
 		for _, script := range scripts {
 			if isGlobalScript(script.Name(), database.Datname) {
 				continue
@@ -333,10 +423,29 @@ func GenerateScriptsPerPhase(phase idl.Step, database DatabaseInfo, gphome strin
 
 			var scriptOutput []byte
 			if strings.HasSuffix(script.Name(), ".sql") {
-				scriptOutput, err = ApplySQLFile(gphome, port, database.Datname, filepath.Join(seedDir, phase.String(), scriptDir.Name(), script.Name()),
-					"-v", "ON_ERROR_STOP=1", "--no-align", "--tuples-only")
-				if err != nil {
-					return err
+				revertScript := strings.TrimSuffix(script.Name(), path.Ext(script.Name())) + ".revert"
+				_, sErr := fs.Stat(seedDirFS, filepath.Join(phase.String(), scriptDir.Name(), revertScript))
+				if sErr != nil && !errors.Is(sErr, fs.ErrNotExist) {
+					return sErr
+				}
+
+				if sErr == nil {
+					var revertOutput []byte
+					scriptOutput, revertOutput, err = generateWithRevertSnapshot(gphome, port, database.Datname,
+						filepath.Join(seedDir, phase.String(), scriptDir.Name(), script.Name()),
+						filepath.Join(seedDir, phase.String(), scriptDir.Name(), revertScript))
+					if err != nil {
+						return err
+					}
+
+					// Objects are restored in the reverse order they were dropped.
+					revertOutputs = append([][]byte{revertOutput}, revertOutputs...)
+				} else {
+					scriptOutput, err = ApplySQLFile(gphome, port, database.Datname, filepath.Join(seedDir, phase.String(), scriptDir.Name(), script.Name()),
+						"-v", "ON_ERROR_STOP=1", "--no-align", "--tuples-only")
+					if err != nil {
+						return err
+					}
 				}
 			}
 
@@ -378,11 +487,71 @@ func GenerateScriptsPerPhase(phase idl.Step, database DatabaseInfo, gphome strin
 
 			bar.Increment()
 		}
+
+		err = writeRevertSnapshot(database, outputDir, scriptDir.Name(), bytes.Join(revertOutputs, nil))
+		if err != nil {
+			return err
+		}
 	}
 
 	return nil
 }
 
+const revertSnapshotMarker = "__gpupgrade_revert_snapshot__"
+
+// generateWithRevertSnapshot executes the seed script along with its
+// companion revert seed script within a single serializable transaction.
+// Both see the same snapshot of the catalog such that the revert script
+// restores exactly the objects the generated script drops even if the catalog
+// changes afterwards.
+func generateWithRevertSnapshot(gphome string, port int, database string, seedScript string, revertSeedScript string) ([]byte, []byte, error) {
+	input := "BEGIN ISOLATION LEVEL SERIALIZABLE;\n" +
+		"\\i " + quoteMetaCommandArg(seedScript) + "\n" +
+		"\\echo " + revertSnapshotMarker + "\n" +
+		"\\i " + quoteMetaCommandArg(revertSeedScript) + "\n" +
+		"COMMIT;\n"
+
+	output, err := applySQL(gphome, port, database, input, "-v", "ON_ERROR_STOP=1", "--no-align", "--tuples-only")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	scriptOutput, revertOutput, found := bytes.Cut(output, []byte(revertSnapshotMarker+"\n"))
+	if !found {
+		return nil, nil, xerrors.Errorf("generating %q: expected revert snapshot marker in output %q", seedScript, output)
+	}
+
+	return scriptOutput, revertOutput, nil
+}
+
+// quoteMetaCommandArg quotes an argument to a psql meta-command such as \i.
+func quoteMetaCommandArg(arg string) string {
+	return "'" + strings.ReplaceAll(strings.ReplaceAll(arg, `\`, `\\`), "'", `\'`) + "'"
+}
+
+// writeRevertSnapshot writes the restore statements captured from the
+// initialize scripts as a revert script for the same script directory.
+func writeRevertSnapshot(database DatabaseInfo, outputDir string, scriptDir string, revertOutput []byte) error {
+	if len(bytes.TrimSpace(revertOutput)) == 0 {
+		return nil
+	}
+
+	var contents bytes.Buffer
+	contents.WriteString(`\c ` + database.QuotedDatname + "\n")
+	contents.WriteString("-- Restores the objects dropped by the initialize data migration scripts using\n")
+	contents.WriteString("-- their exact definitions captured when the scripts were generated.\n")
+	contents.Write(revertOutput)
+
+	outputPath := filepath.Join(outputDir, "current", idl.Step_revert.String(), scriptDir)
+	err := utils.System.MkdirAll(outputPath, 0700)
+	if err != nil {
+		return err
+	}
+
+	outputFile := "migration_" + database.QuotedDatname + "_restore_" + scriptDir + ".sql"
+	return utils.System.WriteFile(filepath.Join(outputPath, outputFile), contents.Bytes(), 0644)
+}
+
 type DatabaseInfo struct {
 	Datname        string
 	QuotedDatname  string
@@ -36,9 +36,16 @@ func PostgresGPVersion_6_7_1() {
 	fmt.Println("postgres (Greenplum Database) 6.7.1 build commit:a21de286045072d8d1df64fa48752b7dfac8c1b7")
 }
 
+func RevertSnapshotScript() {
+	fmt.Print("ALTER TABLE public.t1 DROP CONSTRAINT t1_pkey CASCADE;\n")
+	fmt.Print("__gpupgrade_revert_snapshot__\n")
+	fmt.Print("ALTER TABLE public.t1 ADD CONSTRAINT t1_pkey PRIMARY KEY (a);\n")
+}
+
 func init() {
 	exectest.RegisterMains(
 		PostgresGPVersion_6_7_1,
+		RevertSnapshotScript,
 	)
 }
 
@@ -53,7 +60,7 @@ func TestGenerateDataMigrationScripts(t *testing.T) {
 		}
 		defer utils.ResetSystemFunctions()
 
-		err := commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{})
+		err := commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{}, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		if !errors.Is(err, os.ErrPermission) {
 			t.Errorf("got error %#v want %#v", err, os.ErrPermission)
 		}
@@ -70,7 +77,7 @@ func TestGenerateDataMigrationScripts(t *testing.T) {
 
 		outputDirFS := fstest.MapFS{"current": {Mode: os.ModeDir}}
 
-		err := commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", outputDirFS)
+		err := commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", outputDirFS, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		if err != nil {
 			t.Errorf("unexpected error: %#v", err)
 		}
@@ -88,7 +95,7 @@ func TestGenerateDataMigrationScripts(t *testing.T) {
 		}
 		defer utils.ResetSystemFunctions()
 
-		err := commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{})
+		err := commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{}, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		if !errors.Is(err, expected) {
 			t.Errorf("got %v want %v", err, expected)
 		}
@@ -100,7 +107,7 @@ func TestGenerateDataMigrationScripts(t *testing.T) {
 		}
 		defer utils.ResetSystemFunctions()
 
-		err := commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{})
+		err := commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{}, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		expected := "invalid port"
 		if !strings.Contains(err.Error(), expected) {
 			t.Errorf("got %+v, want %+v", err, expected)
@@ -120,14 +127,14 @@ func TestArchiveDataMigrationScriptsPrompt(t *testing.T) {
 		}
 		defer utils.ResetSystemFunctions()
 
-		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, nil, fsys, "")
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, nil, fsys, "", nil)
 		if !errors.Is(err, expected) {
 			t.Errorf("got %v want %v", err, expected)
 		}
 	})
 
 	t.Run("returns if scripts are 'not' already generated and there is nothing to archive", func(t *testing.T) {
-		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, nil, fstest.MapFS{}, "")
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, nil, fstest.MapFS{}, "", nil)
 		if err != nil {
 			t.Errorf("unexpected error: %#v", err)
 		}
@@ -135,7 +142,7 @@ func TestArchiveDataMigrationScriptsPrompt(t *testing.T) {
 
 	t.Run("errors when failing to read input", func(t *testing.T) {
 		reader := bufio.NewReader(strings.NewReader(""))
-		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "")
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "", nil)
 		expected := io.EOF
 		if !errors.Is(err, expected) {
 			t.Errorf("got error %#v, want %#v", err, expected)
@@ -149,7 +156,7 @@ func TestArchiveDataMigrationScriptsPrompt(t *testing.T) {
 		testutils.MustCreateDir(t, filepath.Join(outputDir, "current"))
 
 		reader := bufio.NewReader(strings.NewReader("a\n"))
-		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, outputDir)
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, outputDir, nil)
 		if err != nil {
 			t.Errorf("unexpected error: %#v", err)
 		}
@@ -166,7 +173,7 @@ func TestArchiveDataMigrationScriptsPrompt(t *testing.T) {
 		defer utils.ResetSystemFunctions()
 
 		reader := bufio.NewReader(strings.NewReader("a\n"))
-		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "")
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "", nil)
 		if !errors.Is(err, os.ErrPermission) {
 			t.Errorf("got error %#v want %#v", err, os.ErrPermission)
 		}
@@ -179,7 +186,7 @@ func TestArchiveDataMigrationScriptsPrompt(t *testing.T) {
 		defer utils.ResetSystemFunctions()
 
 		reader := bufio.NewReader(strings.NewReader("a\n"))
-		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "")
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "", nil)
 		var exitError *exec.ExitError
 		if !errors.As(err, &exitError) {
 			t.Errorf("got %T, want %T", err, exitError)
@@ -188,16 +195,47 @@ func TestArchiveDataMigrationScriptsPrompt(t *testing.T) {
 
 	t.Run("returns skip error when user selects 'c'ontinue", func(t *testing.T) {
 		reader := bufio.NewReader(strings.NewReader("c\n"))
-		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "")
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "", nil)
 		expected := step.Skip
 		if !errors.Is(err, expected) {
 			t.Errorf("got error %#v, want %#v", err, expected)
 		}
 	})
 
+	t.Run("shows the diff and re-prompts when user selects 'd'iff", func(t *testing.T) {
+		diffCalled := false
+		diff := func() error {
+			diffCalled = true
+			return nil
+		}
+
+		reader := bufio.NewReader(strings.NewReader("d\nc\n"))
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "", diff)
+		if !errors.Is(err, step.Skip) {
+			t.Errorf("got error %#v, want %#v", err, step.Skip)
+		}
+
+		if !diffCalled {
+			t.Error("expected diff to be called")
+		}
+	})
+
+	t.Run("errors when diff fails", func(t *testing.T) {
+		expected := errors.New("permission denied")
+		diff := func() error {
+			return expected
+		}
+
+		reader := bufio.NewReader(strings.NewReader("d\n"))
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "", diff)
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v, want %#v", err, expected)
+		}
+	})
+
 	t.Run("returns canceled error when user selects 'q'uit", func(t *testing.T) {
 		reader := bufio.NewReader(strings.NewReader("q\n"))
-		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "")
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.DevNullStream, false, reader, fsys, "", nil)
 		expected := step.Quit
 		if !errors.Is(err, expected) {
 			t.Errorf("got error %#v, want %#v", err, expected)
@@ -208,7 +246,7 @@ func TestArchiveDataMigrationScriptsPrompt(t *testing.T) {
 		d := BufferStandardDescriptors(t)
 
 		reader := bufio.NewReader(strings.NewReader("b\nq\n"))
-		err := commanders.ArchiveDataMigrationScriptsPrompt(step.StdStreams, false, reader, fsys, "")
+		err := commanders.ArchiveDataMigrationScriptsPrompt(step.StdStreams, false, reader, fsys, "", nil)
 		if !errors.Is(err, step.Quit) {
 			t.Errorf("got error %#v, want %#v", err, step.Quit)
 		}
@@ -307,10 +345,68 @@ func TestGenerateScriptsPerDatabase(t *testing.T) {
 		}
 		defer utils.ResetSystemFunctions()
 
-		err = commanders.GenerateDataMigrationScripts(step.DevNullStream, true, "/usr/local/gpdb5", 0, "", outputDir, fstest.MapFS{})
+		err = commanders.GenerateDataMigrationScripts(step.DevNullStream, true, "/usr/local/gpdb5", 0, "", outputDir, fstest.MapFS{}, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
+		if err != nil {
+			t.Errorf("unexpected error: %#v", err)
+		}
+	})
+
+	t.Run("warns when the filter excludes the postgres database used for global scripts", func(t *testing.T) {
+		db, mock, err := sqlmock.New()
+		if err != nil {
+			t.Fatalf("couldn't create sqlmock: %v", err)
+		}
+		defer testutils.FinishMock(mock, t)
+
+		commanders.SetBootstrapConnectionFunction(func(destination idl.ClusterDestination, gphome string, port int) (*sql.DB, error) {
+			return db, nil
+		})
+		defer commanders.ResetBootstrapConnectionFunction()
+
+		outputDir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, outputDir)
+
+		testutils.MustCreateDir(t, filepath.Join(outputDir, "current"))
+
+		expectPgDatabaseToReturn(mock).WillReturnRows(sqlmock.NewRows([]string{"datname", "quoted_datname"}).AddRow("postgres", "postgres").AddRow("sales", "sales"))
+
+		commanders.SetPsqlCommand(exectest.NewCommand(Success))
+		defer commanders.ResetPsqlCommand()
+
+		commanders.SetPsqlFileCommand(exectest.NewCommand(Success))
+		defer commanders.ResetPsqlFileCommand()
+
+		utils.System.DirFS = func(dir string) fs.FS {
+			return fstest.MapFS{
+				idl.Step_initialize.String(): {Mode: os.ModeDir},
+				filepath.Join(idl.Step_initialize.String(), "unique_primary_foreign_key_constraint"):                                                                {Mode: os.ModeDir},
+				filepath.Join(idl.Step_initialize.String(), "unique_primary_foreign_key_constraint", "migration_postgres_gen_drop_constraint_2_primary_unique.sql"): {},
+				idl.Step_execute.String(): {Mode: os.ModeDir},
+				filepath.Join(idl.Step_execute.String(), "unique_primary_foreign_key_constraint"):                                                                {Mode: os.ModeDir},
+				filepath.Join(idl.Step_execute.String(), "unique_primary_foreign_key_constraint", "migration_postgres_gen_drop_constraint_2_primary_unique.sql"): {},
+				idl.Step_finalize.String(): {Mode: os.ModeDir},
+				filepath.Join(idl.Step_finalize.String(), "unique_primary_foreign_key_constraint"):                                                                {Mode: os.ModeDir},
+				filepath.Join(idl.Step_finalize.String(), "unique_primary_foreign_key_constraint", "migration_postgres_gen_drop_constraint_2_primary_unique.sql"): {},
+				idl.Step_revert.String(): {Mode: os.ModeDir},
+				filepath.Join(idl.Step_revert.String(), "unique_primary_foreign_key_constraint"):                                                                {Mode: os.ModeDir},
+				filepath.Join(idl.Step_revert.String(), "unique_primary_foreign_key_constraint", "migration_postgres_gen_drop_constraint_2_primary_unique.sql"): {},
+				idl.Step_stats.String(): {Mode: os.ModeDir},
+				filepath.Join(idl.Step_stats.String(), "unique_primary_foreign_key_constraint"):                                                                {Mode: os.ModeDir},
+				filepath.Join(idl.Step_stats.String(), "unique_primary_foreign_key_constraint", "migration_postgres_gen_drop_constraint_2_primary_unique.sql"): {},
+			}
+		}
+		defer utils.ResetSystemFunctions()
+
+		streams := &step.BufferedStreams{}
+		err = commanders.GenerateDataMigrationScripts(streams, true, "/usr/local/gpdb5", 0, "", outputDir, fstest.MapFS{}, commanders.DatabaseFilter{Exclude: []string{"postgres"}}, commanders.DefaultDataMigrationJobs)
 		if err != nil {
 			t.Errorf("unexpected error: %#v", err)
 		}
+
+		expected := "Warning: The postgres database is not selected."
+		if !strings.Contains(streams.StdoutBuf.String(), expected) {
+			t.Errorf("expected output %q to contain %q", streams.StdoutBuf.String(), expected)
+		}
 	})
 
 	t.Run("errors when creating plpythonu fails with other error", func(t *testing.T) {
@@ -340,7 +436,7 @@ func TestGenerateScriptsPerDatabase(t *testing.T) {
 		commanders.SetPsqlCommand(exectest.NewCommand(FailedMain))
 		defer commanders.ResetPsqlCommand()
 
-		err = commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{})
+		err = commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{}, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		var exitError *exec.ExitError
 		if !errors.As(err, &exitError) {
 			t.Errorf("got %T, want %T", err, exitError)
@@ -377,7 +473,7 @@ func TestGenerateScriptsPerDatabase(t *testing.T) {
 		commanders.SetPsqlFileCommand(exectest.NewCommand(FailedMain))
 		defer commanders.ResetPsqlFileCommand()
 
-		err = commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{})
+		err = commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{}, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		var exitError *exec.ExitError
 		if !errors.As(err, &exitError) {
 			t.Errorf("got %T, want %T", err, exitError)
@@ -414,14 +510,16 @@ func TestGenerateScriptsPerDatabase(t *testing.T) {
 		commanders.SetPsqlFileCommand(exectest.NewCommand(Success))
 		defer commanders.ResetPsqlFileCommand()
 
-		err = commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{})
+		err = commanders.GenerateDataMigrationScripts(step.DevNullStream, false, "", 0, "", "", fstest.MapFS{}, commanders.DatabaseFilter{}, commanders.DefaultDataMigrationJobs)
 		var errs errorlist.Errors
 		if !errors.As(err, &errs) {
 			t.Fatalf("got error %#v, want type %T", err, errs)
 		}
 
-		if len(errs) != len(commanders.MigrationScriptPhases) {
-			t.Errorf("got %d errors want %d", len(errs), len(commanders.MigrationScriptPhases))
+		// A missing revert seed directory is not an error.
+		expected := len(commanders.MigrationScriptPhases) - 1
+		if len(errs) != expected {
+			t.Errorf("got %d errors want %d", len(errs), expected)
 		}
 
 		for _, err := range errs {
@@ -459,6 +557,13 @@ func TestGenerateScriptsPerPhase(t *testing.T) {
 		}
 	})
 
+	t.Run("does not error when there is no revert seed directory", func(t *testing.T) {
+		err := commanders.GenerateScriptsPerPhase(idl.Step_revert, database, gphome, port, seedDir, fstest.MapFS{}, outputDir, bar)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+	})
+
 	t.Run("errors when no script directories are found in the seed directory", func(t *testing.T) {
 		fsys := fstest.MapFS{
 			phase.String(): {Mode: os.ModeDir},
@@ -733,6 +838,71 @@ func TestGenerateScriptsPerPhase(t *testing.T) {
 		}
 	})
 
+	t.Run("captures a revert snapshot when the seed script has a companion revert script", func(t *testing.T) {
+		scriptDir := "unique_primary_foreign_key_constraint"
+		commanders.SetPsqlFileCommand(exectest.NewCommandWithVerifier(RevertSnapshotScript, func(utility string, args ...string) {
+			expected := []string{"-v", "ON_ERROR_STOP=1", "--no-align", "--tuples-only", "--no-psqlrc", "--quiet", "-d", "postgres", "-p", "123", "-f", "-"}
+			if !reflect.DeepEqual(args, expected) {
+				t.Errorf("got args %q want %q", args, expected)
+			}
+		}))
+		defer commanders.ResetPsqlFileCommand()
+
+		utils.System.MkdirAll = func(path string, perm os.FileMode) error {
+			return nil
+		}
+		defer utils.ResetSystemFunctions()
+
+		written := make(map[string]string)
+		utils.System.WriteFile = func(filename string, data []byte, perm os.FileMode) error {
+			written[filename] = string(data)
+			return nil
+		}
+		defer utils.ResetSystemFunctions()
+
+		fsys := fstest.MapFS{
+			phase.String():                           {Mode: os.ModeDir},
+			filepath.Join(phase.String(), scriptDir): {Mode: os.ModeDir},
+			filepath.Join(phase.String(), scriptDir, "gen_drop_constraint_2_primary_unique.sql"):    {},
+			filepath.Join(phase.String(), scriptDir, "gen_drop_constraint_2_primary_unique.revert"): {},
+		}
+
+		err := commanders.GenerateScriptsPerPhase(phase, database, gphome, port, seedDir, fsys, outputDir, bar)
+		if err != nil {
+			t.Errorf("unexpected error: %#v", err)
+		}
+
+		expected := map[string]string{
+			filepath.Join(outputDir, "current", phase.String(), scriptDir, "migration_postgres_gen_drop_constraint_2_primary_unique.sql"): "\\c postgres\n" +
+				"ALTER TABLE public.t1 DROP CONSTRAINT t1_pkey CASCADE;\n",
+			filepath.Join(outputDir, "current", idl.Step_revert.String(), scriptDir, "migration_postgres_restore_unique_primary_foreign_key_constraint.sql"): "\\c postgres\n" +
+				"-- Restores the objects dropped by the initialize data migration scripts using\n" +
+				"-- their exact definitions captured when the scripts were generated.\n" +
+				"ALTER TABLE public.t1 ADD CONSTRAINT t1_pkey PRIMARY KEY (a);\n",
+		}
+		if !reflect.DeepEqual(written, expected) {
+			t.Errorf("got written files %q want %q", written, expected)
+		}
+	})
+
+	t.Run("errors when failing to capture the revert snapshot", func(t *testing.T) {
+		commanders.SetPsqlFileCommand(exectest.NewCommand(SuccessScript))
+		defer commanders.ResetPsqlFileCommand()
+
+		fsys := fstest.MapFS{
+			phase.String(): {Mode: os.ModeDir},
+			filepath.Join(phase.String(), "partitioned_tables_indexes"):                                      {Mode: os.ModeDir},
+			filepath.Join(phase.String(), "partitioned_tables_indexes", "gen_drop_partition_indexes.sql"):    {},
+			filepath.Join(phase.String(), "partitioned_tables_indexes", "gen_drop_partition_indexes.revert"): {},
+		}
+
+		err := commanders.GenerateScriptsPerPhase(phase, database, gphome, port, seedDir, fsys, outputDir, bar)
+		expected := "expected revert snapshot marker"
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("got error %#v want %q", err, expected)
+		}
+	})
+
 	t.Run("errors when failing to read header", func(t *testing.T) {
 		commanders.SetPsqlFileCommand(exectest.NewCommand(SuccessScript))
 		defer commanders.ResetPsqlFileCommand()
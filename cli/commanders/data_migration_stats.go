@@ -0,0 +1,441 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+const (
+	StatsReportJSONFile = "stats.json"
+	StatsReportCSVFile  = "stats.csv"
+)
+
+// statsMetrics maps the lower cased column names output by psql for the
+// cluster_and_database_stats seed scripts back to their names.
+var statsMetrics = map[string]string{
+	"installedextensions":  "InstalledExtensions",
+	"databases":            "Databases",
+	"triggers":             "Triggers",
+	"nondefaultparameters": "NonDefaultParameters",
+	"tablespaces":          "Tablespaces",
+	"schemas":              "Schemas",
+	"ordinarytables":       "OrdinaryTables",
+	"indextables":          "IndexTables",
+	"views":                "Views",
+	"sequences":            "Sequences",
+	"toasttables":          "ToastTables",
+	"aotables":             "AOTables",
+	"aocotables":           "AOCOTables",
+	"usertables":           "UserTables",
+	"externaltables":       "ExternalTables",
+	"aococolumns":          "AOCOColumns",
+	"rootpartitions":       "RootPartitions",
+	"childpartitions":      "ChildPartitions",
+	"indexes":              "Indexes",
+	"udfs":                 "UDFs",
+	"types":                "Types",
+}
+
+// StatsReport is the structured form of the output of the "stats" data
+// migration scripts. It is used for capacity planning such as estimating
+// disk space and upgrade duration, and for comparing clusters across
+// environments.
+type StatsReport struct {
+	Segments  int
+	Hosts     []HostStats
+	Databases []DatabaseStats
+	Totals    map[string]int64
+	Estimates StatsEstimates
+}
+
+type HostStats struct {
+	Hostname        string
+	Primaries       int
+	PrimarySegments int
+	Mirrors         int
+}
+
+type DatabaseStats struct {
+	Database  string
+	SizeBytes int64
+	Counts    map[string]int64
+}
+
+// StatsEstimates are derived from the report. Copy mode copies the data of
+// every primary segment, so the additional space needed on each host is
+// proportional to the number of primary segments on that host. The duration
+// of pg_upgrade is primarily driven by the number of catalog objects to dump
+// and restore, and in copy mode the amount of data to copy. Since hosts copy
+// their data in parallel the copy is bound by the host with the most data.
+type StatsEstimates struct {
+	TotalSizeBytes          int64
+	CatalogObjects          int64
+	CopyModeDiskBytesByHost map[string]int64
+	LinkModeDurationSeconds int64
+	CopyModeDurationSeconds int64
+}
+
+// The rates used to estimate the upgrade duration are deliberately
+// conservative. Actual rates depend on the hardware and the schema.
+const (
+	catalogObjectsPerSecond = 50
+	copyBytesPerSecond      = 100 << 20
+)
+
+type psqlResult struct {
+	columns []string
+	rows    [][]string
+}
+
+var rowCountRegex = regexp.MustCompile(`^\(\d+ rows?\)$`)
+var separatorRegex = regexp.MustCompile(`^[-+]+$`)
+
+// parsePsqlResults parses the aligned result tables from psql output ignoring
+// any echoed queries.
+func parsePsqlResults(output string) []psqlResult {
+	lines := strings.Split(output, "\n")
+
+	var results []psqlResult
+	for i := 0; i+1 < len(lines); i++ {
+		header := strings.TrimSpace(lines[i])
+		if header == "" || !separatorRegex.MatchString(strings.TrimSpace(lines[i+1])) {
+			continue
+		}
+
+		var result psqlResult
+		for _, column := range strings.Split(lines[i], "|") {
+			result.columns = append(result.columns, strings.ToLower(strings.TrimSpace(column)))
+		}
+
+		i += 2
+		for ; i < len(lines); i++ {
+			line := strings.TrimSpace(lines[i])
+			if rowCountRegex.MatchString(line) {
+				break
+			}
+
+			var row []string
+			for _, value := range strings.Split(lines[i], "|") {
+				row = append(row, strings.TrimSpace(value))
+			}
+
+			if len(row) == len(result.columns) {
+				result.rows = append(result.rows, row)
+			}
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// ParseStats parses the output from applying the "stats" data migration
+// scripts. Results following "SELECT current_database()" belong to that
+// database.
+func ParseStats(output []byte) (StatsReport, error) {
+	report := StatsReport{Totals: make(map[string]int64)}
+	hosts := make(map[string]*HostStats)
+	database := -1
+
+	for _, result := range parsePsqlResults(string(output)) {
+		switch {
+		case len(result.columns) == 1 && result.columns[0] == "current_database":
+			if len(result.rows) != 1 {
+				continue
+			}
+
+			// Several scripts are applied per database so merge their results.
+			database = indexOfDatabase(report.Databases, result.rows[0][0])
+			if database < 0 {
+				report.Databases = append(report.Databases, DatabaseStats{Database: result.rows[0][0], Counts: make(map[string]int64)})
+				database = len(report.Databases) - 1
+			}
+
+		case len(result.columns) == 2 && result.columns[0] == "hostname":
+			for _, row := range result.rows {
+				count, err := strconv.Atoi(row[1])
+				if err != nil {
+					return StatsReport{}, xerrors.Errorf("parse %s for host %q: %w", result.columns[1], row[0], err)
+				}
+
+				host, ok := hosts[row[0]]
+				if !ok {
+					host = &HostStats{Hostname: row[0]}
+					hosts[row[0]] = host
+				}
+
+				switch result.columns[1] {
+				case "primaries":
+					host.Primaries = count
+				case "primarysegments":
+					host.PrimarySegments = count
+				case "mirrors":
+					host.Mirrors = count
+				}
+			}
+
+		case len(result.columns) == 1 && len(result.rows) == 1 && database >= 0:
+			err := report.Databases[database].add(result.columns[0], result.rows[0][0])
+			if err != nil {
+				return StatsReport{}, err
+			}
+		}
+	}
+
+	for _, host := range hosts {
+		report.Hosts = append(report.Hosts, *host)
+		report.Segments += host.PrimarySegments
+	}
+
+	sort.Slice(report.Hosts, func(i, j int) bool {
+		return report.Hosts[i].Hostname < report.Hosts[j].Hostname
+	})
+
+	for _, database := range report.Databases {
+		for metric, count := range database.Counts {
+			// Cluster wide metrics are the same in every database.
+			if metric == "Databases" || metric == "NonDefaultParameters" || metric == "Tablespaces" {
+				report.Totals[metric] = count
+				continue
+			}
+
+			report.Totals[metric] += count
+		}
+	}
+
+	report.Estimates = estimate(report)
+	return report, nil
+}
+
+func indexOfDatabase(databases []DatabaseStats, name string) int {
+	for i, database := range databases {
+		if database.Database == name {
+			return i
+		}
+	}
+
+	return -1
+}
+
+func (d *DatabaseStats) add(column string, value string) error {
+	switch column {
+	case "databasesizebytes":
+		size, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return xerrors.Errorf("parse database size for %q: %w", d.Database, err)
+		}
+
+		d.SizeBytes = size
+	case "databasesize":
+		// Prefer the exact size from databasesizebytes when available.
+		if d.SizeBytes != 0 {
+			return nil
+		}
+
+		size, err := parsePrettySize(value)
+		if err != nil {
+			return xerrors.Errorf("parse database size for %q: %w", d.Database, err)
+		}
+
+		d.SizeBytes = size
+	default:
+		count, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			// ignore non-numeric results from queries that are not statistics
+			return nil
+		}
+
+		metric, ok := statsMetrics[column]
+		if !ok {
+			metric = column
+		}
+
+		d.Counts[metric] = count
+	}
+
+	return nil
+}
+
+// parsePrettySize reverses pg_size_pretty.
+func parsePrettySize(size string) (int64, error) {
+	parts := strings.Fields(size)
+	if len(parts) != 2 {
+		return 0, fmt.Errorf("unexpected size %q", size)
+	}
+
+	value, err := strconv.ParseInt(parts[0], 10, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	units := map[string]int64{"bytes": 1, "kB": 1 << 10, "MB": 1 << 20, "GB": 1 << 30, "TB": 1 << 40, "PB": 1 << 50}
+	multiplier, ok := units[parts[1]]
+	if !ok {
+		return 0, fmt.Errorf("unexpected size unit %q", parts[1])
+	}
+
+	return value * multiplier, nil
+}
+
+func estimate(report StatsReport) StatsEstimates {
+	estimates := StatsEstimates{CopyModeDiskBytesByHost: make(map[string]int64)}
+
+	for _, database := range report.Databases {
+		estimates.TotalSizeBytes += database.SizeBytes
+	}
+
+	for _, metric := range []string{"OrdinaryTables", "IndexTables", "Views", "Sequences", "ToastTables", "UDFs", "Types"} {
+		estimates.CatalogObjects += report.Totals[metric]
+	}
+
+	estimates.LinkModeDurationSeconds = divideRoundingUp(estimates.CatalogObjects, catalogObjectsPerSecond)
+	estimates.CopyModeDurationSeconds = estimates.LinkModeDurationSeconds
+
+	if report.Segments == 0 {
+		return estimates
+	}
+
+	var maxHostBytes int64
+	for _, host := range report.Hosts {
+		hostBytes := estimates.TotalSizeBytes * int64(host.PrimarySegments) / int64(report.Segments)
+		estimates.CopyModeDiskBytesByHost[host.Hostname] = hostBytes
+		if hostBytes > maxHostBytes {
+			maxHostBytes = hostBytes
+		}
+	}
+
+	estimates.CopyModeDurationSeconds += divideRoundingUp(maxHostBytes, copyBytesPerSecond)
+	return estimates
+}
+
+func divideRoundingUp(dividend int64, divisor int64) int64 {
+	return (dividend + divisor - 1) / divisor
+}
+
+// CSV returns the report with one metric per row in the form scope, name,
+// metric, value to ease comparing clusters across environments.
+func (r StatsReport) CSV() ([]byte, error) {
+	records := [][]string{{"scope", "name", "metric", "value"}}
+	add := func(scope string, name string, metric string, value int64) {
+		records = append(records, []string{scope, name, metric, strconv.FormatInt(value, 10)})
+	}
+
+	add("cluster", "", "Segments", int64(r.Segments))
+	add("cluster", "", "TotalSizeBytes", r.Estimates.TotalSizeBytes)
+	add("cluster", "", "CatalogObjects", r.Estimates.CatalogObjects)
+	add("cluster", "", "LinkModeDurationSeconds", r.Estimates.LinkModeDurationSeconds)
+	add("cluster", "", "CopyModeDurationSeconds", r.Estimates.CopyModeDurationSeconds)
+	for _, metric := range sortedKeys(r.Totals) {
+		add("cluster", "", metric, r.Totals[metric])
+	}
+
+	for _, host := range r.Hosts {
+		add("host", host.Hostname, "Primaries", int64(host.Primaries))
+		add("host", host.Hostname, "PrimarySegments", int64(host.PrimarySegments))
+		add("host", host.Hostname, "Mirrors", int64(host.Mirrors))
+		add("host", host.Hostname, "CopyModeDiskBytes", r.Estimates.CopyModeDiskBytesByHost[host.Hostname])
+	}
+
+	for _, database := range r.Databases {
+		add("database", database.Database, "SizeBytes", database.SizeBytes)
+		for _, metric := range sortedKeys(database.Counts) {
+			add("database", database.Database, metric, database.Counts[metric])
+		}
+	}
+
+	var buf bytes.Buffer
+	writer := csv.NewWriter(&buf)
+	err := writer.WriteAll(records)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func sortedKeys(m map[string]int64) []string {
+	var keys []string
+	for key := range m {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// WriteStatsReport writes the JSON and CSV reports to each directory that
+// exists and returns the paths written.
+func WriteStatsReport(report StatsReport, dirs ...string) ([]string, error) {
+	contents, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return nil, xerrors.Errorf("marshal stats report: %w", err)
+	}
+
+	csvContents, err := report.CSV()
+	if err != nil {
+		return nil, xerrors.Errorf("format stats report: %w", err)
+	}
+
+	var paths []string
+	for _, dir := range dirs {
+		exist, err := upgrade.PathExist(dir)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exist {
+			continue
+		}
+
+		for name, data := range map[string][]byte{StatsReportJSONFile: contents, StatsReportCSVFile: csvContents} {
+			path := filepath.Join(dir, name)
+			err = utils.System.WriteFile(path, data, 0644)
+			if err != nil {
+				return nil, err
+			}
+
+			paths = append(paths, path)
+		}
+	}
+
+	sort.Strings(paths)
+	return paths, nil
+}
+
+// ReadStatsReport reads a previously written JSON stats report.
+func ReadStatsReport(dirFS fs.FS) (StatsReport, error) {
+	contents, err := utils.System.ReadFileFS(dirFS, StatsReportJSONFile)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return StatsReport{}, xerrors.Errorf("no stats report found. Apply the %q data migration scripts first: %w", "stats", err)
+		}
+
+		return StatsReport{}, err
+	}
+
+	var report StatsReport
+	err = json.Unmarshal(contents, &report)
+	if err != nil {
+		return StatsReport{}, xerrors.Errorf("unmarshal stats report: %w", err)
+	}
+
+	return report, nil
+}
@@ -0,0 +1,248 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders_test
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+const clusterStatsOutput = `SELECT current_database();
+ current_database
+------------------
+ postgres
+(1 row)
+
+SELECT hostname, COUNT(dbid) AS Primaries FROM pg_catalog.gp_segment_configuration WHERE role='p' GROUP BY hostname;
+ hostname | primaries
+----------+-----------
+ sdw1     |         3
+ sdw2     |         2
+(2 rows)
+
+SELECT hostname, COUNT(dbid) AS Mirrors FROM pg_catalog.gp_segment_configuration WHERE role='m' GROUP BY hostname;
+ hostname | mirrors
+----------+---------
+ sdw1     |       2
+ sdw2     |       2
+(2 rows)
+
+SELECT hostname, COUNT(dbid) AS PrimarySegments FROM pg_catalog.gp_segment_configuration WHERE role='p' AND content >= 0 GROUP BY hostname;
+ hostname | primarysegments
+----------+-----------------
+ sdw1     |               2
+ sdw2     |               2
+(2 rows)
+
+SELECT COUNT(*) AS Databases FROM pg_database;
+ databases
+-----------
+         3
+(1 row)
+
+`
+
+const databaseStatsOutput = `SELECT current_database();
+ current_database
+------------------
+ %s
+(1 row)
+
+SELECT pg_size_pretty(pg_database_size(current_database())) AS DatabaseSize;
+ databasesize
+--------------
+ %s
+(1 row)
+
+SELECT pg_database_size(current_database()) AS DatabaseSizeBytes;
+ databasesizebytes
+-------------------
+ %s
+(1 row)
+
+SELECT COUNT(*) AS OrdinaryTables FROM pg_class WHERE relkind = 'r';
+ ordinarytables
+----------------
+ %s
+(1 row)
+
+`
+
+func databaseStats(database, prettySize, sizeBytes, tables string) string {
+	output := databaseStatsOutput
+	for _, value := range []string{database, prettySize, sizeBytes, tables} {
+		output = strings.Replace(output, "%s", value, 1)
+	}
+
+	return output
+}
+
+func TestParseStats(t *testing.T) {
+	t.Run("parses the stats output into a report with estimates", func(t *testing.T) {
+		output := clusterStatsOutput +
+			databaseStats("postgres", "4096 kB", "4194304", "10") +
+			databaseStats("sales", "8 MB", "8388608", "5")
+
+		report, err := commanders.ParseStats([]byte(output))
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := commanders.StatsReport{
+			Segments: 4,
+			Hosts: []commanders.HostStats{
+				{Hostname: "sdw1", Primaries: 3, PrimarySegments: 2, Mirrors: 2},
+				{Hostname: "sdw2", Primaries: 2, PrimarySegments: 2, Mirrors: 2},
+			},
+			Databases: []commanders.DatabaseStats{
+				{Database: "postgres", SizeBytes: 4194304, Counts: map[string]int64{"Databases": 3, "OrdinaryTables": 10}},
+				{Database: "sales", SizeBytes: 8388608, Counts: map[string]int64{"OrdinaryTables": 5}},
+			},
+			Totals: map[string]int64{"Databases": 3, "OrdinaryTables": 15},
+			Estimates: commanders.StatsEstimates{
+				TotalSizeBytes:          12582912,
+				CatalogObjects:          15,
+				CopyModeDiskBytesByHost: map[string]int64{"sdw1": 6291456, "sdw2": 6291456},
+				LinkModeDurationSeconds: 1,
+				CopyModeDurationSeconds: 2,
+			},
+		}
+
+		if !reflect.DeepEqual(report, expected) {
+			t.Errorf("got  %#v", report)
+			t.Errorf("want %#v", expected)
+		}
+	})
+
+	t.Run("estimates the copy mode duration from the host with the most data", func(t *testing.T) {
+		output := clusterStatsOutput + databaseStats("postgres", "1024 MB", "1073741824", "500")
+
+		report, err := commanders.ParseStats([]byte(output))
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if report.Estimates.LinkModeDurationSeconds != 10 {
+			t.Errorf("got link mode duration %d want %d", report.Estimates.LinkModeDurationSeconds, 10)
+		}
+
+		// 512 MiB per host at 100 MiB/s rounds up to 6 seconds.
+		if report.Estimates.CopyModeDurationSeconds != 16 {
+			t.Errorf("got copy mode duration %d want %d", report.Estimates.CopyModeDurationSeconds, 16)
+		}
+	})
+
+	t.Run("falls back to the pretty database size", func(t *testing.T) {
+		output := strings.Replace(databaseStats("postgres", "2 GB", "", "1"), "SELECT pg_database_size(current_database()) AS DatabaseSizeBytes;\n databasesizebytes\n-------------------\n \n(1 row)\n", "", 1)
+
+		report, err := commanders.ParseStats([]byte(output))
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if report.Databases[0].SizeBytes != 2<<30 {
+			t.Errorf("got size %d want %d", report.Databases[0].SizeBytes, 2<<30)
+		}
+	})
+
+	t.Run("errors when the database size is invalid", func(t *testing.T) {
+		_, err := commanders.ParseStats([]byte(databaseStats("postgres", "4096 kB", "lots", "1")))
+		expected := `parse database size for "postgres"`
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("got error %#v want %q", err, expected)
+		}
+	})
+}
+
+func TestStatsReport(t *testing.T) {
+	report := commanders.StatsReport{
+		Segments:  2,
+		Hosts:     []commanders.HostStats{{Hostname: "sdw1", Primaries: 2, PrimarySegments: 2, Mirrors: 0}},
+		Databases: []commanders.DatabaseStats{{Database: "postgres", SizeBytes: 100, Counts: map[string]int64{"Views": 2}}},
+		Totals:    map[string]int64{"Views": 2},
+		Estimates: commanders.StatsEstimates{TotalSizeBytes: 100, CatalogObjects: 2, CopyModeDiskBytesByHost: map[string]int64{"sdw1": 100}, LinkModeDurationSeconds: 1, CopyModeDurationSeconds: 2},
+	}
+
+	t.Run("formats the report as csv", func(t *testing.T) {
+		contents, err := report.CSV()
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := `scope,name,metric,value
+cluster,,Segments,2
+cluster,,TotalSizeBytes,100
+cluster,,CatalogObjects,2
+cluster,,LinkModeDurationSeconds,1
+cluster,,CopyModeDurationSeconds,2
+cluster,,Views,2
+host,sdw1,Primaries,2
+host,sdw1,PrimarySegments,2
+host,sdw1,Mirrors,0
+host,sdw1,CopyModeDiskBytes,100
+database,postgres,SizeBytes,100
+database,postgres,Views,2
+`
+		if string(contents) != expected {
+			t.Errorf("got %q want %q", contents, expected)
+		}
+	})
+
+	t.Run("writes and reads the report skipping directories that do not exist", func(t *testing.T) {
+		dir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, dir)
+
+		paths, err := commanders.WriteStatsReport(report, dir, filepath.Join(dir, "does-not-exist"))
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := []string{filepath.Join(dir, commanders.StatsReportCSVFile), filepath.Join(dir, commanders.StatsReportJSONFile)}
+		if !reflect.DeepEqual(paths, expected) {
+			t.Errorf("got %v want %v", paths, expected)
+		}
+
+		actual, err := commanders.ReadStatsReport(os.DirFS(dir))
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if !reflect.DeepEqual(actual, report) {
+			t.Errorf("got  %#v", actual)
+			t.Errorf("want %#v", report)
+		}
+	})
+
+	t.Run("errors when failing to write the report", func(t *testing.T) {
+		utils.System.WriteFile = func(filename string, data []byte, perm os.FileMode) error {
+			return os.ErrPermission
+		}
+		defer utils.ResetSystemFunctions()
+
+		dir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, dir)
+
+		_, err := commanders.WriteStatsReport(report, dir)
+		if !errors.Is(err, os.ErrPermission) {
+			t.Errorf("got error %#v want %#v", err, os.ErrPermission)
+		}
+	})
+
+	t.Run("errors when there is no report", func(t *testing.T) {
+		_, err := commanders.ReadStatsReport(fstest.MapFS{})
+		if !errors.Is(err, fs.ErrNotExist) {
+			t.Errorf("got error %#v want %#v", err, fs.ErrNotExist)
+		}
+	})
+}
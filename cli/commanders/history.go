@@ -0,0 +1,112 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/history"
+	"github.com/greenplum-db/gpupgrade/utils/stopwatch"
+)
+
+const historyTimeFormat = "2006-01-02 15:04:05"
+
+// ListHistory writes a summary of each recorded upgrade in either table or
+// JSON format.
+func ListHistory(w io.Writer, records []history.Record, format string) error {
+	if err := validateFormat(format); err != nil {
+		return err
+	}
+
+	if format == ConfigFormatJSON {
+		if records == nil {
+			records = []history.Record{}
+		}
+
+		return writeJSON(w, records)
+	}
+
+	if len(records) == 0 {
+		_, err := fmt.Fprintln(w, "No upgrades have been recorded.")
+		return err
+	}
+
+	var tw tabwriter.Writer
+	tw.Init(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(&tw, "upgrade_id\tcluster_name\tsource_version\ttarget_version\tmode\toutcome\tstart\tduration")
+	for _, r := range records {
+		fmt.Fprintf(&tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n", r.UpgradeID, r.ClusterName, r.SourceVersion, r.TargetVersion,
+			r.Mode, r.Outcome, formatTime(r.Start), stopwatch.Format(r.Duration()))
+	}
+
+	return tw.Flush()
+}
+
+// ShowHistory writes a recorded upgrade along with the duration of each
+// substep run in either table or JSON format.
+func ShowHistory(w io.Writer, record history.Record, format string) error {
+	if err := validateFormat(format); err != nil {
+		return err
+	}
+
+	if format == ConfigFormatJSON {
+		return writeJSON(w, record)
+	}
+
+	var tw tabwriter.Writer
+	tw.Init(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintf(&tw, "upgrade_id\t%s\n", record.UpgradeID)
+	fmt.Fprintf(&tw, "cluster_name\t%s\n", record.ClusterName)
+	fmt.Fprintf(&tw, "source_version\t%s\n", record.SourceVersion)
+	fmt.Fprintf(&tw, "target_version\t%s\n", record.TargetVersion)
+	fmt.Fprintf(&tw, "mode\t%s\n", record.Mode)
+	fmt.Fprintf(&tw, "hosts\t%s\n", strings.Join(record.Hosts, ", "))
+	fmt.Fprintf(&tw, "start\t%s\n", formatTime(record.Start))
+	fmt.Fprintf(&tw, "end\t%s\n", formatTime(record.End))
+	fmt.Fprintf(&tw, "duration\t%s\n", stopwatch.Format(record.Duration()))
+	fmt.Fprintf(&tw, "outcome\t%s\n", record.Outcome)
+
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	if len(record.Substeps) == 0 {
+		return nil
+	}
+
+	fmt.Fprintln(w)
+	tw.Init(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(&tw, "step\tsubstep\tstatus\tstart\tduration")
+	for _, s := range record.Substeps {
+		fmt.Fprintf(&tw, "%s\t%s\t%s\t%s\t%s\n", s.Step, s.Substep, s.Status, formatTime(s.Start), stopwatch.Format(s.Duration))
+	}
+
+	return tw.Flush()
+}
+
+func validateFormat(format string) error {
+	if format != ConfigFormatTable && format != ConfigFormatJSON {
+		return xerrors.Errorf("invalid format %q. Please specify either %s or %s.", format, ConfigFormatTable, ConfigFormatJSON)
+	}
+
+	return nil
+}
+
+func formatTime(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
+
+	return t.Local().Format(historyTimeFormat)
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/history"
+	"github.com/greenplum-db/gpupgrade/step"
+)
+
+func historyRecord() history.Record {
+	start := time.Date(2023, 5, 1, 10, 0, 0, 0, time.Local)
+
+	return history.Record{
+		UpgradeID:     "AAAAAAAAAAA",
+		SourceVersion: "6.25.0",
+		TargetVersion: "7.1.0",
+		Mode:          "link",
+		Hosts:         []string{"cdw", "sdw1"},
+		Start:         start,
+		End:           start.Add(90 * time.Minute),
+		Outcome:       history.Finalized,
+		Substeps: []step.SubstepDuration{
+			{Step: "initialize", Substep: "check_upgrade", Status: "complete", Start: start, Duration: 2500 * time.Millisecond},
+		},
+	}
+}
+
+func TestListHistory(t *testing.T) {
+	t.Run("writes a row per upgrade", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := commanders.ListHistory(&buf, []history.Record{historyRecord()}, commanders.ConfigFormatTable)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := `upgrade_id   cluster_name  source_version  target_version  mode  outcome    start                duration
+AAAAAAAAAAA                6.25.0          7.1.0           link  finalized  2023-05-01 10:00:00  1h30m0s
+`
+		if buf.String() != expected {
+			t.Errorf("got\n%s\nwant\n%s", buf.String(), expected)
+		}
+	})
+
+	t.Run("writes an empty JSON list when nothing is recorded", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := commanders.ListHistory(&buf, nil, commanders.ConfigFormatJSON)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if strings.TrimSpace(buf.String()) != "[]" {
+			t.Errorf("got %q want []", buf.String())
+		}
+	})
+
+	t.Run("errors on an invalid format", func(t *testing.T) {
+		err := commanders.ListHistory(&bytes.Buffer{}, nil, "yaml")
+		if err == nil || !strings.Contains(err.Error(), `invalid format "yaml"`) {
+			t.Errorf("got error %v", err)
+		}
+	})
+}
+
+func TestShowHistory(t *testing.T) {
+	t.Run("writes the upgrade and its substeps", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := commanders.ShowHistory(&buf, historyRecord(), commanders.ConfigFormatTable)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := `upgrade_id      AAAAAAAAAAA
+cluster_name    
+source_version  6.25.0
+target_version  7.1.0
+mode            link
+hosts           cdw, sdw1
+start           2023-05-01 10:00:00
+end             2023-05-01 11:30:00
+duration        1h30m0s
+outcome         finalized
+
+step        substep        status    start                duration
+initialize  check_upgrade  complete  2023-05-01 10:00:00  3s
+`
+		if buf.String() != expected {
+			t.Errorf("got\n%s\nwant\n%s", buf.String(), expected)
+		}
+	})
+
+	t.Run("writes JSON", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := commanders.ShowHistory(&buf, historyRecord(), commanders.ConfigFormatJSON)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		var record history.Record
+		err = json.Unmarshal(buf.Bytes(), &record)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := historyRecord()
+		if record.UpgradeID != expected.UpgradeID || !reflect.DeepEqual(record.Substeps[0].Duration, expected.Substeps[0].Duration) {
+			t.Errorf("got record %+v want %+v", record, expected)
+		}
+	})
+}
@@ -47,7 +47,14 @@ func StartHub(streams step.OutStreams) (err error) {
 		return step.Skip
 	}
 
-	cmd := execCommandHubStart("gpupgrade", "hub", "--daemonize")
+	args := []string{"hub", "--daemonize"}
+	if name := utils.GetClusterName(); name != "" {
+		// Pass the cluster name explicitly so that IsHubRunning can find the
+		// hub of this cluster.
+		args = append(args, "--"+utils.ClusterNameFlag, name)
+	}
+
+	cmd := execCommandHubStart("gpupgrade", args...)
 	log.Printf("Executing: %q", cmd.String())
 	output, err := cmd.CombinedOutput()
 	if err != nil {
@@ -63,8 +70,7 @@ func StartHub(streams step.OutStreams) (err error) {
 }
 
 func IsHubRunning() (bool, error) {
-	script := `ps -ef | grep -wGc "[g]pupgrade hub"` // use square brackets to avoid finding yourself in matches
-	_, err := execCommandHubCount("bash", "-c", script).Output()
+	_, err := execCommandHubCount("bash", "-c", hubCountScript(utils.GetClusterName())).Output()
 
 	if exitError, ok := err.(*exec.ExitError); ok {
 		if exitError.ProcessState.ExitCode() == 1 { // hub not found
@@ -77,3 +83,16 @@ func IsHubRunning() (bool, error) {
 
 	return true, nil
 }
+
+// hubCountScript counts the hub processes of the named cluster. Hubs of named
+// clusters are started with the cluster name flag, so the default cluster
+// counts only the hubs without one.
+func hubCountScript(clusterName string) string {
+	hubs := `ps -ef | grep -w "[g]pupgrade hub"` // use square brackets to avoid finding yourself in matches
+	flag := "--" + utils.ClusterNameFlag
+	if clusterName == "" {
+		return fmt.Sprintf(`%s | grep -vc -- "%s"`, hubs, flag)
+	}
+
+	return fmt.Sprintf(`%s | grep -c -- "%s %s\( \|$\)"`, hubs, flag, clusterName)
+}
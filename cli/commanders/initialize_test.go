@@ -14,6 +14,7 @@ import (
 
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/utils"
 )
 
 // Streams the above stdout/err constants to the corresponding standard file
@@ -219,3 +220,41 @@ func TestCreateStateDir(t *testing.T) {
 		}
 	})
 }
+
+func TestHubCountScript(t *testing.T) {
+	t.Run("excludes the hubs of named clusters for the default cluster", func(t *testing.T) {
+		script := hubCountScript("")
+		expected := `ps -ef | grep -w "[g]pupgrade hub" | grep -vc -- "--cluster-name"`
+		if script != expected {
+			t.Errorf("got %q want %q", script, expected)
+		}
+	})
+
+	t.Run("counts only the hubs of the named cluster", func(t *testing.T) {
+		script := hubCountScript("prod")
+		expected := `ps -ef | grep -w "[g]pupgrade hub" | grep -c -- "--cluster-name prod\( \|$\)"`
+		if script != expected {
+			t.Errorf("got %q want %q", script, expected)
+		}
+	})
+}
+
+func TestStartHub_PassesTheClusterName(t *testing.T) {
+	setup(t)
+	defer teardown()
+
+	t.Setenv(utils.ClusterNameEnv, "prod")
+
+	execCommandHubCount = exectest.NewCommand(IsHubRunning_False)
+	execCommandHubStart = exectest.NewCommandWithVerifier(GpupgradeHub_good_Main, func(name string, args ...string) {
+		expected := []string{"hub", "--daemonize", "--cluster-name", "prod"}
+		if !reflect.DeepEqual(args, expected) {
+			t.Errorf("got args %q want %q", args, expected)
+		}
+	})
+
+	err := StartHub(step.DevNullStream)
+	if err != nil {
+		t.Errorf("unexpected error %#v", err)
+	}
+}
@@ -0,0 +1,81 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/report"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+var ErrReportNotFound = errors.New("upgrade report not found")
+
+// FindReport returns the log archive directory holding the report of the
+// upgrade. When no upgrade ID is given the latest report of the cluster is
+// returned. Log archive directories are created next to the log directory by
+// finalize and revert.
+func FindReport(logDir string, upgradeID string) (string, report.Report, error) {
+	if strings.ContainsAny(upgradeID, `/*?[\`) {
+		return "", report.Report{}, xerrors.Errorf("upgrade %q: %w", upgradeID, ErrReportNotFound)
+	}
+
+	pattern := "gpupgrade-*-*"
+	if upgradeID != "" {
+		pattern = "gpupgrade-" + upgradeID + "-*"
+	}
+
+	dirs, err := filepath.Glob(filepath.Join(filepath.Dir(logDir), pattern, report.DataFileName))
+	if err != nil {
+		return "", report.Report{}, err
+	}
+
+	var latestDir string
+	var latest report.Report
+	var latestTime time.Time
+	for _, path := range dirs {
+		dir := filepath.Dir(path)
+
+		r, err := report.Read(dir)
+		if err != nil {
+			return "", report.Report{}, err
+		}
+
+		// Without an upgrade ID only consider reports of the selected cluster
+		// since clusters upgraded on the same hosts share the parent
+		// directory.
+		if upgradeID == "" && r.ClusterName != utils.GetClusterName() {
+			continue
+		}
+
+		if upgradeID != "" && r.UpgradeID != upgradeID {
+			continue
+		}
+
+		info, err := os.Stat(path)
+		if err != nil {
+			return "", report.Report{}, err
+		}
+
+		if latestDir == "" || info.ModTime().After(latestTime) {
+			latestDir, latest, latestTime = dir, r, info.ModTime()
+		}
+	}
+
+	if latestDir == "" {
+		if upgradeID != "" {
+			return "", report.Report{}, xerrors.Errorf("upgrade %q in %q: %w", upgradeID, filepath.Dir(logDir), ErrReportNotFound)
+		}
+
+		return "", report.Report{}, xerrors.Errorf("no finalized or reverted upgrade in %q: %w", filepath.Dir(logDir), ErrReportNotFound)
+	}
+
+	return latestDir, latest, nil
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/report"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+func TestFindReport(t *testing.T) {
+	dir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, dir)
+
+	logDir := filepath.Join(dir, "gpupgrade")
+
+	writeReport := func(t *testing.T, archive string, r report.Report, modTime time.Time) string {
+		t.Helper()
+
+		archiveDir := filepath.Join(dir, archive)
+		err := os.MkdirAll(archiveDir, 0700)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		_, err = report.Write(archiveDir, r)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		err = os.Chtimes(filepath.Join(archiveDir, report.DataFileName), modTime, modTime)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		return archiveDir
+	}
+
+	t.Run("errors when no reports exist", func(t *testing.T) {
+		_, _, err := commanders.FindReport(logDir, "")
+		if !errors.Is(err, commanders.ErrReportNotFound) {
+			t.Errorf("got error %#v want %#v", err, commanders.ErrReportNotFound)
+		}
+	})
+
+	now := time.Now()
+	older := writeReport(t, "gpupgrade-AAAAAAAAAAA-20230501T100000", report.Report{UpgradeID: "AAAAAAAAAAA"}, now.Add(-time.Hour))
+	latest := writeReport(t, "gpupgrade-BBBBBBBBBBB-20230502T100000", report.Report{UpgradeID: "BBBBBBBBBBB"}, now)
+	other := writeReport(t, "gpupgrade-CCCCCCCCCCC-20230503T100000", report.Report{UpgradeID: "CCCCCCCCCCC", ClusterName: "prod"}, now.Add(time.Hour))
+
+	t.Run("returns the latest report of the cluster without an upgrade ID", func(t *testing.T) {
+		archiveDir, r, err := commanders.FindReport(logDir, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if archiveDir != latest || r.UpgradeID != "BBBBBBBBBBB" {
+			t.Errorf("got %q %q want %q", archiveDir, r.UpgradeID, latest)
+		}
+	})
+
+	t.Run("only considers reports of the selected cluster", func(t *testing.T) {
+		t.Setenv(utils.ClusterNameEnv, "prod")
+
+		archiveDir, _, err := commanders.FindReport(logDir, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if archiveDir != other {
+			t.Errorf("got %q want %q", archiveDir, other)
+		}
+	})
+
+	t.Run("returns the report of the upgrade ID", func(t *testing.T) {
+		archiveDir, r, err := commanders.FindReport(logDir, "AAAAAAAAAAA")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if archiveDir != older || r.UpgradeID != "AAAAAAAAAAA" {
+			t.Errorf("got %q %q want %q", archiveDir, r.UpgradeID, older)
+		}
+	})
+
+	t.Run("errors when the upgrade ID is not found", func(t *testing.T) {
+		for _, id := range []string{"DDDDDDDDDDD", "*", "../AAAAAAAAAAA"} {
+			_, _, err := commanders.FindReport(logDir, id)
+			if !errors.Is(err, commanders.ErrReportNotFound) {
+				t.Errorf("got error %#v want %#v for %q", err, commanders.ErrReportNotFound, id)
+			}
+		}
+	})
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+)
+
+// RevertPlan writes the directories revert would delete and restore on each
+// host along with the result of their safety checks. Nothing is changed. An
+// error is returned if any of the safety checks failed.
+func RevertPlan(w io.Writer, client idl.CliToHubClient, partial bool) error {
+	reply, err := client.RevertPlan(context.Background(), &idl.RevertPlanRequest{Partial: partial})
+	if err != nil {
+		return xerrors.Errorf("revert plan: %w", err)
+	}
+
+	return WriteRevertPlan(w, reply.GetActions())
+}
+
+func WriteRevertPlan(w io.Writer, actions []*idl.RevertAction) error {
+	fmt.Fprintln(w, "Revert dry run. No changes have been made.")
+	fmt.Fprintln(w)
+
+	if len(actions) == 0 {
+		fmt.Fprintln(w, "Revert has no directories to delete or restore.")
+		return nil
+	}
+
+	sorted := make([]*idl.RevertAction, len(actions))
+	copy(sorted, actions)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].GetHost() != sorted[j].GetHost() {
+			return sorted[i].GetHost() < sorted[j].GetHost()
+		}
+
+		if sorted[i].GetType() != sorted[j].GetType() {
+			return sorted[i].GetType() < sorted[j].GetType()
+		}
+
+		return directories(sorted[i]) < directories(sorted[j])
+	})
+
+	var tw tabwriter.Writer
+	tw.Init(w, 0, 0, 2, ' ', 0)
+
+	var failed int
+	fmt.Fprintln(&tw, "host\taction\tdirectories\tverification")
+	for _, action := range sorted {
+		verification := "ok"
+		if action.GetVerificationError() != "" {
+			verification = "failed: " + action.GetVerificationError()
+			failed++
+		}
+
+		fmt.Fprintf(&tw, "%s\t%s\t%s\t%s\n", action.GetHost(), strings.ReplaceAll(action.GetType().String(), "_", " "), directories(action), verification)
+	}
+
+	err := tw.Flush()
+	if err != nil {
+		return err
+	}
+
+	if failed > 0 {
+		return xerrors.Errorf("%d of %d directories failed verification. Revert would fail.", failed, len(actions))
+	}
+
+	return nil
+}
+
+// directories describes the directory of a deletion, or the direction of an
+// rsync or snapshot restore as source -> destination.
+func directories(action *idl.RevertAction) string {
+	switch action.GetType() {
+	case idl.RevertAction_rsync_data_directory, idl.RevertAction_rsync_tablespace_directory:
+		return fmt.Sprintf("%s:%s -> %s:%s", action.GetSourceHost(), action.GetSource(), action.GetDestinationHost(), action.GetDestination())
+	case idl.RevertAction_restore_snapshot:
+		return fmt.Sprintf("snapshot %s -> %s", action.GetSource(), action.GetPath())
+	}
+
+	return action.GetPath()
+}
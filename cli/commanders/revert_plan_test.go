@@ -0,0 +1,69 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commanders_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/idl"
+)
+
+func TestWriteRevertPlan(t *testing.T) {
+	t.Run("lists each action by host along with the direction of each rsync", func(t *testing.T) {
+		actions := []*idl.RevertAction{
+			{Type: idl.RevertAction_rsync_data_directory, Host: "msdw1", SourceHost: "msdw1", Source: "/data/dbfast_mirror1/seg1/", DestinationHost: "sdw1", Destination: "/data/dbfast1/seg1"},
+			{Type: idl.RevertAction_delete_data_directory, Host: "cdw", Path: "/data/qddir.ABC.-1"},
+		}
+
+		var buf bytes.Buffer
+		err := commanders.WriteRevertPlan(&buf, actions)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := `Revert dry run. No changes have been made.
+
+host   action                 directories                                                  verification
+cdw    delete data directory  /data/qddir.ABC.-1                                           ok
+msdw1  rsync data directory   msdw1:/data/dbfast_mirror1/seg1/ -> sdw1:/data/dbfast1/seg1  ok
+`
+		if buf.String() != expected {
+			t.Errorf("got output\n%s\nwant\n%s", buf.String(), expected)
+		}
+	})
+
+	t.Run("returns an error when any action failed verification", func(t *testing.T) {
+		actions := []*idl.RevertAction{
+			{Type: idl.RevertAction_delete_data_directory, Host: "cdw", Path: "/data/qddir.ABC.-1"},
+			{Type: idl.RevertAction_delete_tablespace_directory, Host: "sdw1", Path: "/tmp/ts/16386/3/other", VerificationError: "Invalid tablespace directory"},
+		}
+
+		var buf bytes.Buffer
+		err := commanders.WriteRevertPlan(&buf, actions)
+
+		expected := "1 of 2 directories failed verification"
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("got error %#v want %q", err, expected)
+		}
+
+		if !strings.Contains(buf.String(), "failed: Invalid tablespace directory") {
+			t.Errorf("expected output %q to contain the verification error", buf.String())
+		}
+	})
+
+	t.Run("reports when there is nothing to revert", func(t *testing.T) {
+		var buf bytes.Buffer
+		err := commanders.WriteRevertPlan(&buf, nil)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if !strings.Contains(buf.String(), "Revert has no directories to delete or restore.") {
+			t.Errorf("got output %q", buf.String())
+		}
+	})
+}
@@ -83,8 +83,8 @@ func Execute(client idl.CliToHubClient, request *idl.ExecuteRequest, verbose boo
 	return executeResponse, nil
 }
 
-func Finalize(client idl.CliToHubClient, verbose bool) (*idl.FinalizeResponse, error) {
-	stream, err := client.Finalize(context.Background(), &idl.FinalizeRequest{})
+func Finalize(client idl.CliToHubClient, verbose bool, deferMirrors bool) (*idl.FinalizeResponse, error) {
+	stream, err := client.Finalize(context.Background(), &idl.FinalizeRequest{DeferMirrors: deferMirrors})
 	if err != nil {
 		return &idl.FinalizeResponse{}, err
 	}
@@ -102,8 +102,18 @@ func Finalize(client idl.CliToHubClient, verbose bool) (*idl.FinalizeResponse, e
 	return finalizeResponse, nil
 }
 
-func Revert(client idl.CliToHubClient, verbose bool) (*idl.RevertResponse, error) {
-	stream, err := client.Revert(context.Background(), &idl.RevertRequest{})
+func AddMirrors(client idl.CliToHubClient, verbose bool) error {
+	stream, err := client.AddMirrors(context.Background(), &idl.AddMirrorsRequest{})
+	if err != nil {
+		return err
+	}
+
+	_, err = UILoop(stream, verbose)
+	return err
+}
+
+func Revert(client idl.CliToHubClient, verbose bool, partial bool) (*idl.RevertResponse, error) {
+	stream, err := client.Revert(context.Background(), &idl.RevertRequest{Partial: partial})
 	if err != nil {
 		return &idl.RevertResponse{}, err
 	}
@@ -0,0 +1,83 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/greenplum-db/gpupgrade/cli/clistep"
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/history"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+func addMirrors() *cobra.Command {
+	var verbose bool
+	var nonInteractive bool
+
+	cmd := &cobra.Command{
+		Use:   "add-mirrors",
+		Short: "adds the mirrors and standby after finalize with deferred mirrors",
+		Long:  AddMirrorsHelp,
+		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			logdir, err := utils.GetLogDir()
+			if err != nil {
+				return err
+			}
+
+			confirmationText := fmt.Sprintf(addMirrorsConfirmationText, addMirrorsSubsteps, logdir)
+
+			st, err := clistep.Begin(idl.Step_add_mirrors, verbose, nonInteractive, confirmationText)
+			if err != nil {
+				if errors.Is(err, step.Quit) {
+					// If user cancels don't return an error to main to avoid
+					// printing "Error:".
+					return nil
+				}
+				return err
+			}
+
+			// The hub and agents were stopped at the end of finalize.
+			st.AlwaysRun(idl.Substep_start_hub, func(streams step.OutStreams) error {
+				return commanders.StartHub(streams)
+			})
+
+			st.RunHubSubstep(func(streams step.OutStreams) error {
+				client, err := connectToHub()
+				if err != nil {
+					return err
+				}
+
+				return commanders.AddMirrors(client, verbose)
+			})
+
+			st.Run(idl.Substep_stop_hub_and_agents, func(streams step.OutStreams) error {
+				return stopHubAndAgents()
+			})
+
+			st.Run(idl.Substep_delete_master_statedir, func(streams step.OutStreams) error {
+				// Removing the state directory removes the step status file.
+				// Disable the store so the step framework does not try to write
+				// to a non-existent status file.
+				st.DisableStore()
+
+				saveHistory(history.Finalized)
+				return upgrade.DeleteDirectories([]string{utils.GetStateDir()}, upgrade.StateDirectoryFiles, streams)
+			})
+
+			return st.Complete(fmt.Sprintf(AddMirrorsCompletedText, logdir))
+		},
+	}
+
+	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "print the output stream from all substeps")
+	cmd.Flags().BoolVar(&nonInteractive, "non-interactive", false, "do not prompt for confirmation to proceed")
+	cmd.Flags().MarkHidden("non-interactive") //nolint
+	return addHelpToCommand(cmd, AddMirrorsHelp)
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/greenplum-db/gpupgrade/agent"
+	"github.com/greenplum-db/gpupgrade/tracing"
 	"github.com/greenplum-db/gpupgrade/upgrade"
 	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/daemon"
@@ -26,8 +27,19 @@ func Agent() *cobra.Command {
 		Args:   cobra.MaximumNArgs(0), // no positional args allowed
 		RunE: func(cmd *cobra.Command, args []string) error {
 			logger.Initialize("agent")
+			tracing.Initialize("agent")
 			defer logger.WritePanics()
 
+			// The defaults depend on the selected cluster which is only
+			// known once the flags are parsed.
+			if !cmd.Flag("port").Changed {
+				agentPort = upgrade.AgentPort()
+			}
+
+			if !cmd.Flag("state-directory").Changed {
+				stateDir = utils.GetStateDir()
+			}
+
 			agentServer := agent.New()
 
 			// blocking call
@@ -0,0 +1,66 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+func cleanup() *cobra.Command {
+	var nonInteractive bool
+	var upgradeID string
+	var gphome string
+	var port int
+
+	cmd := &cobra.Command{
+		Use:   "cleanup",
+		Short: "deletes the source data directories archived by finalize",
+		Long:  CleanupHelp,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			return commanders.Cleanup(step.StdStreams, nonInteractive, utils.StdinReader, filepath.Clean(gphome), port, upgradeID)
+		},
+	}
+
+	cmd.Flags().BoolVar(&nonInteractive, "non-interactive", false, "do not prompt for confirmation to proceed")
+	cmd.Flags().MarkHidden("non-interactive") //nolint
+	cmd.Flags().StringVar(&upgradeID, "upgrade-id", "", "the upgrade ID shown when finalize completed")
+	cmd.Flags().StringVar(&gphome, "gphome", "", "path to the upgraded Greenplum installation")
+	cmd.Flags().IntVar(&port, "port", 0, "master port of the upgraded cluster")
+	cmd.MarkFlagRequired("upgrade-id") //nolint
+	cmd.MarkFlagRequired("gphome")     //nolint
+	cmd.MarkFlagRequired("port")       //nolint
+
+	return addHelpToCommand(cmd, CleanupHelp)
+}
+
+// cleanupArchives is run over ssh by cleanup on each segment host. It reads
+// an upgrade.ArchivesRequest from stdin and writes the archives as JSON to
+// stdout.
+func cleanupArchives() *cobra.Command {
+	return &cobra.Command{
+		Use:    "cleanup-archives",
+		Short:  "find or delete the archived directories on this host",
+		Long:   "find or delete the archived directories on this host",
+		Hidden: true,
+		Args:   cobra.MaximumNArgs(0), // no positional args allowed
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var request upgrade.ArchivesRequest
+			if err := json.NewDecoder(os.Stdin).Decode(&request); err != nil {
+				return err
+			}
+
+			return json.NewEncoder(os.Stdout).Encode(upgrade.Archives(request))
+		},
+	}
+}
@@ -50,16 +50,22 @@ import (
 	"github.com/greenplum-db/gpupgrade/config"
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/tracing"
 	"github.com/greenplum-db/gpupgrade/upgrade"
 	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/logger"
 )
 
 func BuildRootCommand() *cobra.Command {
 	var shouldPrintVersion bool
 	var format string
+	var clusterName string
 
 	root := &cobra.Command{
 		Use: "gpupgrade",
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return selectCluster(clusterName)
+		},
 		RunE: func(cmd *cobra.Command, args []string) error {
 			if shouldPrintVersion {
 				printVersion(format)
@@ -73,6 +79,7 @@ func BuildRootCommand() *cobra.Command {
 
 	root.Flags().BoolVarP(&shouldPrintVersion, "version", "V", false, "prints version")
 	root.Flags().StringVar(&format, "format", "", `specify the output format as either "multiline", "oneline", or "json". Default is multiline.`)
+	root.PersistentFlags().StringVar(&clusterName, utils.ClusterNameFlag, utils.GetClusterName(), "the name of the upgrade to act on when upgrading multiple clusters on the same hosts. Defaults to $"+utils.ClusterNameEnv+".")
 
 	root.AddCommand(configCmd)
 	root.AddCommand(version())
@@ -82,6 +89,11 @@ func BuildRootCommand() *cobra.Command {
 	root.AddCommand(execute())
 	root.AddCommand(finalize())
 	root.AddCommand(revert())
+	root.AddCommand(addMirrors())
+	root.AddCommand(cleanup())
+	root.AddCommand(cleanupArchives())
+	root.AddCommand(historyCmd())
+	root.AddCommand(reportCmd())
 	root.AddCommand(restartServices)
 	root.AddCommand(killServices)
 	root.AddCommand(Agent())
@@ -89,10 +101,36 @@ func BuildRootCommand() *cobra.Command {
 
 	subConfigShow := createConfigShowSubcommand()
 	configCmd.AddCommand(subConfigShow)
+	configCmd.AddCommand(configValidate())
+	configCmd.AddCommand(configSet())
 
 	return addHelpToCommand(root, GlobalHelp)
 }
 
+// selectCluster exports the cluster name such that the state and log
+// directories resolve to those of the cluster, and such that the hub started
+// from this process inherits it. The CLI log is reopened when the name differs
+// from the environment since the log was opened before the flags were parsed.
+func selectCluster(name string) error {
+	if name != "" {
+		if err := utils.ValidateClusterName(name); err != nil {
+			return err
+		}
+	}
+
+	if name == utils.GetClusterName() {
+		return nil
+	}
+
+	if err := os.Setenv(utils.ClusterNameEnv, name); err != nil {
+		return err
+	}
+
+	logger.Initialize("cli")
+	tracing.Initialize("cli")
+	return nil
+}
+
 //////////////////////////// Commands //////////////////////////////////////////
 
 var configCmd = &cobra.Command{
@@ -102,45 +140,53 @@ var configCmd = &cobra.Command{
 }
 
 func createConfigShowSubcommand() *cobra.Command {
+	var format string
+	var cluster string
+
 	cmd := &cobra.Command{
 		Use:   "show",
 		Short: "show configuration settings",
 		Long:  "show configuration settings",
 		RunE: func(cmd *cobra.Command, args []string) error {
-			client, err := connectToHub()
-			if err != nil {
-				return err
-			}
+			// Show the entire persisted configuration unless specific
+			// settings are requested.
+			var settings []string
+			cmd.Flags().Visit(func(flag *pflag.Flag) {
+				if flag.Name != "format" && flag.Name != "cluster" {
+					settings = append(settings, flag.Name)
+				}
+			})
+
+			if len(settings) == 0 {
+				conf, err := config.Read()
+				if err != nil {
+					if errors.Is(err, os.ErrNotExist) {
+						return fmt.Errorf("No configuration found. Run gpupgrade initialize first.")
+					}
 
-			// Build a list of GetConfigRequests, one for each flag. If no flags
-			// are passed, assume we want to retrieve all of them.
-			var requests []*idl.GetConfigRequest
-			getRequest := func(flag *pflag.Flag) {
-				if flag.Name != "help" && flag.Name != "?" {
-					requests = append(requests, &idl.GetConfigRequest{
-						Name: flag.Name,
-					})
+					return xerrors.Errorf("read config: %w", err)
 				}
+
+				return commanders.ShowConfig(os.Stdout, conf, format, cluster)
 			}
 
-			if cmd.Flags().NFlag() > 0 {
-				cmd.Flags().Visit(getRequest)
-			} else {
-				cmd.Flags().VisitAll(getRequest)
+			client, err := connectToHub()
+			if err != nil {
+				return err
 			}
 
 			// Make the requests and print every response.
-			for _, request := range requests {
-				resp, err := client.GetConfig(context.Background(), request)
+			for _, setting := range settings {
+				resp, err := client.GetConfig(context.Background(), &idl.GetConfigRequest{Name: setting})
 				if err != nil {
 					return err
 				}
 
-				if cmd.Flags().NFlag() == 1 {
+				if len(settings) == 1 {
 					// Don't prefix with the setting name if the user only asked for one.
 					fmt.Println(resp.Value)
 				} else {
-					fmt.Printf("%s: %s\n", request.Name, resp.Value)
+					fmt.Printf("%s: %s\n", setting, resp.Value)
 				}
 			}
 
@@ -153,10 +199,41 @@ func createConfigShowSubcommand() *cobra.Command {
 	cmd.Flags().Bool("target-gphome", false, "show path for the target Greenplum installation")
 	cmd.Flags().Bool("target-datadir", false, "show temporary data directory for target gpdb cluster")
 	cmd.Flags().Bool("target-port", false, "show temporary master port for target cluster")
+	cmd.Flags().StringVar(&format, "format", commanders.ConfigFormatTable, "output format of either table or json")
+	cmd.Flags().StringVar(&cluster, "cluster", "", "show the segments and tablespaces of either the source, intermediate, or target cluster")
 
 	return addHelpToCommand(cmd, ConfigHelp)
 }
 
+func configSet() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set <setting> <value>",
+		Short: "change a setting between steps",
+		Long:  ConfigSetHelp,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			client, err := connectToHub()
+			if err != nil {
+				return err
+			}
+
+			// Accept both the config file and flag forms of the setting name.
+			name := strings.ReplaceAll(args[0], "-", "_")
+			_, err = client.SetConfig(context.Background(), &idl.SetConfigRequest{Name: name, Value: args[1]})
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Set %s to %s\n", name, args[1])
+			return nil
+		},
+	}
+
+	return addHelpToCommand(cmd, ConfigSetHelp)
+}
+
 func version() *cobra.Command {
 	var format string
 
@@ -274,7 +351,9 @@ func connectToHubOnPort(port int) (idl.CliToHubClient, error) {
 
 	// Attempt a connection.
 	address := "localhost:" + strconv.Itoa(port)
-	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+	conn, err := grpc.DialContext(ctx, address, grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock(),
+		grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor("localhost")),
+		grpc.WithStreamInterceptor(tracing.StreamClientInterceptor("localhost")))
 	if err != nil {
 		err = xerrors.Errorf("connecting to hub on port %d: %w", port, err)
 		if ctx.Err() == context.DeadlineExceeded {
@@ -319,7 +398,7 @@ func hubPort() (int, error) {
 	conf, err := config.Read()
 	var pathError *os.PathError
 	if errors.As(err, &pathError) {
-		return upgrade.DefaultHubPort, nil
+		return upgrade.HubPort(), nil
 	}
 
 	if err != nil {
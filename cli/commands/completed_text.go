@@ -41,6 +41,8 @@ To do so configure different ports to avoid conflicts.
 You may delete the source cluster to recover space from all hosts. 
 All source cluster data directories end in "%s".
 MASTER_DATA_DIRECTORY=%s
+To delete them on all hosts run
+"gpupgrade cleanup --upgrade-id %s --gphome %s --port %d"
 
 The gpupgrade logs can be found on the master and segment hosts in
 %s
@@ -64,6 +66,18 @@ If you have not already, execute the “%s” data migration scripts with
 If you postponed creating optimizer statistics run
 "vacuumdb --all --analyze-in-stages"`
 
+var DeferredMirrorsText = `
+
+The mirrors and standby have not been upgraded. The cluster is available to
+users but is not highly available. To add the mirrors and standby online run
+"gpupgrade add-mirrors"`
+
+var AddMirrorsCompletedText = `
+The mirrors and standby have been added to the target cluster.
+
+The gpupgrade logs can be found on the master and segment hosts in
+%s`
+
 var RevertCompletedText = `
 The source cluster is now running version %s.
 source %s
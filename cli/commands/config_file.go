@@ -0,0 +1,325 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"golang.org/x/xerrors"
+	"gopkg.in/yaml.v3"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/notify"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+// configParam is a parameter from a gpupgrade config file along with the line
+// it was declared on such that errors can reference it.
+type configParam struct {
+	name  string
+	value string
+	line  int
+}
+
+// ConfigError is an error for a specific line of a gpupgrade config file.
+type ConfigError struct {
+	Line int
+	Err  error
+}
+
+func (e ConfigError) Error() string {
+	if e.Line == 0 {
+		return e.Err.Error()
+	}
+
+	return fmt.Sprintf("line %d: %s", e.Line, e.Err)
+}
+
+func (e ConfigError) Unwrap() error {
+	return e.Err
+}
+
+func configErrorf(line int, format string, args ...any) error {
+	return ConfigError{Line: line, Err: fmt.Errorf(format, args...)}
+}
+
+// isYAMLConfig returns whether the config file uses the structured YAML
+// format rather than the original "name = value" format.
+func isYAMLConfig(path string) bool {
+	ext := strings.ToLower(filepath.Ext(path))
+	return ext == ".yaml" || ext == ".yml"
+}
+
+// ReadConfigFile parses and validates a gpupgrade config file against the
+// flags of the command returning every error found. Valid parameters are set
+// on the command as if they were passed on the command line.
+func ReadConfigFile(cmd *cobra.Command, path string) (err error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cErr := file.Close(); cErr != nil {
+			err = errorlist.Append(err, cErr)
+		}
+	}()
+
+	var params []configParam
+	if isYAMLConfig(path) {
+		params, err = parseYAMLConfig(file)
+	} else {
+		params, err = parseParams(file)
+	}
+
+	// Continue to validate the parameters that were parsed such that every
+	// error is reported at once.
+	err = errorlist.Append(err, setConfigFlags(cmd, params))
+	if err != nil {
+		return xerrors.Errorf("in file %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// parseYAMLConfig parses the structured config format. Parameters are typed
+// YAML scalars, environment variables such as ${GPHOME} are interpolated, and
+// per host values are nested under the "hosts" section:
+//
+//	source_gphome: /usr/local/greenplum-db-5
+//	target_gphome: ${TARGET_GPHOME}
+//	source_master_port: 5432
+//	mode: link
+//	hosts:
+//	  cdw:
+//	    parent_backup_dir: /data1
+//	  sdw1:
+//	    parent_backup_dir: /data2
+func parseYAMLConfig(r io.Reader) ([]configParam, error) {
+	contents, err := io.ReadAll(r)
+	if err != nil {
+		return nil, xerrors.Errorf("reading config: %w", err)
+	}
+
+	var doc yaml.Node
+	err = yaml.NewDecoder(bytes.NewReader(contents)).Decode(&doc)
+	if err == io.EOF {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, ConfigError{Err: err}
+	}
+
+	if len(doc.Content) == 0 {
+		return nil, nil
+	}
+
+	root := doc.Content[0]
+	if root.Kind != yaml.MappingNode {
+		return nil, configErrorf(root.Line, "expected a mapping of parameter names to values")
+	}
+
+	var errs error
+	var params []configParam
+	seen := make(map[string]int)
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		key, value := root.Content[i], root.Content[i+1]
+
+		if line, ok := seen[key.Value]; ok {
+			errs = errorlist.Append(errs, configErrorf(key.Line, "parameter %q declared more than once, previously on line %d", key.Value, line))
+			continue
+		}
+		seen[key.Value] = key.Line
+
+		if key.Value == "hosts" {
+			param, err := parseHostsSection(value)
+			if err != nil {
+				errs = errorlist.Append(errs, err)
+				continue
+			}
+
+			params = append(params, param)
+			continue
+		}
+
+		if value.Kind != yaml.ScalarNode {
+			errs = errorlist.Append(errs, configErrorf(value.Line, "expected a single value for parameter %q", key.Value))
+			continue
+		}
+
+		interpolated, err := interpolate(value)
+		if err != nil {
+			errs = errorlist.Append(errs, err)
+			continue
+		}
+
+		params = append(params, configParam{name: key.Value, value: interpolated, line: key.Line})
+	}
+
+	return params, errs
+}
+
+// parseHostsSection converts the per host settings into their equivalent
+// flags. The coordinator host must be listed first.
+func parseHostsSection(hosts *yaml.Node) (configParam, error) {
+	if hosts.Kind != yaml.MappingNode {
+		return configParam{}, configErrorf(hosts.Line, "expected %q to be a mapping of hostnames to their settings", "hosts")
+	}
+
+	var errs error
+	var backupDirs []string
+	for i := 0; i+1 < len(hosts.Content); i += 2 {
+		host, settings := hosts.Content[i], hosts.Content[i+1]
+		if settings.Kind != yaml.MappingNode {
+			errs = errorlist.Append(errs, configErrorf(settings.Line, "expected settings for host %q", host.Value))
+			continue
+		}
+
+		for j := 0; j+1 < len(settings.Content); j += 2 {
+			key, value := settings.Content[j], settings.Content[j+1]
+			if key.Value != "parent_backup_dir" {
+				errs = errorlist.Append(errs, configErrorf(key.Line, "unknown host parameter %q for host %q. Supported host parameters: parent_backup_dir", key.Value, host.Value))
+				continue
+			}
+
+			dir, err := interpolate(value)
+			if err != nil {
+				errs = errorlist.Append(errs, err)
+				continue
+			}
+
+			if !filepath.IsAbs(dir) {
+				errs = errorlist.Append(errs, configErrorf(value.Line, "parent_backup_dir %q for host %q must be an absolute path", dir, host.Value))
+				continue
+			}
+
+			backupDirs = append(backupDirs, host.Value+":"+dir)
+		}
+	}
+
+	if errs != nil {
+		return configParam{}, errs
+	}
+
+	return configParam{name: "parent_backup_dirs", value: strings.Join(backupDirs, ","), line: hosts.Line}, nil
+}
+
+// interpolate expands environment variables such as $HOME and ${HOME} in a
+// scalar value. Referencing an unset environment variable is an error.
+func interpolate(node *yaml.Node) (string, error) {
+	if node.Kind != yaml.ScalarNode {
+		return "", configErrorf(node.Line, "expected a single value")
+	}
+
+	var missing []string
+	value := os.Expand(node.Value, func(name string) string {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			missing = append(missing, name)
+		}
+
+		return value
+	})
+
+	if len(missing) > 0 {
+		return "", configErrorf(node.Line, "environment variable %s is not set", strings.Join(missing, ", "))
+	}
+
+	return value, nil
+}
+
+// setConfigFlags sets the config file parameters on the equivalent command
+// line flags validating their types and values.
+func setConfigFlags(cmd *cobra.Command, params []configParam) error {
+	var errs error
+	for _, param := range params {
+		// For config file parameter names replace all underscores with dashes
+		// such that the equivalent cobra command line flag can be found.
+		name := strings.ReplaceAll(param.name, "_", "-")
+
+		flag := cmd.Flag(name)
+		if flag == nil || name == "file" || name == "help" {
+			var names []string
+			cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+				if flag.Name != "file" && flag.Name != "help" && !flag.Hidden {
+					names = append(names, strings.ReplaceAll(flag.Name, "-", "_"))
+				}
+			})
+
+			errs = errorlist.Append(errs, configErrorf(param.line, "The configuration parameter %q was not found in the list of supported parameters: %s.", param.name, strings.Join(names, ", ")))
+			continue
+		}
+
+		if param.value == "" {
+			errs = errorlist.Append(errs, configErrorf(param.line, "no value found for parameter %q", param.name))
+			continue
+		}
+
+		err := validateConfigValue(name, param.value)
+		if err != nil {
+			errs = errorlist.Append(errs, configErrorf(param.line, "invalid value %q for parameter %q: %w", param.value, param.name, err))
+			continue
+		}
+
+		err = flag.Value.Set(param.value)
+		if err != nil {
+			errs = errorlist.Append(errs, configErrorf(param.line, "invalid %s value %q for parameter %q", flag.Value.Type(), param.value, param.name))
+			continue
+		}
+
+		flag.Changed = true
+	}
+
+	return errs
+}
+
+// validateConfigValue validates values beyond their type.
+func validateConfigValue(name string, value string) error {
+	switch name {
+	case "mode":
+		_, err := parseMode(value)
+		return err
+	case "temp-port-range":
+		_, err := ParsePorts(value)
+		return err
+	case "pg-upgrade-jobs":
+		_, err := config.ParsePgUpgradeJobs(value)
+		return err
+	case "webhook-urls":
+		_, err := notify.ParseURLs(value)
+		return err
+	}
+
+	return nil
+}
+
+func configValidate() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "validate <file>",
+		Short: "validate a gpupgrade config file",
+		Long:  ConfigValidateHelp,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			// Validate against a fresh initialize command which is never run.
+			err := ReadConfigFile(initialize(), args[0])
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("%s is valid.\n", args[0])
+			return nil
+		},
+	}
+
+	return addHelpToCommand(cmd, ConfigValidateHelp)
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/testutils"
+)
+
+func TestReadConfigFile(t *testing.T) {
+	dir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, dir)
+
+	t.Run("sets flags from a yaml config file", func(t *testing.T) {
+		t.Setenv("TEST_TARGET_GPHOME", "/usr/local/greenplum-db-6")
+
+		path := filepath.Join(dir, "gpupgrade_config.yaml")
+		testutils.MustWriteToFile(t, path, `
+# comments are allowed
+source_gphome: /usr/local/greenplum-db-5
+target_gphome: ${TEST_TARGET_GPHOME}
+source_master_port: 15432
+mode: link
+disk_free_ratio: 0.3
+use_hba_hostnames: true
+hosts:
+  cdw:
+    parent_backup_dir: /data1
+  sdw1:
+    parent_backup_dir: /data2
+`)
+
+		cmd := initialize()
+		err := ReadConfigFile(cmd, path)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := map[string]string{
+			"source-gphome":      "/usr/local/greenplum-db-5",
+			"target-gphome":      "/usr/local/greenplum-db-6",
+			"source-master-port": "15432",
+			"mode":               "link",
+			"disk-free-ratio":    "0.3",
+			"use-hba-hostnames":  "true",
+			"parent-backup-dirs": "cdw:/data1,sdw1:/data2",
+		}
+
+		for name, value := range expected {
+			flag := cmd.Flag(name)
+			if !flag.Changed {
+				t.Errorf("expected flag %q to be changed", name)
+			}
+
+			if flag.Value.String() != value {
+				t.Errorf("got flag %q value %q want %q", name, flag.Value.String(), value)
+			}
+		}
+	})
+
+	t.Run("reports every error in a yaml config file with line numbers", func(t *testing.T) {
+		path := filepath.Join(dir, "invalid.yml")
+		testutils.MustWriteToFile(t, path, `source_gphome: /usr/local/greenplum-db-5
+target_gphome: ${TEST_UNSET_VARIABLE}
+source_master_port: abc
+unknown_parameter: value
+mode: depeche
+source_gphome: /usr/local/greenplum-db-6
+hosts:
+  cdw:
+    parent_backup_dir: relative/dir
+`)
+
+		err := ReadConfigFile(initialize(), path)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+
+		for _, expected := range []string{
+			`line 2: environment variable TEST_UNSET_VARIABLE is not set`,
+			`line 3: invalid int value "abc" for parameter "source_master_port"`,
+			`line 4: The configuration parameter "unknown_parameter" was not found`,
+			`line 5: invalid value "depeche" for parameter "mode"`,
+			`line 6: parameter "source_gphome" declared more than once, previously on line 1`,
+			`line 9: parent_backup_dir "relative/dir" for host "cdw" must be an absolute path`,
+		} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("expected error %q to contain %q", err.Error(), expected)
+			}
+		}
+	})
+
+	t.Run("reports every error in a name = value config file with line numbers", func(t *testing.T) {
+		path := filepath.Join(dir, "gpupgrade_config")
+		testutils.MustWriteToFile(t, path, `source_gphome = /usr/local/greenplum-db-5
+
+target_gphome /usr/local/greenplum-db-6
+source_master_port =
+pg_upgrade_jobs = -1
+webhook_urls = alerts.example.com
+`)
+
+		err := ReadConfigFile(initialize(), path)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+
+		for _, expected := range []string{
+			`line 3: parameter "target_gphome /usr/local/greenplum-db-6" is not of the form name = value`,
+			`line 4: no value found for parameter "source_master_port"`,
+			`line 5: invalid value "-1" for parameter "pg_upgrade_jobs"`,
+			`line 6: invalid value "alerts.example.com" for parameter "webhook_urls"`,
+		} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("expected error %q to contain %q", err.Error(), expected)
+			}
+		}
+	})
+
+	t.Run("accepts the example config file once filled in", func(t *testing.T) {
+		path := filepath.Join(dir, "example_config")
+		config := strings.NewReplacer(
+			"source_master_port =", "source_master_port = 5432",
+			"source_gphome =", "source_gphome = /usr/local/greenplum-db-5",
+			"target_gphome =", "target_gphome = /usr/local/greenplum-db-6",
+		).Replace(testutils.MustReadFile(t, GPUPGRADE_CONFIG))
+		testutils.MustWriteToFile(t, path, config)
+
+		err := ReadConfigFile(initialize(), path)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+	})
+}
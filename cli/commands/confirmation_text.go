@@ -13,17 +13,28 @@ You are about to initialize a major-version upgrade of Greenplum.
 gpupgrade log files can be found on all hosts in %s
 
 gpupgrade initialize will use these values from %s
-source_master_port:   %d
-source_gphome:        %s
-target_gphome:        %s
-mode:                 %s
-disk_free_ratio:      %.1f
-pg_upgrade_jobs:      %d
-use_hba_hostnames:    %t
-dynamic_library_path: %s
-temp_port_range:      %s
-hub_port:             %d
-agent_port:           %d
+source_master_port:       %d
+source_gphome:            %s
+target_gphome:            %s
+mode:                     %s
+disk_free_ratio:          %.1f
+pg_upgrade_jobs:          %s
+master_pg_upgrade_jobs:   %d
+primary_pg_upgrade_jobs:  %d
+rsync_jobs:               %d
+copy_fan_out:             %d
+copy_depth:               %d
+use_hba_hostnames:        %t
+dynamic_library_path:     %s
+temp_port_range:          %s
+hub_port:                 %d
+agent_port:               %d
+snapshot_provider:        %s
+snapshot_command:         %s
+snapshot_restore_command: %s
+snapshot_delete_command:  %s
+webhook_urls:             %s
+metrics_port:             %d
 
 You will still have the opportunity to revert the cluster to its original state 
 after this step.
@@ -66,6 +77,15 @@ WARNING: Do not perform operations on the source and target clusters until gpupg
 finalized or reverted.
 `)
 
+var addMirrorsConfirmationText = `
+You are about to add the mirrors and standby to the upgraded cluster.
+The cluster remains available to users.
+
+Add-mirrors will carry out the following steps:
+%s
+gpupgrade log files can be found on all hosts in %s
+`
+
 var revertConfirmationText = `
 You are about to revert this upgrade.
 This should be done only during a downtime window.
@@ -74,7 +94,8 @@ This should be done only during a downtime window.
 %s
 gpupgrade log files can be found on all hosts in %s
 ` + color.RedString(`
-WARNING: You cannot revert if you do not have mirrors & standby configured, and execute has started.
+WARNING: You cannot revert if you do not have mirrors & standby configured, and execute has started,
+unless a snapshot provider was configured during initialize.
 
 WARNING: Do not perform operations on the source and target clusters until gpupgrade revert
 has completed.
@@ -22,6 +22,10 @@ func dataMigrationGenerate() *cobra.Command {
 	var port int
 	var seedDir string
 	var outputDir string
+	var diff bool
+	var databases string
+	var excludeDatabases string
+	var jobs uint
 
 	logDir, err := utils.GetLogDir()
 	if err != nil {
@@ -37,7 +41,16 @@ func dataMigrationGenerate() *cobra.Command {
 		RunE: func(cmd *cobra.Command, args []string) error {
 			outputDir = filepath.Clean(outputDir)
 			seedDir = filepath.Clean(seedDir)
-			return commanders.GenerateDataMigrationScripts(step.StdStreams, nonInteractive, filepath.Clean(gphome), port, seedDir, outputDir, utils.System.DirFS(outputDir))
+			if diff {
+				return commanders.DiffDataMigrationScripts(step.StdStreams, filepath.Clean(gphome), port, seedDir, outputDir, jobs)
+			}
+
+			filter, err := commanders.NewDatabaseFilter(databases, excludeDatabases)
+			if err != nil {
+				return err
+			}
+
+			return commanders.GenerateDataMigrationScripts(step.StdStreams, nonInteractive, filepath.Clean(gphome), port, seedDir, outputDir, utils.System.DirFS(outputDir), filter, jobs)
 		},
 	}
 
@@ -46,6 +59,10 @@ func dataMigrationGenerate() *cobra.Command {
 	dataMigrationGenerator.Flags().StringVar(&gphome, "gphome", "", "path to the Greenplum installation")
 	dataMigrationGenerator.Flags().IntVar(&port, "port", 0, "master port for Greenplum cluster")
 	dataMigrationGenerator.Flags().StringVar(&outputDir, "output-dir", outputDir, "output path to the current generated data migration SQL files. Defaults to $HOME/gpAdminLogs/gpupgrade/data-migration-scripts")
+	dataMigrationGenerator.Flags().StringVar(&databases, "databases", "", `comma separated list of database patterns such as "sales,hr_*" to generate scripts for. Defaults to all databases.`)
+	dataMigrationGenerator.Flags().StringVar(&excludeDatabases, "exclude-databases", "", `comma separated list of database patterns such as "test_*" to not generate scripts for`)
+	dataMigrationGenerator.Flags().UintVar(&jobs, "jobs", commanders.DefaultDataMigrationJobs, "number of databases to generate scripts for in parallel. 0 generates all databases in parallel")
+	dataMigrationGenerator.Flags().BoolVar(&diff, "diff", false, "show the differences between the previously generated data migration SQL files and a fresh generation without modifying them")
 	// seed-dir is a hidden flag used for internal testing.
 	dataMigrationGenerator.Flags().StringVar(&seedDir, "seed-dir", utils.GetDataMigrationSeedDir(), "path to the seed scripts")
 	dataMigrationGenerator.Flags().MarkHidden("seed-dir") //nolint
@@ -59,6 +76,11 @@ func dataMigrationApply() *cobra.Command {
 	var port int
 	var inputDir string
 	var phase string
+	var resume bool
+	var continueOnError bool
+	var databases string
+	var excludeDatabases string
+	var jobs uint
 
 	logDir, err := utils.GetLogDir()
 	if err != nil {
@@ -77,8 +99,13 @@ func dataMigrationApply() *cobra.Command {
 				return err
 			}
 
+			filter, err := commanders.NewDatabaseFilter(databases, excludeDatabases)
+			if err != nil {
+				return err
+			}
+
 			currentDir := filepath.Join(filepath.Clean(inputDir), "current")
-			err = commanders.ApplyDataMigrationScripts(step.StdStreams, nonInteractive, filepath.Clean(gphome), port, logDir, utils.System.DirFS(currentDir), currentDir, parsedPhase)
+			err = commanders.ApplyDataMigrationScripts(step.StdStreams, nonInteractive, filepath.Clean(gphome), port, logDir, utils.System.DirFS(currentDir), currentDir, parsedPhase, resume, continueOnError, filter, jobs)
 			if err != nil {
 				return err
 			}
@@ -93,6 +120,11 @@ func dataMigrationApply() *cobra.Command {
 	dataMigrationExecutor.Flags().IntVar(&port, "port", 0, "master port for Greenplum cluster")
 	dataMigrationExecutor.Flags().StringVar(&inputDir, "input-dir", inputDir, "path to the generated data migration SQL files. Defaults to $HOME/gpAdminLogs/gpupgrade/data-migration-scripts")
 	dataMigrationExecutor.Flags().StringVar(&phase, "phase", "", `data migration phase. Either "pre-initialize", "post-finalize", "post-revert", or "stats".`)
+	dataMigrationExecutor.Flags().StringVar(&databases, "databases", "", `comma separated list of database patterns such as "sales,hr_*" to apply scripts for. Defaults to the databases scripts were generated for.`)
+	dataMigrationExecutor.Flags().StringVar(&excludeDatabases, "exclude-databases", "", `comma separated list of database patterns such as "test_*" to not apply scripts for`)
+	dataMigrationExecutor.Flags().UintVar(&jobs, "jobs", commanders.DefaultDataMigrationJobs, "number of script directories to apply in parallel. 0 applies all script directories in parallel")
+	dataMigrationExecutor.Flags().BoolVar(&resume, "resume", false, "skip scripts that were successfully applied by a previous apply of the same phase and have not changed since")
+	dataMigrationExecutor.Flags().BoolVar(&continueOnError, "continue-on-error", false, "continue applying the remaining scripts when a script fails and report all failures at the end. Defaults to stopping on the first error.")
 
 	return addHelpToCommand(dataMigrationExecutor, applyHelp)
 }
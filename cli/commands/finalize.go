@@ -15,6 +15,7 @@ import (
 	"github.com/greenplum-db/gpupgrade/cli/clistep"
 	"github.com/greenplum-db/gpupgrade/cli/commanders"
 	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/history"
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/upgrade"
@@ -24,6 +25,7 @@ import (
 func finalize() *cobra.Command {
 	var verbose bool
 	var nonInteractive bool
+	var deferMirrors bool
 
 	cmd := &cobra.Command{
 		Use:   "finalize",
@@ -58,7 +60,7 @@ func finalize() *cobra.Command {
 					return err
 				}
 
-				response, err = commanders.Finalize(client, verbose)
+				response, err = commanders.Finalize(client, verbose, deferMirrors)
 				if err != nil {
 					return err
 				}
@@ -81,8 +83,10 @@ func finalize() *cobra.Command {
 				}
 
 				currentDir := filepath.Join(response.GetLogArchiveDirectory(), "data-migration-scripts", "current")
-				return commanders.ApplyDataMigrationScripts(streams, nonInteractive, target.GPHome, target.CoordinatorPort(),
-					response.GetLogArchiveDirectory(), utils.System.DirFS(currentDir), currentDir, idl.Step_finalize)
+				err := commanders.ApplyDataMigrationScripts(streams, nonInteractive, target.GPHome, target.CoordinatorPort(),
+					response.GetLogArchiveDirectory(), utils.System.DirFS(currentDir), currentDir, idl.Step_finalize, false, false, commanders.DatabaseFilter{}, commanders.AllDataMigrationJobs)
+				regenerateReport(response.GetLogArchiveDirectory())
+				return err
 			})
 
 			st.Run(idl.Substep_analyze_target_cluster, func(streams step.OutStreams) error {
@@ -107,18 +111,27 @@ If you postpone creating statistics then after the upgrade run "vacuumdb --all -
 				return target.RunGreenplumCmd(streams, "vacuumdb", "--all", "--analyze-only")
 			})
 
-			st.Run(idl.Substep_delete_master_statedir, func(streams step.OutStreams) error {
+			// Keep the state directory for "gpupgrade add-mirrors".
+			st.RunConditionally(idl.Substep_delete_master_statedir, !response.GetMirrorsDeferred(), func(streams step.OutStreams) error {
 				// Removing the state directory removes the step status file.
 				// Disable the store so the step framework does not try to write
 				// to a non-existent status file.
 				st.DisableStore()
+
+				saveHistory(history.Finalized)
 				return upgrade.DeleteDirectories([]string{utils.GetStateDir()}, upgrade.StateDirectoryFiles, streams)
 			})
 
-			return st.Complete(fmt.Sprintf(FinalizeCompletedText,
+			completedText := FinalizeCompletedText
+			if response.GetMirrorsDeferred() {
+				completedText += DeferredMirrorsText
+			}
+
+			return st.Complete(fmt.Sprintf(completedText,
 				target.Version,
 				fmt.Sprintf("%s.<contentID>%s", response.GetUpgradeID(), upgrade.OldSuffix),
 				response.GetArchivedSourceCoordinatorDataDirectory(),
+				response.GetUpgradeID(), target.GPHome, target.CoordinatorPort(),
 				response.GetLogArchiveDirectory(),
 				filepath.Join(target.GPHome, "greenplum_path.sh"),
 				filepath.Join(filepath.Dir(target.GPHome), "greenplum-db"), target.GPHome,
@@ -134,5 +147,6 @@ If you postpone creating statistics then after the upgrade run "vacuumdb --all -
 	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "print the output stream from all substeps")
 	cmd.Flags().BoolVar(&nonInteractive, "non-interactive", false, "do not prompt for confirmation to proceed")
 	cmd.Flags().MarkHidden("non-interactive") //nolint
+	cmd.Flags().BoolVar(&deferMirrors, "defer-mirrors", false, `upgrade only the master and primaries to shorten downtime, and add the mirrors and standby later with "gpupgrade add-mirrors"`)
 	return addHelpToCommand(cmd, FinalizeHelp)
 }
@@ -20,10 +20,12 @@ var initializeSubsteps substeps.Substeps
 var executeSubsteps substeps.Substeps
 var finalizeSubsteps substeps.Substeps
 var revertSubsteps substeps.Substeps
+var addMirrorsSubsteps substeps.Substeps
 var InitializeHelp string
 var ExecuteHelp string
 var FinalizeHelp string
 var RevertHelp string
+var AddMirrorsHelp string
 var GlobalHelp string
 var Help map[idl.Step]string
 
@@ -45,12 +47,14 @@ func init() {
 		idl.Substep_check_environment,
 		idl.Substep_create_backupdirs,
 		idl.Substep_check_disk_space,
+		idl.Substep_record_disk_usage,
 		idl.Substep_generate_target_config,
 		idl.Substep_init_target_cluster,
 		idl.Substep_setting_dynamic_library_path_on_target_cluster,
 		idl.Substep_shutdown_target_cluster,
 		idl.Substep_backup_target_master,
 		idl.Substep_initialize_wait_for_cluster_to_be_ready,
+		idl.Substep_check_clone_support,
 		idl.Substep_check_upgrade,
 	}
 
@@ -59,8 +63,10 @@ func init() {
 		idl.Substep_check_active_connections_on_source_cluster,
 		idl.Substep_wait_for_cluster_to_be_ready_before_upgrade_master,
 		idl.Substep_shutdown_source_cluster,
+		idl.Substep_snapshot_source_cluster,
 		idl.Substep_upgrade_master,
 		idl.Substep_copy_master,
+		idl.Substep_verify_master_backup,
 		idl.Substep_upgrade_primaries,
 		idl.Substep_start_target_cluster,
 	}
@@ -78,6 +84,7 @@ func init() {
 		idl.Substep_start_target_cluster,
 		idl.Substep_wait_for_cluster_to_be_ready_after_updating_catalog,
 		idl.Substep_archive_log_directories,
+		idl.Substep_delete_source_cluster_snapshot,
 		idl.Substep_delete_backupdir,
 		idl.Substep_delete_segment_statedirs,
 		idl.Substep_stop_hub_and_agents,
@@ -97,6 +104,7 @@ func init() {
 		idl.Substep_start_source_cluster,
 		idl.Substep_recoverseg_source_cluster,
 		idl.Substep_archive_log_directories,
+		idl.Substep_delete_source_cluster_snapshot,
 		idl.Substep_delete_backupdir,
 		idl.Substep_delete_segment_statedirs,
 		idl.Substep_stop_hub_and_agents,
@@ -104,17 +112,30 @@ func init() {
 		idl.Substep_delete_master_statedir,
 	}
 
+	addMirrorsSubsteps = substeps.Substeps{
+		idl.Substep_start_hub,
+		idl.Substep_ensure_gpupgrade_agents_are_running,
+		idl.Substep_upgrade_mirrors,
+		idl.Substep_upgrade_standby,
+		idl.Substep_wait_for_cluster_to_be_ready_after_adding_mirrors_and_standby,
+		idl.Substep_delete_segment_statedirs,
+		idl.Substep_stop_hub_and_agents,
+		idl.Substep_delete_master_statedir,
+	}
+
 	InitializeHelp = fmt.Sprintf(initializeHelpText, cases.Title(language.English).String(idl.Step_initialize.String()), initializeSubsteps, logDir)
 	ExecuteHelp = fmt.Sprintf(executeHelpText, cases.Title(language.English).String(idl.Step_execute.String()), executeSubsteps, logDir)
 	FinalizeHelp = fmt.Sprintf(finalizeHelpText, cases.Title(language.English).String(idl.Step_finalize.String()), finalizeSubsteps, logDir)
 	RevertHelp = fmt.Sprintf(revertHelpText, cases.Title(language.English).String(idl.Step_revert.String()), revertSubsteps, logDir)
+	AddMirrorsHelp = fmt.Sprintf(addMirrorsHelpText, addMirrorsSubsteps, logDir)
 	GlobalHelp = fmt.Sprintf(globalHelpText, logDir)
 
 	Help = map[idl.Step]string{
-		idl.Step_initialize: InitializeHelp,
-		idl.Step_execute:    ExecuteHelp,
-		idl.Step_finalize:   FinalizeHelp,
-		idl.Step_revert:     RevertHelp,
+		idl.Step_initialize:  InitializeHelp,
+		idl.Step_execute:     ExecuteHelp,
+		idl.Step_finalize:    FinalizeHelp,
+		idl.Step_revert:      RevertHelp,
+		idl.Step_add_mirrors: AddMirrorsHelp,
 	}
 }
 
@@ -131,7 +152,7 @@ Usage: gpupgrade initialize --file <path/to/config_file>
 Required Flags:
 
   -f, --file      config file containing upgrade parameters
-                  (e.g. gpupgrade_config)
+                  (e.g. gpupgrade_config or gpupgrade_config.yaml)
 
 Optional Flags:
 
@@ -177,12 +198,37 @@ Usage: gpupgrade finalize
 
 Optional Flags:
 
-  -h, --help      displays help output for finalize
-  -v, --verbose   outputs detailed logs for finalize
+  -h, --help            displays help output for finalize
+  -v, --verbose         outputs detailed logs for finalize
+      --defer-mirrors   upgrades only the master and primaries to shorten the
+                        downtime window. Once finalize completes the cluster
+                        is available to users, and the mirrors and standby are
+                        added online with "gpupgrade add-mirrors". Until then
+                        the cluster is not highly available.
 
 NOTE: After running finalize, you must execute data migration scripts. 
 Refer to documentation for instructions.
 
+gpupgrade log files can be found on all hosts in %s
+`
+const addMirrorsHelpText = `
+Adds the mirrors and standby to the upgraded cluster after running
+"gpupgrade finalize --defer-mirrors". The cluster remains online and available
+to users. The mirrors are created with gpaddmirrors in every mode
+since rsync requires the cluster to be stopped.
+
+Add-mirrors will carry out the following steps:
+%s
+If add-mirrors fails, address the issue and run it again. Completed steps are
+skipped.
+
+Usage: gpupgrade add-mirrors
+
+Optional Flags:
+
+  -h, --help      displays help output for add-mirrors
+  -v, --verbose   outputs detailed logs for add-mirrors
+
 gpupgrade log files can be found on all hosts in %s
 `
 const revertHelpText = `
@@ -198,6 +244,14 @@ Optional Flags:
 
   -h, --help      displays help output for revert
   -v, --verbose   outputs detailed logs for revert
+      --dry-run   lists the directories revert would delete on each host,
+                  and the direction of each rsync used to restore the source
+                  cluster in link mode. The same safety checks as revert are
+                  run. No changes are made.
+      --partial   in link mode only restores the source coordinator and
+                  primaries that pg_upgrade modified, rather than every
+                  segment. Unmodified segments are left as is. Can be
+                  combined with --dry-run.
 
 NOTE: After running revert, you must execute data migration scripts. 
 Refer to documentation for instructions.
@@ -218,13 +272,29 @@ Usage: gpupgrade generate --gphome "$GPHOME" --port "$PGPORT"
 
 Required Flags:
 
-  --gphome       path to the Greenplum installation
-  --port         master port for Greenplum cluster
+  --gphome                path to the Greenplum installation
+  --port                  master port for Greenplum cluster
 
 Optional Flags:
 
-  --output-dir    output path to the current generated data migration SQL files. 
-                  Defaults to $HOME/gpAdminLogs/gpupgrade/data-migration-scripts
+  --output-dir            output path to the current generated data migration SQL files. 
+                          Defaults to $HOME/gpAdminLogs/gpupgrade/data-migration-scripts
+  --databases             comma separated list of database patterns such as 
+                          "sales,hr_*" to generate scripts for. Defaults to all 
+                          databases. Cluster wide scripts such as stats are 
+                          generated with the postgres database and are not 
+                          generated when postgres is not selected.
+  --exclude-databases     comma separated list of database patterns such as 
+                          "test_*" to not generate scripts for.
+  --jobs                  number of databases to generate scripts for in 
+                          parallel. 0 generates all databases in parallel. 
+                          Defaults to 4. The scripts generated by initialize 
+                          are generated for all databases in parallel.
+  --diff                  re-generates the data migration SQL files into a temporary 
+                          directory and shows which scripts and objects were added, 
+                          removed, or changed per phase and database compared to the 
+                          previously generated files. The previously generated files 
+                          are not modified.
 `
 const applyHelp = `
 Applies data migration SQL scripts to resolve catalog inconsistencies between 
@@ -236,21 +306,43 @@ Usage: gpupgrade apply --gphome "$GPHOME" --port "$PGPORT" --phase initialize
 
 Required Flags:
 
-  --gphome       path to the Greenplum installation
-  --port         master port for Greenplum cluster
-  --phase        the data migration phase. Either "pre-initialize", 
-                 "post-finalize", "post-revert", or "stats".
+  --gphome               path to the Greenplum installation
+  --port                 master port for Greenplum cluster
+  --phase                the data migration phase. Either "pre-initialize", 
+                         "post-finalize", "post-revert", or "stats".
 
 Optional Flags:
 
-  --input-dir    path to the generated data migration SQL files. 
-                 Defaults to $HOME/gpAdminLogs/gpupgrade/data-migration-scripts
+  --input-dir            path to the generated data migration SQL files. 
+                         Defaults to $HOME/gpAdminLogs/gpupgrade/data-migration-scripts
+  --resume               skip scripts that were successfully applied by a previous 
+                         apply of the same phase. Scripts changed since, such as 
+                         by re-generating them, are applied again. Applied scripts 
+                         are recorded in 
+                         $HOME/gpAdminLogs/gpupgrade/apply_<phase>.checkpoint
+  --continue-on-error    continue applying the remaining scripts when a script 
+                         fails and report all failures at the end. Defaults to 
+                         stopping on the first error.
+  --databases            comma separated list of database patterns such as 
+                         "sales,hr_*" to apply scripts for. Defaults to the 
+                         databases the scripts were generated for.
+  --exclude-databases    comma separated list of database patterns such as 
+                         "test_*" to not apply scripts for.
+  --jobs                 number of script directories to apply in parallel. 
+                         0 applies all script directories in parallel. 
+                         Defaults to 4. The scripts applied by initialize, 
+                         finalize, and revert are applied for all script 
+                         directories in parallel.
 `
 const ConfigHelp = `
 The config subcommand allows one to view configuration parameters only after 
 initialize has started. It is useful for starting or connecting to the 
 target cluster by getting the target cluster data directory and port parameters.
 
+Without flags the entire persisted configuration is shown including the mode,
+ports, backup directories, pg_upgrade jobs, and the source, intermediate, and
+target cluster topologies and tablespaces.
+
 Usage: gpupgrade config show <flag>
 
 Optional Flags:
@@ -262,9 +354,169 @@ Optional Flags:
 --target-gphome
 --target-datadir
 --target-port
+--format           output format of either table or json. Defaults to table.
+--cluster          show the segments and tablespaces of either the source,
+                   intermediate, or target cluster similar to
+                   gp_segment_configuration.
 
 Example:
   gpupgrade config show --target-datadir
+  gpupgrade config show --format json
+  gpupgrade config show --cluster intermediate
+`
+
+const HistoryHelp = `
+Lists and shows upgrades that were finalized or reverted. Finalize and revert
+delete the state directory, so a record of each upgrade is kept in
+.gpupgrade_history next to the state directory. It includes the source and
+target versions, mode, hosts, start and end time, outcome, and the duration of
+each substep run.
+
+Usage: gpupgrade history list
+       gpupgrade history show <upgrade-id>
+
+Optional Flags:
+
+--format           output format of either table or json. Defaults to table.
+
+Example:
+  gpupgrade history list
+  gpupgrade history show AAAAAAAAAAA --format json
+`
+
+const ReportHelp = `
+Writes a self-contained report of a finalized or reverted upgrade for change
+management records. It includes the cluster topology, versions, mode, the
+timeline of substeps, disk usage before and after, the data migration scripts
+applied in each phase, warnings and next actions, and the archived paths.
+
+Finalize and revert generate the report in both formats in the log archive
+directory. Use this command to write it again, for example to another path.
+Without an upgrade ID the latest upgrade of the cluster is used.
+
+Usage: gpupgrade report [<upgrade-id>]
+
+Optional Flags:
+
+--format           output format of either markdown or html. Defaults to
+                   markdown.
+--output           path to write the report to. Defaults to the log archive
+                   directory of the upgrade.
+
+Example:
+  gpupgrade report
+  gpupgrade report AAAAAAAAAAA --format html --output /tmp/upgrade.html
+`
+
+const ConfigSetHelp = `
+Changes a setting that is safe to change between steps. The hub performs any
+needed side effects such as moving the backup directories, and persists the
+new value for subsequent gpupgrade commands. Settings cannot be changed while
+a step is in progress.
+
+Usage: gpupgrade config set <setting> <value>
+
+Settings:
+
+pg_upgrade_jobs      the number of jobs pg_upgrade uses, or "auto" to size
+                     them from the CPUs and primaries of each host. Can be
+                     changed after initialize or execute.
+master_pg_upgrade_jobs
+                     overrides pg_upgrade_jobs for the master when non-zero.
+                     Can be changed after initialize or execute.
+primary_pg_upgrade_jobs
+                     overrides pg_upgrade_jobs for the primaries when
+                     non-zero. Can be changed after initialize or execute.
+rsync_jobs           the number of mirrors rsynced in parallel on each host
+                     when upgrading the mirrors in link mode. 0 rsyncs every
+                     mirror on a host in parallel. Can be changed after
+                     initialize, execute, or a failed finalize.
+copy_fan_out         the number of hosts each host copies the upgraded master
+                     to in each round such that segment hosts relay it to
+                     their peers. 0 copies from the master host to every
+                     host. Can be changed after initialize or execute.
+copy_depth           the number of rounds used to copy the upgraded master
+                     when copy_fan_out is set. Can be changed after
+                     initialize or execute.
+parent_backup_dirs   parent directories on each host to store the backup of
+                     the coordinator data directory and user defined
+                     coordinator tablespaces. The existing backup directories
+                     are moved keeping any snapshots. Can be changed after
+                     initialize or a failed execute until the coordinator has
+                     been copied.
+use_hba_hostnames    use hostnames rather than IP addresses when adding
+                     replication entries to pg_hba.conf. Can be changed after
+                     initialize or execute.
+webhook_urls         comma separated http or https URLs the hub posts JSON
+                     events to when a step starts, completes, or fails, and
+                     for each substep status. An empty value disables
+                     notifications. Can be changed after any step.
+
+Example:
+  gpupgrade config set pg_upgrade_jobs 8
+  gpupgrade config set parent_backup_dirs "cdw:/data1,sdw1:/data2"
+  gpupgrade config set webhook_urls "https://alerts.example.com/gpupgrade"
+`
+
+const ConfigValidateHelp = `
+Validates a gpupgrade config file without starting the upgrade. Every error
+is reported along with its line number.
+
+Config files ending in .yaml or .yml use the structured YAML format which
+supports typed values, comments, environment variable interpolation such as
+${TARGET_GPHOME}, and per host settings. The coordinator host must be listed
+first. For example:
+
+  source_gphome: /usr/local/greenplum-db-5
+  target_gphome: ${TARGET_GPHOME}
+  source_master_port: 5432
+  mode: link
+  hosts:
+    cdw:
+      parent_backup_dir: /data1
+    sdw1:
+      parent_backup_dir: /data2
+
+All other config files use the "name = value" format such as gpupgrade_config.
+
+Usage: gpupgrade config validate <file>
+
+Optional Flags:
+
+  -h, --help   displays help output for config validate
+
+Example:
+  gpupgrade config validate gpupgrade_config.yaml
+`
+
+const CleanupHelp = `
+Deletes the source data directories and tablespace directories that finalize
+archived on all hosts. The archived data directories are named
+<datadir>.<upgradeID>.<contentID>.old and are verified to belong to the given
+upgrade ID before anything is deleted. A summary of the archived directories
+and their sizes is shown before asking to proceed.
+
+In link mode the archived directories share files with the upgraded cluster.
+Only the reclaimable size is freed when deleting them.
+
+Cleanup does not require the hub or the gpupgrade state directory. The
+upgraded cluster must be running in order to find the hosts and data
+directories.
+
+Usage: gpupgrade cleanup --upgrade-id <id> --gphome <path> --port <port>
+
+Required Flags:
+
+      --upgrade-id   the upgrade ID shown when finalize completed
+      --gphome       path to the upgraded Greenplum installation
+      --port         master port of the upgraded cluster
+
+Optional Flags:
+
+  -h, --help   displays help output for cleanup
+
+Example:
+  gpupgrade cleanup --upgrade-id ABC123 --gphome /usr/local/greenplum-db-6 --port 5432
 `
 
 const globalHelpText = `
@@ -291,6 +543,12 @@ Optional Commands:
 
   apply           applies data migration SQL scripts
 
+  cleanup         deletes the source data directories archived by finalize
+
+  history         lists and shows finalized and reverted upgrades
+
+  report          writes a report of a finalized or reverted upgrade
+
   config show     shows configuration parameters. 
                   One can only view the configuration parameters only 
                   after initialize has started. The config subcommand is
@@ -302,6 +560,17 @@ Optional Flags:
   -h, --help      displays help output for gpupgrade
   -v, --verbose   outputs detailed logs for gpupgrade
   -V, --version   displays the version of the current gpupgrade utility
+      --cluster-name <name>
+                  selects an independent upgrade when upgrading several
+                  clusters on the same hosts. Its state directory, log
+                  directory, backup directories, and default hub and agent
+                  ports are suffixed or derived from the name, and
+                  restart-services and kill-services only act on its hub and
+                  agents. Named clusters default to hub ports 17528-18527 and
+                  agent ports 16417-17416. Pass it to every command of the
+                  upgrade, or set GPUPGRADE_CLUSTER_NAME. Initialize fails if
+                  two names get the same ports, in which case set hub_port and
+                  agent_port in the config file.
 
 gpupgrade log files can be found on all hosts in %s
 
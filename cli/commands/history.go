@@ -0,0 +1,86 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"log"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/history"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+func historyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "history",
+		Short: "lists and shows finalized and reverted upgrades",
+		Long:  HistoryHelp,
+	}
+
+	cmd.AddCommand(historyList())
+	cmd.AddCommand(historyShow())
+
+	return addHelpToCommand(cmd, HistoryHelp)
+}
+
+func historyList() *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "list finalized and reverted upgrades",
+		Long:  "list finalized and reverted upgrades",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			records, err := history.List(utils.GetHistoryDir())
+			if err != nil {
+				return err
+			}
+
+			return commanders.ListHistory(os.Stdout, records, format)
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", commanders.ConfigFormatTable, "output format of either table or json")
+	return cmd
+}
+
+func historyShow() *cobra.Command {
+	var format string
+
+	cmd := &cobra.Command{
+		Use:   "show <upgrade-id>",
+		Short: "show an upgrade including the duration of each substep",
+		Long:  "show an upgrade including the duration of each substep",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			record, err := history.Read(utils.GetHistoryDir(), args[0])
+			if err != nil {
+				return err
+			}
+
+			return commanders.ShowHistory(os.Stdout, record, format)
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", commanders.ConfigFormatTable, "output format of either table or json")
+	return cmd
+}
+
+// saveHistory records the upgrade before the state directory is deleted.
+// Failing to do so does not fail the step since the upgrade has already been
+// finalized or reverted, and the state directory must still be deleted.
+func saveHistory(outcome string) {
+	err := history.Save(outcome)
+	if err != nil {
+		log.Printf("warning: recording upgrade history: %v", err)
+	}
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/greenplum-db/gpupgrade/config"
 	"github.com/greenplum-db/gpupgrade/hub"
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/tracing"
 	"github.com/greenplum-db/gpupgrade/upgrade"
 	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/daemon"
@@ -29,6 +30,7 @@ func Hub() *cobra.Command {
 		Args:   cobra.MaximumNArgs(0), //no positional args allowed
 		RunE: func(cmd *cobra.Command, args []string) error {
 			logger.Initialize("hub")
+			tracing.Initialize("hub")
 			defer logger.WritePanics()
 
 			exist, err := upgrade.PathExist(utils.GetStateDir())
@@ -6,7 +6,7 @@ package commands
 import (
 	"errors"
 	"fmt"
-	"os"
+	"log"
 	"path/filepath"
 	"strconv"
 	"strings"
@@ -23,8 +23,10 @@ import (
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/greenplum/connection"
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/notify"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/upgrade/snapshot"
 	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
 )
@@ -43,12 +45,20 @@ func initialize() *cobra.Command {
 	var pgUpgradeVerbose bool
 	var skipVersionCheck bool
 	var skipPgUpgradeChecks bool
-	var pgUpgradeJobs uint
+	var pgUpgradeJobs string
+	var coordinatorPgUpgradeJobs uint
+	var primaryPgUpgradeJobs uint
+	var rsyncJobs uint
+	var copyFanOut uint
+	var copyDepth uint
 	var ports string
 	var mode string
 	var useHbaHostnames bool
 	var dynamicLibraryPath string
 	var dataMigrationSeedDir string
+	var snapshotSettings snapshot.Settings
+	var webhookURLs string
+	var metricsPort int
 
 	subInit := &cobra.Command{
 		Use:   "initialize",
@@ -101,25 +111,20 @@ func initialize() *cobra.Command {
 
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
 			if cmd.Flag("file").Changed {
-				configFile, err := os.Open(file)
+				err = ReadConfigFile(cmd, file)
 				if err != nil {
 					return err
 				}
-				defer func() {
-					if cErr := configFile.Close(); cErr != nil {
-						err = errorlist.Append(err, cErr)
-					}
-				}()
+			}
 
-				flags, err := ParseConfig(configFile)
-				if err != nil {
-					return xerrors.Errorf("in file %q: %w", file, err)
-				}
+			// The default ports are offset for named clusters which are only
+			// known once the flags are parsed.
+			if !cmd.Flag("hub-port").Changed {
+				hubPort = upgrade.HubPort()
+			}
 
-				err = addFlags(cmd, flags)
-				if err != nil {
-					return err
-				}
+			if !cmd.Flag("agent-port").Changed {
+				agentPort = upgrade.AgentPort()
 			}
 
 			mode, err := parseMode(mode)
@@ -127,6 +132,11 @@ func initialize() *cobra.Command {
 				return err
 			}
 
+			parsedPgUpgradeJobs, err := config.ParsePgUpgradeJobs(pgUpgradeJobs)
+			if err != nil {
+				return err
+			}
+
 			// if diskFreeRatio is not explicitly set, use defaults
 			if !cmd.Flag("disk-free-ratio").Changed {
 				diskFreeRatio = 0.2
@@ -135,6 +145,25 @@ func initialize() *cobra.Command {
 				}
 			}
 
+			if copyFanOut > 0 && copyDepth == 0 {
+				// Match Cobra's option-error format.
+				return fmt.Errorf(`invalid argument %d for "--copy-depth" flag: value must be at least 1 when --copy-fan-out is set`, copyDepth)
+			}
+
+			parsedWebhookURLs, err := notify.ParseURLs(webhookURLs)
+			if err != nil {
+				return err
+			}
+
+			if metricsPort < 0 || metricsPort > 65535 {
+				// Match Cobra's option-error format.
+				return fmt.Errorf(`invalid argument %d for "--metrics-port" flag: value must be between 0 and 65535`, metricsPort)
+			}
+
+			if err := snapshotSettings.Validate(); err != nil {
+				return err
+			}
+
 			if diskFreeRatio < 0.0 || diskFreeRatio > 1.0 {
 				// Match Cobra's option-error format.
 				return fmt.Errorf(
@@ -168,7 +197,9 @@ func initialize() *cobra.Command {
 			confirmationText := fmt.Sprintf(initializeConfirmationText,
 				cases.Title(language.English).String(idl.Step_initialize.String()),
 				initializeSubsteps, logdir, configPath,
-				sourcePort, sourceGPHome, targetGPHome, mode, diskFreeRatio, pgUpgradeJobs, useHbaHostnames, dynamicLibraryPath, ports, hubPort, agentPort)
+				sourcePort, sourceGPHome, targetGPHome, mode, diskFreeRatio, pgUpgradeJobs, coordinatorPgUpgradeJobs, primaryPgUpgradeJobs, rsyncJobs, copyFanOut, copyDepth, useHbaHostnames, dynamicLibraryPath, ports, hubPort, agentPort,
+				snapshotSettings.Provider, snapshotSettings.SnapshotCommand, snapshotSettings.RestoreCommand, snapshotSettings.DeleteCommand,
+				strings.Join(parsedWebhookURLs, ","), metricsPort)
 
 			st, err := clistep.Begin(idl.Step_initialize, verbose, nonInteractive, confirmationText)
 			if err != nil {
@@ -185,6 +216,11 @@ func initialize() *cobra.Command {
 					return err
 				}
 
+				err = config.EnsurePortsAreNotUsedByOtherUpgrades(hubPort, agentPort)
+				if err != nil {
+					return err
+				}
+
 				db, err := connection.Bootstrap(idl.ClusterDestination_source, sourceGPHome, sourcePort)
 				if err != nil {
 					return err
@@ -198,13 +234,25 @@ func initialize() *cobra.Command {
 					db, hubPort, agentPort,
 					filepath.Clean(sourceGPHome),
 					filepath.Clean(targetGPHome),
-					mode, useHbaHostnames, parsedPorts, pgUpgradeJobs,
+					mode, useHbaHostnames, parsedPorts, parsedPgUpgradeJobs, rsyncJobs,
 					parentBackupDirs,
 				)
 				if err != nil {
 					return err
 				}
 
+				conf.Databases, err = greenplum.CountDatabases(db)
+				if err != nil {
+					return err
+				}
+
+				conf.CoordinatorPgUpgradeJobs = coordinatorPgUpgradeJobs
+				conf.PrimaryPgUpgradeJobs = primaryPgUpgradeJobs
+				conf.CopyFanOut = copyFanOut
+				conf.CopyDepth = copyDepth
+				conf.Snapshot = snapshotSettings
+				conf.WebhookURLs = parsedWebhookURLs
+				conf.MetricsPort = metricsPort
 				return conf.Write()
 			})
 
@@ -222,7 +270,7 @@ func initialize() *cobra.Command {
 					return nil
 				}
 
-				return commanders.GenerateDataMigrationScripts(streams, nonInteractive, sourceGPHome, sourcePort, filepath.Clean(dataMigrationSeedDir), generatedScriptsOutputDir, utils.System.DirFS(generatedScriptsOutputDir))
+				return commanders.GenerateDataMigrationScripts(streams, nonInteractive, sourceGPHome, sourcePort, filepath.Clean(dataMigrationSeedDir), generatedScriptsOutputDir, utils.System.DirFS(generatedScriptsOutputDir), commanders.DatabaseFilter{}, commanders.AllDataMigrationJobs)
 			})
 
 			st.AlwaysRun(idl.Substep_execute_stats_data_migration_scripts, func(streams step.OutStreams) error {
@@ -231,7 +279,7 @@ func initialize() *cobra.Command {
 				}
 
 				currentDir := filepath.Join(generatedScriptsOutputDir, "current")
-				return commanders.ApplyDataMigrationScripts(streams, nonInteractive, sourceGPHome, sourcePort, logdir, utils.System.DirFS(currentDir), currentDir, idl.Step_stats)
+				return commanders.ApplyDataMigrationScripts(streams, nonInteractive, sourceGPHome, sourcePort, logdir, utils.System.DirFS(currentDir), currentDir, idl.Step_stats, false, false, commanders.DatabaseFilter{}, commanders.AllDataMigrationJobs)
 			})
 
 			st.AlwaysRun(idl.Substep_execute_initialize_data_migration_scripts, func(streams step.OutStreams) error {
@@ -241,7 +289,7 @@ func initialize() *cobra.Command {
 
 				currentDir := filepath.Join(filepath.Clean(generatedScriptsOutputDir), "current")
 				err = commanders.ApplyDataMigrationScripts(streams, nonInteractive, sourceGPHome, sourcePort,
-					logdir, utils.System.DirFS(currentDir), currentDir, idl.Step_initialize)
+					logdir, utils.System.DirFS(currentDir), currentDir, idl.Step_initialize, false, false, commanders.DatabaseFilter{}, commanders.AllDataMigrationJobs)
 				if err != nil {
 					return err
 				}
@@ -261,6 +309,18 @@ func initialize() *cobra.Command {
 					DiskFreeRatio:    diskFreeRatio,
 					ParentBackupDirs: parentBackupDirs,
 				}
+
+				// Copy mode and the rsync snapshot provider additionally
+				// check each host has the space estimated by the stats
+				// report when one was written.
+				if mode == idl.Mode_copy || snapshotSettings.Provider == snapshot.RsyncProvider {
+					report, err := commanders.ReadStatsReport(utils.System.DirFS(utils.GetStateDir()))
+					if err != nil {
+						log.Printf("Not checking disk space against the stats report estimates: %v", err)
+					} else {
+						request.CopyModeDiskBytesByHost = report.Estimates.CopyModeDiskBytesByHost
+					}
+				}
 				err = commanders.Initialize(client, request, verbose)
 				if err != nil {
 					return err
@@ -289,7 +349,7 @@ func initialize() *cobra.Command {
 			})
 
 			revertWarning := ""
-			if !response.GetHasAllMirrorsAndStandby() && mode == idl.Mode_link {
+			if !response.GetHasAllMirrorsAndStandby() && mode == idl.Mode_link && !snapshotSettings.Enabled() {
 				revertWarning = revertWarningText
 			}
 
@@ -301,14 +361,25 @@ func initialize() *cobra.Command {
 	subInit.Flags().BoolVar(&pgUpgradeVerbose, "pg-upgrade-verbose", false, "execute pg_upgrade with --verbose")
 	subInit.Flags().BoolVar(&skipPgUpgradeChecks, "skip-pg-upgrade-checks", false, "skips pg_upgrade checks")
 	subInit.Flags().MarkHidden("skip-pg-upgrade-checks") //nolint
-	subInit.Flags().UintVar(&pgUpgradeJobs, "pg-upgrade-jobs", 4, "databases to upgrade in parallel based on the number of specified threads. Defaults to 4.")
+	subInit.Flags().StringVar(&pgUpgradeJobs, "pg-upgrade-jobs", "4", "databases to upgrade in parallel based on the number of specified threads, or \"auto\" to size the jobs from the CPUs and primaries of each host and the number of databases. Defaults to 4.")
+	subInit.Flags().UintVar(&coordinatorPgUpgradeJobs, "master-pg-upgrade-jobs", 0, "overrides --pg-upgrade-jobs for the master. Defaults to 0 which uses --pg-upgrade-jobs.")
+	subInit.Flags().UintVar(&primaryPgUpgradeJobs, "primary-pg-upgrade-jobs", 0, "overrides --pg-upgrade-jobs for the primaries. Defaults to 0 which uses --pg-upgrade-jobs.")
+	subInit.Flags().UintVar(&rsyncJobs, "rsync-jobs", config.DefaultRsyncJobs, "mirrors to rsync in parallel on each host when upgrading mirrors in link mode. Defaults to 0 which rsyncs every mirror on a host in parallel.")
+	subInit.Flags().UintVar(&copyFanOut, "copy-fan-out", 0, "hosts each host copies the upgraded master to in each round such that segment hosts relay it to their peers. Defaults to 0 which copies from the master host to every host.")
+	subInit.Flags().UintVar(&copyDepth, "copy-depth", config.DefaultCopyDepth, "rounds used to copy the upgraded master when --copy-fan-out is set. The last round copies to all remaining hosts. Defaults to 3.")
+	subInit.Flags().StringVar(&snapshotSettings.Provider, "snapshot-provider", "", "snapshots the source master and primaries in link mode before they are upgraded such that revert can restore them without mirrors and standby. Either \"command\" or \"rsync\".")
+	subInit.Flags().StringVar(&snapshotSettings.SnapshotCommand, "snapshot-command", "", "command run for each directory to snapshot when the snapshot provider is \"command\"")
+	subInit.Flags().StringVar(&snapshotSettings.RestoreCommand, "snapshot-restore-command", "", "command run for each directory to restore from its snapshot when the snapshot provider is \"command\"")
+	subInit.Flags().StringVar(&snapshotSettings.DeleteCommand, "snapshot-delete-command", "", "command run for each directory to delete its snapshot when the snapshot provider is \"command\"")
+	subInit.Flags().IntVar(&metricsPort, "metrics-port", 0, "the port gpupgrade hub serves Prometheus metrics on at /metrics. Defaults to 0 which disables the metrics listener.")
+	subInit.Flags().StringVar(&webhookURLs, "webhook-urls", "", "comma separated http or https URLs to post JSON events to when a step starts, completes, or fails, and for each substep status")
 	subInit.Flags().StringVarP(&file, "file", "f", "", "the configuration file to use")
 	subInit.Flags().BoolVar(&nonInteractive, "non-interactive", false, "do not prompt for confirmation to proceed")
 	subInit.Flags().MarkHidden("non-interactive") //nolint
 	subInit.Flags().IntVar(&sourcePort, "source-master-port", 0, "master port for source gpdb cluster")
 	subInit.Flags().StringVar(&sourceGPHome, "source-gphome", "", "path for the source Greenplum installation")
 	subInit.Flags().StringVar(&targetGPHome, "target-gphome", "", "path for the target Greenplum installation")
-	subInit.Flags().StringVar(&mode, "mode", "copy", "performs upgrade in either copy or link mode. Default is copy.")
+	subInit.Flags().StringVar(&mode, "mode", "copy", "performs upgrade in either copy, link, or clone mode. Clone mode requires filesystems supporting reflinks. Default is copy.")
 	subInit.Flags().StringVar(&parentBackupDirs, "parent-backup-dirs", "", "parent directories on each host to internally store the backup of the coordinator data directory and user defined coordinator tablespaces."+
 		"Defaults to the parent directory of each primary data directory on each primary host."+
 		"To specify a single directory across all hosts set /dir."+
@@ -388,25 +459,3 @@ func parseMode(input string) (idl.Mode, error) {
 
 	return idl.Mode_unknown_mode, fmt.Errorf("Invalid input %q. Please specify either %s.", input, strings.Join(choices, ", "))
 }
-
-func addFlags(cmd *cobra.Command, flags map[string]string) error {
-	for name, value := range flags {
-		flag := cmd.Flag(name)
-		if flag == nil {
-			var names []string
-			cmd.Flags().VisitAll(func(flag *pflag.Flag) {
-				names = append(names, flag.Name)
-			})
-			return xerrors.Errorf("The configuration parameter %q was not found in the list of supported parameters: %s.", name, strings.Join(names, ", "))
-		}
-
-		err := flag.Value.Set(value)
-		if err != nil {
-			return xerrors.Errorf("set %q to %q: %w", name, value, err)
-		}
-
-		cmd.Flag(name).Changed = true
-	}
-
-	return nil
-}
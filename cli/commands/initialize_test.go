@@ -4,14 +4,17 @@
 package commands
 
 import (
+	"errors"
 	"reflect"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
 )
 
 func TestParsePorts(t *testing.T) {
@@ -136,7 +139,7 @@ func TestParseMode(t *testing.T) {
 	}
 }
 
-func TestAddFlags(t *testing.T) {
+func TestSetConfigFlags(t *testing.T) {
 	t.Run("sets flags to correct value and marks them as changed", func(t *testing.T) {
 		var name string
 		var port int
@@ -154,9 +157,15 @@ func TestAddFlags(t *testing.T) {
 			"is-set": "true",
 		}
 
-		err := addFlags(&cmd, flags)
+		params := []configParam{
+			{name: "name", value: "value", line: 1},
+			{name: "port", value: "123", line: 2},
+			{name: "is_set", value: "true", line: 3},
+		}
+
+		err := setConfigFlags(&cmd, params)
 		if err != nil {
-			t.Errorf("addFlags returned error %+v", err)
+			t.Errorf("setConfigFlags returned error %+v", err)
 		}
 
 		// verify string flags
@@ -199,13 +208,46 @@ func TestAddFlags(t *testing.T) {
 	})
 
 	t.Run("errors when adding unknown parameter", func(t *testing.T) {
-		flags := map[string]string{
-			"unknown": "value",
+		params := []configParam{{name: "unknown", value: "value", line: 4}}
+
+		err := setConfigFlags(&cobra.Command{}, params)
+		expected := `line 4: The configuration parameter "unknown" was not found`
+		if err == nil || !strings.Contains(err.Error(), expected) {
+			t.Errorf("got error %#v want %q", err, expected)
 		}
+	})
 
-		err := addFlags(&cobra.Command{}, flags)
-		if err == nil {
-			t.Errorf("expected error %#v got nil", err)
+	t.Run("reports every invalid parameter with its line number", func(t *testing.T) {
+		var port int
+		var mode string
+		cmd := cobra.Command{}
+		cmd.Flags().IntVar(&port, "port", 0, "")
+		cmd.Flags().StringVar(&mode, "mode", "", "")
+
+		params := []configParam{
+			{name: "port", value: "abc", line: 2},
+			{name: "mode", value: "depeche", line: 5},
+		}
+
+		err := setConfigFlags(&cmd, params)
+
+		var errs errorlist.Errors
+		if !errors.As(err, &errs) {
+			t.Fatalf("got error %#v want type %T", err, errs)
+		}
+
+		expected := []string{
+			`line 2: invalid int value "abc" for parameter "port"`,
+			`line 5: invalid value "depeche" for parameter "mode"`,
+		}
+		if len(errs) != len(expected) {
+			t.Fatalf("got %d errors want %d: %v", len(errs), len(expected), errs)
+		}
+
+		for i, e := range errs {
+			if !strings.HasPrefix(e.Error(), expected[i]) {
+				t.Errorf("got error %q want prefix %q", e.Error(), expected[i])
+			}
 		}
 	})
 }
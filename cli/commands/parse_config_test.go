@@ -5,12 +5,34 @@ package commands_test
 
 import (
 	"fmt"
-	"strings"
+	"path/filepath"
 	"testing"
 
+	"github.com/spf13/cobra"
+
 	"github.com/greenplum-db/gpupgrade/cli/commands"
+	"github.com/greenplum-db/gpupgrade/testutils"
 )
 
+// readConfig reads the config through a command with a flag for each
+// parameter used by the test cases.
+func readConfig(t *testing.T, config string) (*cobra.Command, error) {
+	t.Helper()
+
+	dir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, dir)
+
+	path := filepath.Join(dir, "gpupgrade_config")
+	testutils.MustWriteToFile(t, path, config)
+
+	cmd := &cobra.Command{}
+	for _, name := range []string{"name", "name-with-dash", "config-name"} {
+		cmd.Flags().String(name, "", "")
+	}
+
+	return cmd, commands.ReadConfigFile(cmd, path)
+}
+
 func TestConfig(t *testing.T) {
 	cases := []struct {
 		description      string
@@ -85,18 +107,18 @@ func TestConfig(t *testing.T) {
 
 	for _, c := range cases {
 		t.Run(c.description, func(t *testing.T) {
-			flags, err := commands.ParseConfig(strings.NewReader(c.config))
+			cmd, err := readConfig(t, c.config)
 			if err != nil {
-				t.Errorf("ParseConfig returned error: %+v", err)
+				t.Errorf("ReadConfigFile returned error: %+v", err)
 			}
 
-			value, ok := flags[c.parameter]
-			if c.shouldBeExcluded && ok {
+			flag := cmd.Flag(c.parameter)
+			if c.shouldBeExcluded && flag.Changed {
 				t.Errorf("expected paramter %q to not be present", c.parameter)
 			}
 
-			if value != c.expected {
-				t.Errorf("flags[%q] = %q, want %q", c.parameter, value, c.expected)
+			if flag.Value.String() != c.expected {
+				t.Errorf("flag %q = %q, want %q", c.parameter, flag.Value.String(), c.expected)
 			}
 		})
 	}
@@ -141,7 +163,7 @@ func TestConfig(t *testing.T) {
 
 	for _, c := range errorCases {
 		t.Run(fmt.Sprintf("errors when %s", c.description), func(t *testing.T) {
-			_, err := commands.ParseConfig(strings.NewReader(c.config))
+			_, err := readConfig(t, c.config)
 			if err == nil {
 				t.Errorf("expected error %#v got nil", err)
 			}
@@ -0,0 +1,86 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package commands
+
+import (
+	"fmt"
+	"log"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/cli/commanders"
+	"github.com/greenplum-db/gpupgrade/report"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+func reportCmd() *cobra.Command {
+	var format string
+	var output string
+
+	cmd := &cobra.Command{
+		Use:   "report [<upgrade-id>]",
+		Short: "writes a report of a finalized or reverted upgrade",
+		Long:  ReportHelp,
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+
+			if format != report.FormatMarkdown && format != report.FormatHTML {
+				return xerrors.Errorf("invalid format %q. Please specify either %s or %s.", format, report.FormatMarkdown, report.FormatHTML)
+			}
+
+			var upgradeID string
+			if len(args) > 0 {
+				upgradeID = args[0]
+			}
+
+			logDir, err := utils.GetLogDir()
+			if err != nil {
+				return err
+			}
+
+			dir, r, err := commanders.FindReport(logDir, upgradeID)
+			if err != nil {
+				return err
+			}
+
+			if output == "" {
+				output = filepath.Join(dir, report.FileName(format))
+			}
+
+			var b strings.Builder
+			err = report.Render(&b, r, format)
+			if err != nil {
+				return err
+			}
+
+			err = utils.AtomicallyWrite(output, []byte(b.String()))
+			if err != nil {
+				return err
+			}
+
+			fmt.Printf("Upgrade report of %s written to %s\n", r.UpgradeID, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVar(&format, "format", report.FormatMarkdown, "output format of either markdown or html")
+	cmd.Flags().StringVar(&output, "output", "", "path to write the report to. Defaults to the log archive directory of the upgrade.")
+
+	return addHelpToCommand(cmd, ReportHelp)
+}
+
+// regenerateReport updates the report generated by the hub when archiving the
+// log directory with the data migration scripts applied afterwards. Failing to
+// do so does not fail the upgrade as the report can be written again with
+// "gpupgrade report".
+func regenerateReport(logArchiveDir string) {
+	_, err := report.Regenerate(logArchiveDir)
+	if err != nil {
+		log.Printf("regenerating upgrade report in %q: %v", logArchiveDir, err)
+	}
+}
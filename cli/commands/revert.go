@@ -6,6 +6,7 @@ package commands
 import (
 	"errors"
 	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/spf13/cobra"
@@ -15,6 +16,7 @@ import (
 	"github.com/greenplum-db/gpupgrade/cli/clistep"
 	"github.com/greenplum-db/gpupgrade/cli/commanders"
 	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/history"
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/upgrade"
@@ -24,12 +26,25 @@ import (
 func revert() *cobra.Command {
 	var verbose bool
 	var nonInteractive bool
+	var dryRun bool
+	var partial bool
 
 	cmd := &cobra.Command{
 		Use:   "revert",
 		Short: "reverts the upgrade and returns the cluster to its original state",
 		Long:  RevertHelp,
 		RunE: func(cmd *cobra.Command, args []string) (err error) {
+			if dryRun {
+				cmd.SilenceUsage = true
+
+				client, err := connectToHub()
+				if err != nil {
+					return err
+				}
+
+				return commanders.RevertPlan(os.Stdout, client, partial)
+			}
+
 			var response *idl.RevertResponse
 
 			logdir, err := utils.GetLogDir()
@@ -58,7 +73,7 @@ func revert() *cobra.Command {
 					return err
 				}
 
-				response, err = commanders.Revert(client, verbose)
+				response, err = commanders.Revert(client, verbose, partial)
 				if err != nil {
 					return err
 				}
@@ -81,7 +96,9 @@ func revert() *cobra.Command {
 				}
 
 				currentDir := filepath.Join(response.GetLogArchiveDirectory(), "data-migration-scripts", "current")
-				return commanders.ApplyDataMigrationScripts(streams, nonInteractive, source.GPHome, source.CoordinatorPort(), response.GetLogArchiveDirectory(), utils.System.DirFS(currentDir), currentDir, idl.Step_revert)
+				err := commanders.ApplyDataMigrationScripts(streams, nonInteractive, source.GPHome, source.CoordinatorPort(), response.GetLogArchiveDirectory(), utils.System.DirFS(currentDir), currentDir, idl.Step_revert, false, false, commanders.DatabaseFilter{}, commanders.AllDataMigrationJobs)
+				regenerateReport(response.GetLogArchiveDirectory())
+				return err
 			})
 
 			st.Run(idl.Substep_delete_master_statedir, func(streams step.OutStreams) error {
@@ -89,6 +106,8 @@ func revert() *cobra.Command {
 				// Disable the store so the step framework does not try to write
 				// to a non-existent status file.
 				st.DisableStore()
+
+				saveHistory(history.Reverted)
 				return upgrade.DeleteDirectories([]string{utils.GetStateDir()}, upgrade.StateDirectoryFiles, streams)
 			})
 
@@ -104,6 +123,8 @@ func revert() *cobra.Command {
 	cmd.Flags().BoolVarP(&verbose, "verbose", "v", false, "print the output stream from all substeps")
 	cmd.Flags().BoolVar(&nonInteractive, "non-interactive", false, "do not prompt for confirmation to proceed")
 	cmd.Flags().MarkHidden("non-interactive") //nolint
+	cmd.Flags().BoolVar(&dryRun, "dry-run", false, "list the directories revert would delete and restore on each host without reverting")
+	cmd.Flags().BoolVar(&partial, "partial", false, "in link mode only restore the source segments modified by pg_upgrade")
 
 	return addHelpToCommand(cmd, RevertHelp)
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/greenplum-db/gpupgrade/cli/commands"
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/tracing"
 	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/daemon"
 	"github.com/greenplum-db/gpupgrade/utils/logger"
@@ -22,6 +23,7 @@ func main() {
 	debug.SetTraceback("all")
 
 	logger.Initialize("cli")
+	tracing.Initialize("cli")
 	defer logger.WritePanics()
 
 	root := commands.BuildRootCommand()
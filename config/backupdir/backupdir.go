@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/utils"
 )
 
 type BackupDirs struct {
@@ -38,10 +39,10 @@ func ParseParentBackupDirs(input string, cluster greenplum.Cluster) (BackupDirs,
 
 	// set default backup directories
 	if input == "" {
-		backupDirs.CoordinatorBackupDir = filepath.Join(filepath.Dir(cluster.CoordinatorDataDir()), string(os.PathSeparator), ".gpupgrade")
+		backupDirs.CoordinatorBackupDir = filepath.Join(filepath.Dir(cluster.CoordinatorDataDir()), string(os.PathSeparator), utils.Namespace(".gpupgrade"))
 
 		for _, seg := range cluster.Primaries.ExcludingCoordinator() {
-			backupDirs.AgentHostsToBackupDir[seg.Hostname] = filepath.Join(filepath.Dir(seg.DataDir), string(os.PathSeparator), ".gpupgrade")
+			backupDirs.AgentHostsToBackupDir[seg.Hostname] = filepath.Join(filepath.Dir(seg.DataDir), string(os.PathSeparator), utils.Namespace(".gpupgrade"))
 		}
 
 		return backupDirs, nil
@@ -49,7 +50,7 @@ func ParseParentBackupDirs(input string, cluster greenplum.Cluster) (BackupDirs,
 
 	// parse single backup directory across all hosts
 	if !strings.ContainsAny(input, ",:") {
-		backupDir := filepath.Join(filepath.Clean(input), ".gpupgrade")
+		backupDir := filepath.Join(filepath.Clean(input), utils.Namespace(".gpupgrade"))
 
 		backupDirs.CoordinatorBackupDir = backupDir
 		for _, seg := range cluster.ExcludingCoordinatorOrStandby() {
@@ -65,7 +66,7 @@ func ParseParentBackupDirs(input string, cluster greenplum.Cluster) (BackupDirs,
 	for _, pair := range parts {
 		hostBackupParts := strings.Split(strings.TrimSpace(pair), ":")
 		host := strings.TrimSpace(hostBackupParts[0])
-		backupDir := filepath.Join(filepath.Clean(strings.TrimSpace(hostBackupParts[1])), ".gpupgrade")
+		backupDir := filepath.Join(filepath.Clean(strings.TrimSpace(hostBackupParts[1])), utils.Namespace(".gpupgrade"))
 
 		if parseCoordinator {
 			backupDirs.CoordinatorBackupDir = backupDir
@@ -6,8 +6,10 @@ package config
 import (
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	"golang.org/x/xerrors"
@@ -16,11 +18,100 @@ import (
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/upgrade/snapshot"
 	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/schema"
 )
 
 const ConfigFileName = "config.json"
 
+// Schema is the versioned format of config.json. Append a migration whenever
+// a field is renamed, removed, or its meaning changes such that config.json
+// files written by an older gpupgrade remain readable.
+var Schema = schema.Schema{
+	File: ConfigFileName,
+	Migrations: []schema.Migration{
+		schema.AddVersion,
+		addRsyncJobs,
+		addCopyFanOut,
+		pinPgUpgradeJobs,
+	},
+}
+
+// DefaultRsyncJobs rsyncs every mirror on a host in parallel when upgrading the
+// mirrors in link mode. Limiting the jobs is opt-in for hosts whose disks or
+// network are saturated by rsyncing every mirror at once.
+const DefaultRsyncJobs = 0
+
+// DefaultCopyDepth is the number of rounds used to copy the coordinator backup
+// to the segment hosts when copying through a fan-out tree.
+const DefaultCopyDepth = 3
+
+// AutoPgUpgradeJobs is the pg_upgrade_jobs value that sizes the jobs
+// automatically.
+const AutoPgUpgradeJobs = "auto"
+
+// ParsePgUpgradeJobs parses either a positive number of jobs or "auto" which
+// is returned as zero.
+func ParsePgUpgradeJobs(value string) (uint, error) {
+	if value == AutoPgUpgradeJobs {
+		return 0, nil
+	}
+
+	jobs, err := strconv.ParseUint(value, 10, 0)
+	if err != nil || jobs == 0 {
+		return 0, xerrors.Errorf("Invalid input %q. Please specify a positive integer or %q.", value, AutoPgUpgradeJobs)
+	}
+
+	return uint(jobs), nil
+}
+
+// FormatPgUpgradeJobs is the inverse of ParsePgUpgradeJobs.
+func FormatPgUpgradeJobs(jobs uint) string {
+	if jobs == 0 {
+		return AutoPgUpgradeJobs
+	}
+
+	return strconv.FormatUint(uint64(jobs), 10)
+}
+
+// addRsyncJobs defaults RsyncJobs for upgrades initialized before it was
+// configurable.
+func addRsyncJobs(doc map[string]any) error {
+	if _, ok := doc["RsyncJobs"]; !ok {
+		doc["RsyncJobs"] = DefaultRsyncJobs
+	}
+
+	return nil
+}
+
+// addCopyFanOut keeps copying the coordinator backup from the hub to every
+// host for upgrades initialized before the fan-out copy was configurable.
+func addCopyFanOut(doc map[string]any) error {
+	if _, ok := doc["CopyFanOut"]; !ok {
+		doc["CopyFanOut"] = 0
+	}
+
+	if _, ok := doc["CopyDepth"]; !ok {
+		doc["CopyDepth"] = DefaultCopyDepth
+	}
+
+	return nil
+}
+
+// pinPgUpgradeJobs keeps a PgUpgradeJobs of zero running pg_upgrade serially
+// for upgrades initialized before zero sized the jobs automatically. The
+// pg_upgrade of an older gpupgrade was passed "--jobs 0" which pg_upgrade
+// treats as a single job.
+func pinPgUpgradeJobs(doc map[string]any) error {
+	jobs, ok := doc["PgUpgradeJobs"].(json.Number)
+	if !ok || jobs.String() == "0" {
+		doc["PgUpgradeJobs"] = 1
+	}
+
+	return nil
+}
+
 type Config struct {
 	// We do not combine the state directory and backup directory for
 	// several reasons:
@@ -54,10 +145,68 @@ type Config struct {
 	Mode            idl.Mode
 	UseHbaHostnames bool
 	UpgradeID       string
-	PgUpgradeJobs   uint
+
+	// PgUpgradeJobs is the number of jobs passed to each pg_upgrade. Zero
+	// sizes the jobs automatically for the coordinator and the primaries on
+	// each host based on their CPU count and the number of databases.
+	PgUpgradeJobs uint
+
+	// CoordinatorPgUpgradeJobs and PrimaryPgUpgradeJobs override
+	// PgUpgradeJobs for the coordinator and primaries when non-zero.
+	CoordinatorPgUpgradeJobs uint
+	PrimaryPgUpgradeJobs     uint
+
+	// Databases is the number of databases in the source cluster which
+	// pg_upgrade upgrades. It bounds the automatically sized jobs since
+	// pg_upgrade does not use more jobs than databases. Zero is unknown.
+	Databases uint
+
+	// RsyncJobs is the number of mirrors rsynced in parallel on each host
+	// when upgrading the mirrors in link mode. Zero rsyncs every mirror on a
+	// host in parallel.
+	RsyncJobs uint
+
+	// CopyFanOut is the number of hosts each host that has the coordinator
+	// backup copies it to in each round. The hub copies to the first hosts
+	// which relay it to their peers. Zero copies from the hub to every host.
+	CopyFanOut uint
+
+	// CopyDepth is the number of rounds used to copy the coordinator backup
+	// when CopyFanOut is set. The last round copies to any remaining hosts.
+	CopyDepth uint
+
+	// MetricsPort is the port the hub serves metrics on for monitoring systems
+	// to scrape. Zero disables the metrics listener.
+	MetricsPort int
+
+	// WebhookURLs are posted JSON events when a step starts, completes, or
+	// fails, and for each substep status.
+	WebhookURLs []string `json:",omitempty"`
+
+	// MirrorsDeferred is set when finalize upgrades only the coordinator and
+	// primaries. The mirrors and standby are then added by "gpupgrade
+	// add-mirrors" once the cluster is available to users.
+	MirrorsDeferred bool
+
+	// Snapshot configures the provider that snapshots the source coordinator
+	// and primaries in link mode before pg_upgrade modifies them, such that a
+	// cluster without mirrors and a standby can be reverted.
+	Snapshot snapshot.Settings
+
+	// AffectedContents are the content IDs of the source coordinator and
+	// primaries that pg_upgrade modified in link mode. It is recorded by a
+	// partial revert before the source pg_control files are restored, which
+	// erases the evidence, such that a resumed revert restores the same
+	// segments. It is nil until recorded.
+	AffectedContents []int
+
+	// SchemaVersion is the version of the config.json format which is set
+	// when written.
+	SchemaVersion int
 }
 
 func (conf *Config) Write() error {
+	conf.SchemaVersion = Schema.Version()
 	contents, err := json.MarshalIndent(conf, "", "  ")
 	if err != nil {
 		return xerrors.Errorf("marshal configuration file: %w", err)
@@ -72,6 +221,11 @@ func Read() (*Config, error) {
 		return nil, err
 	}
 
+	contents, err = Schema.Migrate(contents)
+	if err != nil {
+		return nil, err
+	}
+
 	conf := &Config{}
 	err = json.Unmarshal(contents, &conf)
 	if err != nil {
@@ -81,11 +235,50 @@ func Read() (*Config, error) {
 	return conf, nil
 }
 
+// EnsurePortsAreNotUsedByOtherUpgrades returns an error when the hub or agent
+// port is used by another cluster being upgraded by the same user, such as
+// when two cluster names get the same default ports.
+func EnsurePortsAreNotUsedByOtherUpgrades(hubPort int, agentPort int) error {
+	stateDirs, err := utils.OtherStateDirs()
+	if err != nil {
+		return err
+	}
+
+	for _, stateDir := range stateDirs {
+		contents, err := os.ReadFile(filepath.Join(stateDir, ConfigFileName))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+
+		if err != nil {
+			return err
+		}
+
+		var other struct {
+			HubPort   int
+			AgentPort int
+		}
+		err = json.Unmarshal(contents, &other)
+		if err != nil {
+			return xerrors.Errorf("unmarshal configuration file %q: %w", stateDir, err)
+		}
+
+		for _, port := range []int{hubPort, agentPort} {
+			if port == other.HubPort || port == other.AgentPort {
+				return xerrors.Errorf("Port %d is used by the upgrade with state directory %q. "+
+					"Specify different ports with --hub-port and --agent-port.", port, stateDir)
+			}
+		}
+	}
+
+	return nil
+}
+
 func GetConfigFile() string {
 	return filepath.Join(utils.GetStateDir(), ConfigFileName)
 }
 
-func Create(db *sql.DB, hubPort int, agentPort int, sourceGPHome string, targetGPHome string, mode idl.Mode, useHbaHostnames bool, ports []int, pgUpgradeJobs uint, parentBackupDirs string) (Config, error) {
+func Create(db *sql.DB, hubPort int, agentPort int, sourceGPHome string, targetGPHome string, mode idl.Mode, useHbaHostnames bool, ports []int, pgUpgradeJobs uint, rsyncJobs uint, parentBackupDirs string) (Config, error) {
 	source, err := greenplum.ClusterFromDB(db, sourceGPHome, idl.ClusterDestination_source)
 	if err != nil {
 		return Config{}, xerrors.Errorf("retrieve source configuration: %w", err)
@@ -109,6 +302,7 @@ func Create(db *sql.DB, hubPort int, agentPort int, sourceGPHome string, targetG
 	config.UseHbaHostnames = useHbaHostnames
 	config.UpgradeID = upgrade.NewID()
 	config.PgUpgradeJobs = pgUpgradeJobs
+	config.RsyncJobs = rsyncJobs
 	config.BackupDirs, err = backupdir.ParseParentBackupDirs(parentBackupDirs, source)
 	if err != nil {
 		return Config{}, err
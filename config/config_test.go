@@ -4,8 +4,11 @@
 package config_test
 
 import (
+	"errors"
 	"os"
+	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/DATA-DOG/go-sqlmock"
@@ -17,6 +20,8 @@ import (
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/testutils"
 	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/schema"
 )
 
 func TestConfig(t *testing.T) {
@@ -50,6 +55,72 @@ func TestConfig(t *testing.T) {
 		if !reflect.DeepEqual(actual, conf) {
 			t.Errorf("wrote config %#v but wanted %#v", actual, conf)
 		}
+
+		if actual.SchemaVersion != config.Schema.Version() {
+			t.Errorf("got schema version %d want %d", actual.SchemaVersion, config.Schema.Version())
+		}
+	})
+
+	t.Run("reads configuration written before schema versions were introduced", func(t *testing.T) {
+		stateDir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, stateDir)
+
+		resetEnv := testutils.SetEnv(t, "GPUPGRADE_HOME", stateDir)
+		defer resetEnv()
+
+		testutils.MustWriteToFile(t, config.GetConfigFile(), `{"HubPort": 12345, "UpgradeID": "ABC123", "PgUpgradeJobs": 4}`)
+
+		actual, err := config.Read()
+		if err != nil {
+			t.Fatalf("loading config: %+v", err)
+		}
+
+		expected := &config.Config{HubPort: 12345, UpgradeID: "ABC123", PgUpgradeJobs: 4, RsyncJobs: config.DefaultRsyncJobs, CopyDepth: config.DefaultCopyDepth, SchemaVersion: config.Schema.Version()}
+		if !reflect.DeepEqual(actual, expected) {
+			t.Errorf("got config %#v want %#v", actual, expected)
+		}
+	})
+
+	t.Run("runs pg_upgrade serially for configuration written before jobs were sized automatically", func(t *testing.T) {
+		stateDir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, stateDir)
+
+		resetEnv := testutils.SetEnv(t, "GPUPGRADE_HOME", stateDir)
+		defer resetEnv()
+
+		cases := map[string]uint{
+			`{"SchemaVersion": 3, "PgUpgradeJobs": 0}`: 1,
+			`{"SchemaVersion": 3}`:                     1,
+			`{"SchemaVersion": 3, "PgUpgradeJobs": 8}`: 8,
+		}
+
+		for contents, expected := range cases {
+			testutils.MustWriteToFile(t, config.GetConfigFile(), contents)
+
+			actual, err := config.Read()
+			if err != nil {
+				t.Fatalf("loading config: %+v", err)
+			}
+
+			if actual.PgUpgradeJobs != expected {
+				t.Errorf("got PgUpgradeJobs %d for %s want %d", actual.PgUpgradeJobs, contents, expected)
+			}
+		}
+	})
+
+	t.Run("refuses configuration written by a newer version of gpupgrade", func(t *testing.T) {
+		stateDir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, stateDir)
+
+		resetEnv := testutils.SetEnv(t, "GPUPGRADE_HOME", stateDir)
+		defer resetEnv()
+
+		testutils.MustWriteToFile(t, config.GetConfigFile(), `{"SchemaVersion": 1000, "HubPort": 12345}`)
+
+		_, err := config.Read()
+		if !errors.Is(err, schema.ErrDowngrade) {
+			t.Errorf("got error %#v want %#v", err, schema.ErrDowngrade)
+		}
 	})
 }
 
@@ -80,6 +151,7 @@ func TestCreate(t *testing.T) {
 	const useHbaHostnames = false
 	const parentBackupDirs = ""
 	const pgUpgradeJobs = 1
+	const rsyncJobs = 2
 	ports, err := commands.ParsePorts("50432-65535")
 	if err != nil {
 		t.Fatal(err)
@@ -100,7 +172,7 @@ func TestCreate(t *testing.T) {
 			expectPgStatReplicationToReturn(mock)
 			expectPgTablespace(mock)
 
-			conf, err := config.Create(db, hubPort, agentPort, source.GPHome, targetGPHome, mode, useHbaHostnames, ports, pgUpgradeJobs, parentBackupDirs)
+			conf, err := config.Create(db, hubPort, agentPort, source.GPHome, targetGPHome, mode, useHbaHostnames, ports, pgUpgradeJobs, rsyncJobs, parentBackupDirs)
 			if err != nil {
 				t.Fatalf("unexpected error %#v", err)
 			}
@@ -126,7 +198,7 @@ func TestCreate(t *testing.T) {
 			expectPgStatReplicationToReturn(mock)
 			expectPgTablespace(mock)
 
-			conf, err := config.Create(db, hubPort, agentPort, source.GPHome, targetGPHome, mode, useHbaHostnames, ports, pgUpgradeJobs, parentBackupDirs)
+			conf, err := config.Create(db, hubPort, agentPort, source.GPHome, targetGPHome, mode, useHbaHostnames, ports, pgUpgradeJobs, rsyncJobs, parentBackupDirs)
 			if err != nil {
 				t.Fatalf("unexpected error %#v", err)
 			}
@@ -151,7 +223,7 @@ func TestCreate(t *testing.T) {
 			expectPgStatReplicationToReturn(mock)
 			expectPgTablespace(mock)
 
-			conf, err := config.Create(db, hubPort, agentPort, source.GPHome, targetGPHome, mode, useHbaHostnames, ports, pgUpgradeJobs, parentBackupDirs)
+			conf, err := config.Create(db, hubPort, agentPort, source.GPHome, targetGPHome, mode, useHbaHostnames, ports, pgUpgradeJobs, rsyncJobs, parentBackupDirs)
 			if err != nil {
 				t.Fatalf("unexpected error %#v", err)
 			}
@@ -168,7 +240,7 @@ func TestCreate(t *testing.T) {
 		expectPgStatReplicationToReturn(mock)
 		expectPgTablespace(mock)
 
-		conf, err := config.Create(db, hubPort, agentPort, source.GPHome, targetGPHome, mode, useHbaHostnames, ports, pgUpgradeJobs, parentBackupDirs)
+		conf, err := config.Create(db, hubPort, agentPort, source.GPHome, targetGPHome, mode, useHbaHostnames, ports, pgUpgradeJobs, rsyncJobs, parentBackupDirs)
 		if err != nil {
 			t.Fatalf("unexpected error %#v", err)
 		}
@@ -214,6 +286,10 @@ func TestCreate(t *testing.T) {
 			t.Errorf("got %d want %d", conf.PgUpgradeJobs, pgUpgradeJobs)
 		}
 
+		if conf.RsyncJobs != rsyncJobs {
+			t.Errorf("got %d want %d", conf.RsyncJobs, rsyncJobs)
+		}
+
 		if conf.UpgradeID == "" {
 			t.Errorf("expected non-empty UpgradeID")
 		}
@@ -249,3 +325,73 @@ func expectPgStatReplicationToReturn(mock sqlmock.Sqlmock) {
 WHERE state = 'streaming' AND sent_location = flush_location;`).
 		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(1))
 }
+
+func TestParsePgUpgradeJobs(t *testing.T) {
+	cases := []struct {
+		value    string
+		expected uint
+		valid    bool
+	}{
+		{"8", 8, true},
+		{"auto", 0, true},
+		{"0", 0, false},
+		{"-1", 0, false},
+		{"many", 0, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.value, func(t *testing.T) {
+			jobs, err := config.ParsePgUpgradeJobs(c.value)
+			if c.valid && err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+
+			if !c.valid && err == nil {
+				t.Fatalf("expected an error")
+			}
+
+			if jobs != c.expected {
+				t.Errorf("got %d want %d", jobs, c.expected)
+			}
+
+			if c.valid && config.FormatPgUpgradeJobs(jobs) != c.value {
+				t.Errorf("got %q want %q", config.FormatPgUpgradeJobs(jobs), c.value)
+			}
+		})
+	}
+}
+
+func TestEnsurePortsAreNotUsedByOtherUpgrades(t *testing.T) {
+	home := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, home)
+
+	stateDir := filepath.Join(home, ".gpupgrade")
+	t.Setenv("GPUPGRADE_HOME", stateDir)
+
+	testutils.MustCreateDir(t, stateDir)
+	testutils.MustWriteToFile(t, filepath.Join(stateDir, config.ConfigFileName), `{"HubPort": 7527, "AgentPort": 6416}`)
+
+	testutils.MustCreateDir(t, stateDir+"-staging")
+	testutils.MustWriteToFile(t, filepath.Join(stateDir+"-staging", config.ConfigFileName), `{"HubPort": 17600, "AgentPort": 16500}`)
+
+	testutils.MustCreateDir(t, stateDir+"-prod")
+	testutils.MustWriteToFile(t, filepath.Join(stateDir+"-prod", config.ConfigFileName), `{"HubPort": 17700, "AgentPort": 16600}`)
+
+	t.Setenv(utils.ClusterNameEnv, "prod")
+
+	t.Run("succeeds when the ports are only used by the selected cluster", func(t *testing.T) {
+		err := config.EnsurePortsAreNotUsedByOtherUpgrades(17700, 16600)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+	})
+
+	t.Run("errors when another cluster uses the ports", func(t *testing.T) {
+		for _, ports := range [][2]int{{17600, 16601}, {17701, 16500}, {7527, 16600}, {17700, 7527}} {
+			err := config.EnsurePortsAreNotUsedByOtherUpgrades(ports[0], ports[1])
+			if err == nil || !strings.Contains(err.Error(), "--hub-port and --agent-port") {
+				t.Errorf("got error %v for ports %v", err, ports)
+			}
+		}
+	})
+}
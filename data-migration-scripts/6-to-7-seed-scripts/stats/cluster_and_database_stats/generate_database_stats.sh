@@ -11,6 +11,7 @@ SELECT COUNT(*) AS InstalledExtensions FROM pg_catalog.pg_extension;
 
 -- Database Size
 SELECT pg_size_pretty(pg_database_size(current_database())) AS DatabaseSize;
+SELECT pg_database_size(current_database()) AS DatabaseSizeBytes;
 SELECT COUNT(*) as Databases FROM pg_catalog.pg_database;
 
 -- No. of Triggers
@@ -218,6 +218,31 @@ func (c *Cluster) HasMirrors() bool {
 	return false
 }
 
+// PrimariesOnly returns a copy of the cluster without the mirrors and standby.
+func (c Cluster) PrimariesOnly() *Cluster {
+	c.Mirrors = ContentToSegConfig{}
+	return &c
+}
+
+// WithContents returns a copy of the cluster with only the segments of the
+// given contents. Use content -1 to include the coordinator and standby.
+func (c Cluster) WithContents(contents ...int) *Cluster {
+	primaries, mirrors := ContentToSegConfig{}, ContentToSegConfig{}
+	for _, content := range contents {
+		if seg, ok := c.Primaries[content]; ok {
+			primaries[content] = seg
+		}
+
+		if seg, ok := c.Mirrors[content]; ok {
+			mirrors[content] = seg
+		}
+	}
+
+	c.Primaries = primaries
+	c.Mirrors = mirrors
+	return &c
+}
+
 func (c *Cluster) HasAllMirrorsAndStandby() bool {
 	for content := range c.Primaries {
 		if _, ok := c.Mirrors[content]; !ok {
@@ -66,6 +66,58 @@ func TestHasMirrors(t *testing.T) {
 	}
 }
 
+func TestPrimariesOnly(t *testing.T) {
+	cluster := MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "coordinator", DataDir: "/data/qddir/seg-1", Port: 15432, Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: -1, Hostname: "standby", DataDir: "/data/standby", Port: 16432, Role: greenplum.MirrorRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg1", Port: 25433, Role: greenplum.PrimaryRole},
+		{DbID: 4, ContentID: 0, Hostname: "sdw2", DataDir: "/data/dbfast_mirror1/seg1", Port: 25434, Role: greenplum.MirrorRole},
+	})
+
+	primaries := cluster.PrimariesOnly()
+	if primaries.HasMirrors() || primaries.HasStandby() {
+		t.Errorf("expected no mirrors or standby got %v", primaries.Mirrors)
+	}
+
+	if !reflect.DeepEqual(primaries.Primaries, cluster.Primaries) {
+		t.Errorf("got primaries %v want %v", primaries.Primaries, cluster.Primaries)
+	}
+
+	if !cluster.HasMirrors() || !cluster.HasStandby() {
+		t.Errorf("expected the original cluster to retain its mirrors and standby")
+	}
+}
+
+func TestWithContents(t *testing.T) {
+	cluster := MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "coordinator", DataDir: "/data/qddir/seg-1", Port: 15432, Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: -1, Hostname: "standby", DataDir: "/data/standby", Port: 16432, Role: greenplum.MirrorRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg1", Port: 25433, Role: greenplum.PrimaryRole},
+		{DbID: 4, ContentID: 0, Hostname: "sdw2", DataDir: "/data/dbfast_mirror1/seg1", Port: 25434, Role: greenplum.MirrorRole},
+		{DbID: 5, ContentID: 1, Hostname: "sdw2", DataDir: "/data/dbfast2/seg2", Port: 25435, Role: greenplum.PrimaryRole},
+		{DbID: 6, ContentID: 1, Hostname: "sdw1", DataDir: "/data/dbfast_mirror2/seg2", Port: 25436, Role: greenplum.MirrorRole},
+	})
+
+	filtered := cluster.WithContents(1)
+	expected := MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 5, ContentID: 1, Hostname: "sdw2", DataDir: "/data/dbfast2/seg2", Port: 25435, Role: greenplum.PrimaryRole},
+		{DbID: 6, ContentID: 1, Hostname: "sdw1", DataDir: "/data/dbfast_mirror2/seg2", Port: 25436, Role: greenplum.MirrorRole},
+	})
+
+	if !reflect.DeepEqual(filtered.Primaries, expected.Primaries) || !reflect.DeepEqual(filtered.Mirrors, expected.Mirrors) {
+		t.Errorf("got primaries %v and mirrors %v want %v and %v", filtered.Primaries, filtered.Mirrors, expected.Primaries, expected.Mirrors)
+	}
+
+	if len(cluster.Primaries) != 3 || len(cluster.Mirrors) != 3 {
+		t.Errorf("expected the original cluster to retain all segments")
+	}
+
+	filtered = cluster.WithContents(-1)
+	if !filtered.HasStandby() || filtered.CoordinatorDataDir() != "/data/qddir/seg-1" || len(filtered.Primaries) != 1 {
+		t.Errorf("expected only the coordinator and standby got %v and %v", filtered.Primaries, filtered.Mirrors)
+	}
+}
+
 func TestGetSegmentConfiguration(t *testing.T) {
 	t.Run("can retrieve gp_segment_configuration", func(t *testing.T) {
 		db, mock, err := sqlmock.New()
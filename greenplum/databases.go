@@ -0,0 +1,22 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package greenplum
+
+import (
+	"database/sql"
+
+	"golang.org/x/xerrors"
+)
+
+// CountDatabases returns the number of databases pg_upgrade upgrades which
+// excludes template0 since it does not allow connections.
+func CountDatabases(db *sql.DB) (uint, error) {
+	var count uint
+	err := db.QueryRow(`SELECT count(*) FROM pg_database WHERE datallowconn;`).Scan(&count)
+	if err != nil {
+		return 0, xerrors.Errorf("count databases: %w", err)
+	}
+
+	return count, nil
+}
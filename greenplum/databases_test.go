@@ -0,0 +1,46 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package greenplum_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/testutils"
+)
+
+func TestCountDatabases(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	if err != nil {
+		t.Fatalf("couldn't create sqlmock: %v", err)
+	}
+	defer testutils.FinishMock(mock, t)
+
+	t.Run("counts the databases allowing connections", func(t *testing.T) {
+		mock.ExpectQuery(`SELECT count\(\*\) FROM pg_database WHERE datallowconn;`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		count, err := greenplum.CountDatabases(db)
+		if err != nil {
+			t.Fatalf("unexpected error: %#v", err)
+		}
+
+		if count != 3 {
+			t.Errorf("got %d want 3", count)
+		}
+	})
+
+	t.Run("returns query errors", func(t *testing.T) {
+		expected := errors.New("connection refused")
+		mock.ExpectQuery(`SELECT count\(\*\) FROM pg_database WHERE datallowconn;`).WillReturnError(expected)
+
+		_, err := greenplum.CountDatabases(db)
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v want %#v", err, expected)
+		}
+	})
+}
@@ -0,0 +1,179 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+// Package history keeps a record of each finished upgrade outside of the state
+// directory, which is deleted by finalize and revert, for trend analysis and
+// audits.
+package history
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+const (
+	Finalized = "finalized"
+	Reverted  = "reverted"
+)
+
+var ErrNotFound = errors.New("upgrade not found in history")
+
+type Record struct {
+	UpgradeID     string
+	ClusterName   string `json:",omitempty"`
+	SourceVersion string
+	TargetVersion string
+	Mode          string
+	Hosts         []string
+	Start         time.Time
+	End           time.Time
+	Outcome       string
+	Substeps      []step.SubstepDuration
+}
+
+func (r Record) Duration() time.Duration {
+	if r.Start.IsZero() {
+		return 0
+	}
+
+	return r.End.Sub(r.Start)
+}
+
+// NewRecord summarizes an upgrade from its configuration and substep runs. The
+// upgrade starts with its first substep and ends when the record is created.
+func NewRecord(conf *config.Config, durations []step.SubstepDuration, outcome string, end time.Time) Record {
+	record := Record{
+		UpgradeID:   conf.UpgradeID,
+		ClusterName: utils.GetClusterName(),
+		Mode:        conf.Mode.String(),
+		End:         end,
+		Outcome:     outcome,
+		Substeps:    durations,
+	}
+
+	if conf.Source != nil {
+		record.SourceVersion = conf.Source.Version.String()
+		record.Hosts = conf.Source.Hosts()
+		sort.Strings(record.Hosts)
+	}
+
+	for _, target := range []*greenplum.Cluster{conf.Target, conf.Intermediate} {
+		if target != nil {
+			record.TargetVersion = target.Version.String()
+			break
+		}
+	}
+
+	for _, duration := range durations {
+		if record.Start.IsZero() || duration.Start.Before(record.Start) {
+			record.Start = duration.Start
+		}
+	}
+
+	return record
+}
+
+// Save records the upgrade of the state directory with the given outcome. It
+// is called before the state directory is deleted.
+func Save(outcome string) error {
+	conf, err := config.Read()
+	if err != nil {
+		return xerrors.Errorf("read config: %w", err)
+	}
+
+	durations, err := step.ReadDurations(utils.GetStateDir())
+	if err != nil {
+		return err
+	}
+
+	return Write(utils.GetHistoryDir(), NewRecord(conf, durations, outcome, time.Now()))
+}
+
+// Write stores the record as a file named after the upgrade ID such that
+// recording the same upgrade again, for example when retrying finalize,
+// replaces the earlier record.
+func Write(dir string, record Record) error {
+	err := os.MkdirAll(dir, 0700)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(record, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return utils.AtomicallyWrite(filepath.Join(dir, record.UpgradeID+".json"), data)
+}
+
+// List returns all recorded upgrades ordered by their start.
+func List(dir string) ([]Record, error) {
+	entries, err := os.ReadDir(dir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var records []Record
+	for _, entry := range entries {
+		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".json") {
+			continue
+		}
+
+		record, err := read(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	sort.SliceStable(records, func(i, j int) bool {
+		return records[i].Start.Before(records[j].Start)
+	})
+
+	return records, nil
+}
+
+func Read(dir string, upgradeID string) (Record, error) {
+	if upgradeID == "" || filepath.Base(upgradeID) != upgradeID {
+		return Record{}, xerrors.Errorf("%q: %w", upgradeID, ErrNotFound)
+	}
+
+	record, err := read(filepath.Join(dir, upgradeID+".json"))
+	if errors.Is(err, os.ErrNotExist) {
+		return Record{}, xerrors.Errorf("%q: %w", upgradeID, ErrNotFound)
+	}
+
+	return record, err
+}
+
+func read(path string) (Record, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return Record{}, err
+	}
+
+	var record Record
+	err = json.Unmarshal(data, &record)
+	if err != nil {
+		return Record{}, xerrors.Errorf("read %q: %w", path, err)
+	}
+
+	return record, nil
+}
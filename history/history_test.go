@@ -0,0 +1,149 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package history_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/history"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+func TestNewRecord(t *testing.T) {
+	source := greenplum.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "cdw", DataDir: "/data/qddir/seg-1", Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: 0, Hostname: "sdw2", DataDir: "/data/dbfast1/seg0", Role: greenplum.PrimaryRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast_mirror1/seg0", Role: greenplum.MirrorRole},
+	})
+	source.Version = semver.MustParse("6.25.0")
+
+	intermediate := greenplum.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "cdw", DataDir: "/data/qddir/seg.AAAAAAAAAAA.-1", Role: greenplum.PrimaryRole},
+	})
+	intermediate.Version = semver.MustParse("7.1.0")
+
+	conf := &config.Config{UpgradeID: "AAAAAAAAAAA", Mode: idl.Mode_link, Source: source, Intermediate: intermediate}
+
+	start := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	durations := []step.SubstepDuration{
+		{Step: "initialize", Substep: "check_upgrade", Status: "complete", Start: start.Add(time.Minute), Duration: time.Minute},
+		{Step: "initialize", Substep: "saving_source_cluster_config", Status: "complete", Start: start, Duration: time.Second},
+	}
+	end := start.Add(time.Hour)
+
+	t.Setenv(utils.ClusterNameEnv, "prod")
+	record := history.NewRecord(conf, durations, history.Finalized, end)
+
+	expected := history.Record{
+		UpgradeID:     "AAAAAAAAAAA",
+		ClusterName:   "prod",
+		SourceVersion: "6.25.0",
+		TargetVersion: "7.1.0",
+		Mode:          "link",
+		Hosts:         []string{"cdw", "sdw1", "sdw2"},
+		Start:         start,
+		End:           end,
+		Outcome:       history.Finalized,
+		Substeps:      durations,
+	}
+
+	if !reflect.DeepEqual(record, expected) {
+		t.Errorf("got record %+v want %+v", record, expected)
+	}
+
+	if record.Duration() != time.Hour {
+		t.Errorf("got duration %s want %s", record.Duration(), time.Hour)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	dir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, dir)
+
+	historyDir := filepath.Join(dir, ".gpupgrade_history")
+
+	t.Run("lists nothing when no upgrades are recorded", func(t *testing.T) {
+		records, err := history.List(historyDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(records) != 0 {
+			t.Errorf("got records %v want none", records)
+		}
+	})
+
+	start := time.Date(2023, 5, 1, 10, 0, 0, 0, time.UTC)
+	later := history.Record{UpgradeID: "BBBBBBBBBBB", Outcome: history.Reverted, Start: start.Add(time.Hour), End: start.Add(2 * time.Hour)}
+	earlier := history.Record{UpgradeID: "AAAAAAAAAAA", Outcome: history.Finalized, Start: start, End: start.Add(time.Hour)}
+
+	t.Run("lists the written upgrades by their start", func(t *testing.T) {
+		for _, record := range []history.Record{later, earlier} {
+			err := history.Write(historyDir, record)
+			if err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+		}
+
+		records, err := history.List(historyDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := []history.Record{earlier, later}
+		if !reflect.DeepEqual(records, expected) {
+			t.Errorf("got records %+v want %+v", records, expected)
+		}
+	})
+
+	t.Run("replaces the record of the same upgrade", func(t *testing.T) {
+		retried := earlier
+		retried.End = start.Add(3 * time.Hour)
+
+		err := history.Write(historyDir, retried)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		record, err := history.Read(historyDir, earlier.UpgradeID)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if !reflect.DeepEqual(record, retried) {
+			t.Errorf("got record %+v want %+v", record, retried)
+		}
+	})
+
+	t.Run("errors when the upgrade is not recorded", func(t *testing.T) {
+		for _, upgradeID := range []string{"CCCCCCCCCCC", "", "../.gpupgrade/config"} {
+			_, err := history.Read(historyDir, upgradeID)
+			if !errors.Is(err, history.ErrNotFound) {
+				t.Errorf("got error %#v want %#v for %q", err, history.ErrNotFound, upgradeID)
+			}
+		}
+	})
+
+	t.Run("errors when a record is malformed", func(t *testing.T) {
+		testutils.MustWriteToFile(t, filepath.Join(historyDir, "DDDDDDDDDDD.json"), "{")
+		defer os.Remove(filepath.Join(historyDir, "DDDDDDDDDDD.json"))
+
+		_, err := history.List(historyDir)
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
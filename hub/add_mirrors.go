@@ -0,0 +1,62 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+// AddMirrors adds the mirrors and standby to the upgraded target cluster after
+// finalize was run with deferred mirrors. The target cluster remains online
+// and available to users, thus gpaddmirrors is used in both copy and link mode
+// rather than rsync which requires the cluster to be stopped. Completed
+// substeps are skipped when re-run such that a failed add-mirrors resumes.
+func (s *Server) AddMirrors(req *idl.AddMirrorsRequest, stream idl.CliToHub_AddMirrorsServer) (err error) {
+	if !s.MirrorsDeferred {
+		return xerrors.New(`The mirrors and standby were upgraded during finalize. To defer them run "gpupgrade finalize --defer-mirrors".`)
+	}
+
+	st, err := step.Begin(idl.Step_add_mirrors, stream)
+	if err != nil {
+		return err
+	}
+
+	st.AlwaysRun(idl.Substep_ensure_gpupgrade_agents_are_running, func(_ step.OutStreams) error {
+		_, err := RestartAgents(context.Background(), nil, AgentHosts(s.Source), s.AgentPort, utils.GetStateDir())
+		if err != nil {
+			return err
+		}
+
+		_, err = s.AgentConns()
+		if err != nil {
+			return err
+		}
+
+		return nil
+	})
+
+	st.RunConditionally(idl.Substep_upgrade_mirrors, s.Target.HasMirrors(), func(streams step.OutStreams) error {
+		return UpgradeMirrorsUsingGpAddMirrors(streams, s.Target, s.UseHbaHostnames)
+	})
+
+	st.RunConditionally(idl.Substep_upgrade_standby, s.Target.HasStandby(), func(streams step.OutStreams) error {
+		return UpgradeStandby(streams, s.Target, s.UseHbaHostnames)
+	})
+
+	st.Run(idl.Substep_wait_for_cluster_to_be_ready_after_adding_mirrors_and_standby, func(streams step.OutStreams) error {
+		return s.Target.WaitForClusterToBeReady()
+	})
+
+	st.AlwaysRun(idl.Substep_delete_segment_statedirs, func(_ step.OutStreams) error {
+		return DeleteStateDirectories(s.agentConns, s.Source.CoordinatorHostname())
+	})
+
+	return st.Err()
+}
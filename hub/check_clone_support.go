@@ -0,0 +1,90 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+	"sort"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+// CloneDirectories returns for each host the source directories of the
+// coordinator and primaries whose files pg_upgrade clones in clone mode, along
+// with the directory they are cloned into. Data directories are cloned into
+// the corresponding intermediate data directory, while user defined tablespaces
+// are upgraded within their source location.
+func CloneDirectories(source *greenplum.Cluster, intermediate *greenplum.Cluster) map[string][]*idl.CloneDirectories {
+	hostDirs := make(map[string][]*idl.CloneDirectories)
+
+	segs := source.SelectSegments(func(seg *greenplum.SegConfig) bool {
+		return seg.IsCoordinator() || seg.IsPrimary()
+	})
+	sort.Sort(segs)
+
+	for _, seg := range segs {
+		hostDirs[seg.Hostname] = append(hostDirs[seg.Hostname], &idl.CloneDirectories{
+			Source: seg.DataDir,
+			Target: intermediate.Primaries[seg.ContentID].DataDir,
+		})
+
+		var oids []int
+		for oid, tsInfo := range source.Tablespaces[int32(seg.DbID)] {
+			if tsInfo.GetUserDefined() {
+				oids = append(oids, int(oid))
+			}
+		}
+		sort.Ints(oids)
+
+		for _, oid := range oids {
+			location := source.Tablespaces[int32(seg.DbID)][int32(oid)].GetLocation()
+			hostDirs[seg.Hostname] = append(hostDirs[seg.Hostname], &idl.CloneDirectories{Source: location, Target: location})
+		}
+	}
+
+	return hostDirs
+}
+
+// CheckCloneSupport verifies that pg_upgrade can clone rather than copy the
+// files of the source coordinator and primaries. The coordinator is checked
+// locally and the primaries on each segment host.
+func CheckCloneSupport(agentConns []*idl.Connection, source *greenplum.Cluster, intermediate *greenplum.Cluster) error {
+	if intermediate.Version.Major < 7 {
+		return xerrors.Errorf("clone mode requires a target cluster of version 7 or later since pg_upgrade %s does not support --clone", intermediate.Version)
+	}
+
+	var contents []int
+	for content := range source.Primaries {
+		if content != -1 {
+			contents = append(contents, content)
+		}
+	}
+
+	coordinatorDirs := CloneDirectories(source.WithContents(-1), intermediate)[source.CoordinatorHostname()]
+	hostDirs := CloneDirectories(source.WithContents(contents...), intermediate)
+
+	request := func(conn *idl.Connection) error {
+		dirs := hostDirs[conn.Hostname]
+		if len(dirs) == 0 {
+			return nil
+		}
+
+		_, err := conn.AgentClient.CheckCloneSupport(context.Background(), &idl.CheckCloneSupportRequest{Directories: dirs})
+		if err != nil {
+			return xerrors.Errorf("check clone support on host %s: %w", conn.Hostname, err)
+		}
+
+		return nil
+	}
+
+	var err error
+	err = errorlist.Append(err, upgrade.CheckCloneSupport(coordinatorDirs))
+	err = errorlist.Append(err, ExecuteRPC(agentConns, request))
+	return err
+}
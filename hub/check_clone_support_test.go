@@ -0,0 +1,143 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/golang/mock/gomock"
+
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/hub"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/idl/mock_idl"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+)
+
+func cloneIntermediateCluster(t *testing.T) *greenplum.Cluster {
+	intermediate := hub.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "coordinator", DataDir: "/data/qddir/seg.AAAAAAAAAAA.-1", Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg.AAAAAAAAAAA.0", Role: greenplum.PrimaryRole},
+		{DbID: 3, ContentID: 1, Hostname: "sdw1", DataDir: "/data/dbfast2/seg.AAAAAAAAAAA.1", Role: greenplum.PrimaryRole},
+		{DbID: 4, ContentID: 2, Hostname: "sdw2", DataDir: "/data/dbfast3/seg.AAAAAAAAAAA.2", Role: greenplum.PrimaryRole},
+	})
+	intermediate.Version = semver.MustParse("7.0.0")
+
+	return intermediate
+}
+
+func TestCloneDirectories(t *testing.T) {
+	hostDirs := hub.CloneDirectories(snapshotCluster(t), cloneIntermediateCluster(t))
+
+	expected := map[string][]*idl.CloneDirectories{
+		"coordinator": {
+			{Source: "/data/qddir/seg-1", Target: "/data/qddir/seg.AAAAAAAAAAA.-1"},
+			{Source: "/tablespace/1/16384", Target: "/tablespace/1/16384"},
+		},
+		"sdw1": {
+			{Source: "/data/dbfast1/seg0", Target: "/data/dbfast1/seg.AAAAAAAAAAA.0"},
+			{Source: "/tablespace/2/16384", Target: "/tablespace/2/16384"},
+			{Source: "/data/dbfast2/seg1", Target: "/data/dbfast2/seg.AAAAAAAAAAA.1"},
+		},
+		"sdw2": {
+			{Source: "/data/dbfast3/seg2", Target: "/data/dbfast3/seg.AAAAAAAAAAA.2"},
+		},
+	}
+
+	if !reflect.DeepEqual(hostDirs, expected) {
+		t.Errorf("got %v want %v", hostDirs, expected)
+	}
+}
+
+func TestCheckCloneSupport(t *testing.T) {
+	t.Run("checks the coordinator locally and the primaries on their hosts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var clones int
+		upgrade.SetCloneCommand(exectest.NewCommandWithVerifier(exectest.Success, func(string, ...string) {
+			clones++
+		}))
+		defer upgrade.ResetCloneCommand()
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().CheckCloneSupport(
+			gomock.Any(),
+			&idl.CheckCloneSupportRequest{Directories: []*idl.CloneDirectories{
+				{Source: "/data/dbfast1/seg0", Target: "/data/dbfast1/seg.AAAAAAAAAAA.0"},
+				{Source: "/tablespace/2/16384", Target: "/tablespace/2/16384"},
+				{Source: "/data/dbfast2/seg1", Target: "/data/dbfast2/seg.AAAAAAAAAAA.1"},
+			}},
+		).Return(&idl.CheckCloneSupportReply{}, nil)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().CheckCloneSupport(
+			gomock.Any(),
+			&idl.CheckCloneSupportRequest{Directories: []*idl.CloneDirectories{
+				{Source: "/data/dbfast3/seg2", Target: "/data/dbfast3/seg.AAAAAAAAAAA.2"},
+			}},
+		).Return(&idl.CheckCloneSupportReply{}, nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		coordinatorDir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, coordinatorDir)
+
+		source := snapshotCluster(t)
+		coordinator := source.Primaries[-1]
+		coordinator.DataDir = coordinatorDir
+		source.Primaries[-1] = coordinator
+		delete(source.Tablespaces, 1)
+
+		intermediate := cloneIntermediateCluster(t)
+		intermediateCoordinator := intermediate.Primaries[-1]
+		intermediateCoordinator.DataDir = coordinatorDir
+		intermediate.Primaries[-1] = intermediateCoordinator
+
+		err := hub.CheckCloneSupport(agentConns, source, intermediate)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		if clones != 1 {
+			t.Errorf("got %d clones want 1", clones)
+		}
+	})
+
+	t.Run("returns agent errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := errors.New("Operation not supported")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().CheckCloneSupport(gomock.Any(), gomock.Any()).Return(nil, expected)
+
+		agentConns := []*idl.Connection{{AgentClient: sdw1, Hostname: "sdw1"}}
+
+		source := snapshotCluster(t).WithContents(0, 1)
+		err := hub.CheckCloneSupport(agentConns, source, cloneIntermediateCluster(t))
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v want %#v", err, expected)
+		}
+	})
+
+	t.Run("refuses targets before 7X whose pg_upgrade does not support cloning", func(t *testing.T) {
+		intermediate := cloneIntermediateCluster(t)
+		intermediate.Version = semver.MustParse("6.25.0")
+
+		err := hub.CheckCloneSupport(nil, snapshotCluster(t), intermediate)
+		if err == nil || !strings.Contains(err.Error(), "version 7 or later") {
+			t.Errorf("got error %v", err)
+		}
+	})
+}
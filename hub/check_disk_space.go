@@ -8,6 +8,7 @@ import (
 	"sort"
 	"sync"
 
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
@@ -17,7 +18,111 @@ import (
 
 var checkDiskUsage = disk.CheckUsage
 
+var diskUsages = disk.Usages
+
 func CheckDiskSpace(streams step.OutStreams, agentConns []*idl.Connection, diskFreeRatio float64, source *greenplum.Cluster, sourceTablespaces greenplum.Tablespaces) error {
+	totalUsage, err := collectDiskUsage(streams, agentConns, diskFreeRatio, source, sourceTablespaces)
+	if err != nil {
+		return err
+	}
+
+	if len(totalUsage) > 0 {
+		return disk.NewSpaceUsageError(totalUsage)
+	}
+
+	return nil
+}
+
+// DiskUsage returns the used, available, and total space of each filesystem
+// holding the data directories and user defined tablespaces of the cluster on
+// every host sorted by host and filesystem.
+func DiskUsage(agentConns []*idl.Connection, cluster *greenplum.Cluster) ([]*idl.GetDiskUsageReply_FilesystemUsage, error) {
+	var wg sync.WaitGroup
+	errs := make(chan error, len(agentConns)+1)
+	usagesChan := make(chan []*idl.GetDiskUsageReply_FilesystemUsage, len(agentConns)+1)
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		coordinatorDirs := []string{cluster.CoordinatorDataDir()}
+		coordinatorDirs = append(coordinatorDirs, cluster.Tablespaces.GetCoordinatorTablespaces().UserDefinedTablespacesLocations()...)
+
+		usages, err := diskUsages(disk.Local, coordinatorDirs...)
+		errs <- err
+		usagesChan <- usages
+	}()
+
+	for _, conn := range agentConns {
+		conn := conn
+
+		segments := cluster.SelectSegments(func(seg *greenplum.SegConfig) bool {
+			return seg.IsOnHost(conn.Hostname) && !seg.IsCoordinator()
+		})
+		if len(segments) == 0 {
+			continue
+		}
+		sort.Sort(segments)
+
+		var dirs []string
+		for _, seg := range segments {
+			dirs = append(dirs, seg.DataDir)
+			dirs = append(dirs, cluster.Tablespaces[int32(seg.DbID)].UserDefinedTablespacesLocations()...)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			reply, err := conn.AgentClient.GetDiskUsage(context.Background(), &idl.GetDiskUsageRequest{Dirs: dirs})
+			errs <- err
+			usagesChan <- reply.GetUsages()
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+	close(usagesChan)
+
+	var err error
+	for e := range errs {
+		err = errorlist.Append(err, e)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	// A host may be reported by both the hub and an agent such as when the
+	// standby is on the coordinator host.
+	var usages []*idl.GetDiskUsageReply_FilesystemUsage
+	seen := make(map[disk.FilesystemHost]bool)
+	for hostUsages := range usagesChan {
+		for _, usage := range hostUsages {
+			key := disk.FilesystemHost{Filesystem: usage.GetFs(), Host: usage.GetHost()}
+			if seen[key] {
+				continue
+			}
+
+			seen[key] = true
+			usages = append(usages, usage)
+		}
+	}
+
+	sort.Slice(usages, func(i, j int) bool {
+		if usages[i].GetHost() == usages[j].GetHost() {
+			return usages[i].GetFs() < usages[j].GetFs()
+		}
+
+		return usages[i].GetHost() < usages[j].GetHost()
+	})
+
+	return usages, nil
+}
+
+// collectDiskUsage returns the filesystems across all hosts that have less
+// than the ratio of free space.
+func collectDiskUsage(streams step.OutStreams, agentConns []*idl.Connection, diskFreeRatio float64, source *greenplum.Cluster, sourceTablespaces greenplum.Tablespaces) (map[disk.FilesystemHost]*idl.CheckDiskSpaceReply_DiskUsage, error) {
 	var wg sync.WaitGroup
 	errs := make(chan error, len(agentConns)+1)
 	usagesChan := make(chan disk.FileSystemDiskUsage, len(agentConns)+1)
@@ -48,22 +153,19 @@ func CheckDiskSpace(streams step.OutStreams, agentConns []*idl.Connection, diskF
 	}
 
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	// combine disk space usage across all hosts and return an usage error
+	// combine disk space usage across all hosts
 	totalUsage := make(map[disk.FilesystemHost]*idl.CheckDiskSpaceReply_DiskUsage)
 	for usages := range usagesChan {
+		recordDiskUsage(usages)
 		for _, usage := range usages {
 			totalUsage[disk.FilesystemHost{Filesystem: usage.GetFs(), Host: usage.GetHost()}] = usage
 		}
 	}
 
-	if len(totalUsage) > 0 {
-		return disk.NewSpaceUsageError(totalUsage)
-	}
-
-	return nil
+	return totalUsage, nil
 }
 
 func checkDiskSpaceOnStandbyAndSegments(agentConns []*idl.Connection, errs chan<- error, usages chan<- disk.FileSystemDiskUsage, diskFreeRatio float64, source *greenplum.Cluster, sourceTablespaces greenplum.Tablespaces) {
@@ -105,3 +207,169 @@ func checkDiskSpaceOnStandbyAndSegments(agentConns []*idl.Connection, errs chan<
 
 	wg.Wait()
 }
+
+// CheckCopyModeDiskSpace checks that each filesystem holding the primaries has
+// at least the space estimated by the stats report to copy them in copy mode.
+// The target data directories and tablespaces are created next to the source
+// ones so the filesystems holding the source directories are checked. Since
+// the estimate is per host it is split evenly across the primaries on the
+// host. The report does not break down the size of a primary by tablespace so
+// each filesystem holding any directory of a primary must have room for the
+// whole estimate of that primary. The estimate is in bytes while the disk
+// usage is in kilobytes.
+func CheckCopyModeDiskSpace(agentConns []*idl.Connection, source *greenplum.Cluster, sourceTablespaces greenplum.Tablespaces, requiredBytesByHost map[string]int64) error {
+	var wg sync.WaitGroup
+	errs := make(chan error, len(agentConns))
+	usages := make(chan disk.FileSystemDiskUsage, len(agentConns))
+
+	for _, conn := range agentConns {
+		conn := conn
+
+		required := requiredBytesByHost[conn.Hostname]
+		if required <= 0 {
+			continue
+		}
+
+		primaries := source.SelectSegments(func(seg *greenplum.SegConfig) bool {
+			return seg.IsOnHost(conn.Hostname) && seg.IsPrimary() && !seg.IsCoordinator()
+		})
+		if len(primaries) == 0 {
+			continue
+		}
+		requiredKB := (uint64(required) + 1023) / 1024
+		requiredPerPrimary := requiredKB / uint64(len(primaries))
+
+		var dirs []string
+		dirsByPrimary := make(map[int][]string)
+		for _, seg := range primaries {
+			segDirs := append([]string{seg.DataDir}, sourceTablespaces[int32(seg.DbID)].UserDefinedTablespacesLocations()...)
+			dirsByPrimary[seg.DbID] = segDirs
+			dirs = append(dirs, segDirs...)
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			reply, err := conn.AgentClient.GetDiskUsage(context.Background(), &idl.GetDiskUsageRequest{Dirs: dirs})
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			fsByDir := make(map[string]*idl.GetDiskUsageReply_FilesystemUsage)
+			for _, usage := range reply.GetUsages() {
+				for _, dir := range usage.GetDirs() {
+					fsByDir[dir] = usage
+				}
+			}
+
+			requiredByFs := make(map[*idl.GetDiskUsageReply_FilesystemUsage]uint64)
+			for _, segDirs := range dirsByPrimary {
+				counted := make(map[*idl.GetDiskUsageReply_FilesystemUsage]bool)
+				for _, dir := range segDirs {
+					usage, ok := fsByDir[dir]
+					if !ok || counted[usage] {
+						continue
+					}
+
+					counted[usage] = true
+					requiredByFs[usage] += requiredPerPrimary
+				}
+			}
+
+			var failures disk.FileSystemDiskUsage
+			for usage, required := range requiredByFs {
+				if usage.GetAvailable() < required {
+					failures = append(failures, &idl.CheckDiskSpaceReply_DiskUsage{
+						Fs:        usage.GetFs(),
+						Host:      usage.GetHost(),
+						Required:  required,
+						Available: usage.GetAvailable(),
+					})
+				}
+			}
+
+			usages <- failures
+		}()
+	}
+
+	wg.Wait()
+	close(errs)
+	close(usages)
+
+	var err error
+	for e := range errs {
+		err = errorlist.Append(err, e)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	totalUsage := make(map[disk.FilesystemHost]*idl.CheckDiskSpaceReply_DiskUsage)
+	for failures := range usages {
+		for _, usage := range failures {
+			totalUsage[disk.FilesystemHost{Filesystem: usage.GetFs(), Host: usage.GetHost()}] = usage
+		}
+	}
+
+	if len(totalUsage) > 0 {
+		return disk.NewSpaceUsageError(totalUsage)
+	}
+
+	return nil
+}
+
+// CheckRsyncSnapshotDiskSpace checks that the filesystem holding the backup
+// directory of each host has room for the full copy of the primary data
+// directories and tablespaces taken by the rsync snapshot provider. Like
+// CheckCopyModeDiskSpace it uses the estimate of the stats report which is in
+// bytes. The coordinator is not checked since it holds no user data.
+func CheckRsyncSnapshotDiskSpace(agentConns []*idl.Connection, backupDirs backupdir.BackupDirs, requiredBytesByHost map[string]int64) error {
+	usages := make(chan *idl.CheckDiskSpaceReply_DiskUsage, len(agentConns))
+
+	request := func(conn *idl.Connection) error {
+		required := requiredBytesByHost[conn.Hostname]
+		if required <= 0 {
+			return nil
+		}
+		requiredKB := (uint64(required) + 1023) / 1024
+
+		backupDir := backupDirs.AgentHostsToBackupDir[conn.Hostname]
+		reply, err := conn.AgentClient.GetDiskUsage(context.Background(), &idl.GetDiskUsageRequest{Dirs: []string{backupDir}})
+		if err != nil {
+			return err
+		}
+
+		for _, usage := range reply.GetUsages() {
+			if usage.GetAvailable() < requiredKB {
+				usages <- &idl.CheckDiskSpaceReply_DiskUsage{
+					Fs:        usage.GetFs(),
+					Host:      usage.GetHost(),
+					Required:  requiredKB,
+					Available: usage.GetAvailable(),
+				}
+			}
+		}
+
+		return nil
+	}
+
+	err := ExecuteRPC(agentConns, request)
+	close(usages)
+	if err != nil {
+		return err
+	}
+
+	totalUsage := make(map[disk.FilesystemHost]*idl.CheckDiskSpaceReply_DiskUsage)
+	for usage := range usages {
+		totalUsage[disk.FilesystemHost{Filesystem: usage.GetFs(), Host: usage.GetHost()}] = usage
+	}
+
+	if len(totalUsage) > 0 {
+		return disk.NewSpaceUsageError(totalUsage)
+	}
+
+	return nil
+}
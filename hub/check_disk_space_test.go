@@ -12,6 +12,7 @@ import (
 
 	"github.com/golang/mock/gomock"
 
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/hub"
 	"github.com/greenplum-db/gpupgrade/idl"
@@ -316,3 +317,282 @@ func (r reqCheckDiskMatcher) Matches(x interface{}) bool {
 func (r reqCheckDiskMatcher) String() string {
 	return fmt.Sprintf("is equivalent to %v", r.expected)
 }
+
+func TestDiskUsage(t *testing.T) {
+	cluster := hub.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "mdw", DataDir: "/data/qddir/seg-1", Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: -1, Hostname: "mdw", DataDir: "/data/standby", Role: greenplum.MirrorRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast/seg1", Role: greenplum.PrimaryRole},
+	})
+
+	t.Run("returns the used, available, and total space of every filesystem sorted by host", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		mdwUsage := &idl.GetDiskUsageReply_FilesystemUsage{Fs: "/data", Host: "mdw", Used: 3072, Available: 1024, Total: 4096, Dirs: []string{"/data/qddir/seg-1"}}
+		hub.SetDiskUsages(func(d disk.Disk, paths ...string) ([]*idl.GetDiskUsageReply_FilesystemUsage, error) {
+			if !reflect.DeepEqual(paths, []string{"/data/qddir/seg-1"}) {
+				t.Errorf("got paths %v want the coordinator data directory", paths)
+			}
+
+			return []*idl.GetDiskUsageReply_FilesystemUsage{mdwUsage}, nil
+		})
+		defer hub.ResetDiskUsages()
+
+		// The standby is on the coordinator host so its filesystem is also
+		// reported by the agent on that host.
+		mdw := mock_idl.NewMockAgentClient(ctrl)
+		mdw.EXPECT().GetDiskUsage(
+			gomock.Any(),
+			&idl.GetDiskUsageRequest{Dirs: []string{"/data/standby"}},
+		).Return(&idl.GetDiskUsageReply{Usages: []*idl.GetDiskUsageReply_FilesystemUsage{
+			{Fs: "/data", Host: "mdw", Used: 3072, Available: 1024, Total: 4096, Dirs: []string{"/data/standby"}},
+		}}, nil)
+
+		sdw1Usage := &idl.GetDiskUsageReply_FilesystemUsage{Fs: "/data", Host: "sdw1", Used: 6144, Available: 2048, Total: 8192, Dirs: []string{"/data/dbfast/seg1"}}
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetDiskUsage(
+			gomock.Any(),
+			&idl.GetDiskUsageRequest{Dirs: []string{"/data/dbfast/seg1"}},
+		).Return(&idl.GetDiskUsageReply{Usages: []*idl.GetDiskUsageReply_FilesystemUsage{sdw1Usage}}, nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: mdw, Hostname: "mdw"},
+		}
+
+		usages, err := hub.DiskUsage(agentConns, cluster)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		if len(usages) != 2 || usages[0].GetHost() != "mdw" || !reflect.DeepEqual(usages[1], sdw1Usage) {
+			t.Errorf("got %v want one usage for mdw followed by %v", usages, sdw1Usage)
+		}
+	})
+
+	t.Run("errors when getting the disk usage fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := errors.New("permission denied")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetDiskUsage(gomock.Any(), gomock.Any()).Return(nil, expected)
+
+		hub.SetDiskUsages(func(d disk.Disk, paths ...string) ([]*idl.GetDiskUsageReply_FilesystemUsage, error) {
+			return nil, nil
+		})
+		defer hub.ResetDiskUsages()
+
+		_, err := hub.DiskUsage([]*idl.Connection{{AgentClient: sdw1, Hostname: "sdw1"}}, cluster)
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v, want %#v", err, expected)
+		}
+	})
+}
+
+func TestCheckCopyModeDiskSpace(t *testing.T) {
+	source := hub.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "mdw", DataDir: "/data/qddir/seg-1", Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast/seg1", Role: greenplum.PrimaryRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw2", DataDir: "/data/dbfast_mirror1/seg1", Role: greenplum.MirrorRole},
+		{DbID: 4, ContentID: 1, Hostname: "sdw2", DataDir: "/data/dbfast/seg2", Role: greenplum.PrimaryRole},
+		{DbID: 5, ContentID: 1, Hostname: "sdw1", DataDir: "/data/dbfast_mirror2/seg2", Role: greenplum.MirrorRole},
+	})
+
+	tablespaces := greenplum.Tablespaces{
+		2: greenplum.SegmentTablespaces{
+			16384: &idl.TablespaceInfo{Location: "/tablespaces/16384/2", UserDefined: true},
+		},
+	}
+
+	t.Run("checks each filesystem holding the primary data directories and tablespaces", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetDiskUsage(
+			gomock.Any(),
+			&idl.GetDiskUsageRequest{Dirs: []string{"/data/dbfast/seg1", "/tablespaces/16384/2"}},
+		).Return(&idl.GetDiskUsageReply{Usages: []*idl.GetDiskUsageReply_FilesystemUsage{
+			{Fs: "/data", Host: "sdw1", Used: 1024, Available: 4096, Total: 5120, Dirs: []string{"/data/dbfast/seg1"}},
+			{Fs: "/tablespaces", Host: "sdw1", Used: 1024, Available: 1024, Total: 2048, Dirs: []string{"/tablespaces/16384/2"}},
+		}}, nil)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().GetDiskUsage(
+			gomock.Any(),
+			&idl.GetDiskUsageRequest{Dirs: []string{"/data/dbfast/seg2"}},
+		).Return(&idl.GetDiskUsageReply{Usages: []*idl.GetDiskUsageReply_FilesystemUsage{
+			{Fs: "/data", Host: "sdw2", Used: 1024, Available: 4096, Total: 5120, Dirs: []string{"/data/dbfast/seg2"}},
+		}}, nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		err := hub.CheckCopyModeDiskSpace(agentConns, source, tablespaces, map[string]int64{"sdw1": 2048 * 1024, "sdw2": 2048 * 1024})
+		var spaceUsageErr *disk.SpaceUsageErr
+		if !errors.As(err, &spaceUsageErr) {
+			t.Fatalf("got error %#v want type %T", err, spaceUsageErr)
+		}
+
+		expected := [][]string{
+			{"Hostname", "Filesystem", "Shortfall", "Available", "Required"},
+			{"sdw1", "/tablespaces", disk.FormatBytes(1024), disk.FormatBytes(1024), disk.FormatBytes(2048)},
+		}
+		if !reflect.DeepEqual(spaceUsageErr.Table(), expected) {
+			t.Errorf("returned %v want %v", spaceUsageErr.Table(), expected)
+		}
+	})
+
+	t.Run("splits the estimate of a host across the primaries on each filesystem", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		source := hub.MustCreateCluster(t, greenplum.SegConfigs{
+			{DbID: 1, ContentID: -1, Hostname: "mdw", DataDir: "/data/qddir/seg-1", Role: greenplum.PrimaryRole},
+			{DbID: 2, ContentID: 0, Hostname: "sdw1", DataDir: "/data1/seg1", Role: greenplum.PrimaryRole},
+			{DbID: 3, ContentID: 1, Hostname: "sdw1", DataDir: "/data2/seg2", Role: greenplum.PrimaryRole},
+		})
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetDiskUsage(gomock.Any(), gomock.Any()).
+			Return(&idl.GetDiskUsageReply{Usages: []*idl.GetDiskUsageReply_FilesystemUsage{
+				{Fs: "/data1", Host: "sdw1", Used: 0, Available: 2048, Total: 2048, Dirs: []string{"/data1/seg1"}},
+				{Fs: "/data2", Host: "sdw1", Used: 0, Available: 2048, Total: 2048, Dirs: []string{"/data2/seg2"}},
+			}}, nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+		}
+
+		// Each filesystem only needs room for the share of the estimate of
+		// the primary it holds rather than the whole estimate of the host.
+		err := hub.CheckCopyModeDiskSpace(agentConns, source, greenplum.Tablespaces{}, map[string]int64{"sdw1": 4096 * 1024})
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+	})
+
+	t.Run("does not check hosts without an estimate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetDiskUsage(gomock.Any(), gomock.Any()).Times(0)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+		}
+
+		err := hub.CheckCopyModeDiskSpace(agentConns, source, tablespaces, nil)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+	})
+
+	t.Run("errors when checking disk usage on a host fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := errors.New("permission denied")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetDiskUsage(gomock.Any(), gomock.Any()).Return(nil, expected)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+		}
+
+		err := hub.CheckCopyModeDiskSpace(agentConns, source, tablespaces, map[string]int64{"sdw1": 2048})
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v, want %#v", err, expected)
+		}
+	})
+}
+
+func TestCheckRsyncSnapshotDiskSpace(t *testing.T) {
+	backupDirs := backupdir.BackupDirs{
+		CoordinatorBackupDir: "/data/.gpupgrade",
+		AgentHostsToBackupDir: backupdir.AgentHostsToBackupDir{
+			"sdw1": "/data/.gpupgrade",
+			"sdw2": "/backup/.gpupgrade",
+		},
+	}
+
+	t.Run("checks the filesystem holding the backup directory of each host", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetDiskUsage(
+			gomock.Any(),
+			&idl.GetDiskUsageRequest{Dirs: []string{"/data/.gpupgrade"}},
+		).Return(&idl.GetDiskUsageReply{Usages: []*idl.GetDiskUsageReply_FilesystemUsage{
+			{Fs: "/data", Host: "sdw1", Used: 1024, Available: 4096, Total: 5120, Dirs: []string{"/data/.gpupgrade"}},
+		}}, nil)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().GetDiskUsage(
+			gomock.Any(),
+			&idl.GetDiskUsageRequest{Dirs: []string{"/backup/.gpupgrade"}},
+		).Return(&idl.GetDiskUsageReply{Usages: []*idl.GetDiskUsageReply_FilesystemUsage{
+			{Fs: "/backup", Host: "sdw2", Used: 1024, Available: 1024, Total: 2048, Dirs: []string{"/backup/.gpupgrade"}},
+		}}, nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		err := hub.CheckRsyncSnapshotDiskSpace(agentConns, backupDirs, map[string]int64{"sdw1": 2048 * 1024, "sdw2": 2048 * 1024})
+		var spaceUsageErr *disk.SpaceUsageErr
+		if !errors.As(err, &spaceUsageErr) {
+			t.Fatalf("got error %#v want type %T", err, spaceUsageErr)
+		}
+
+		expected := [][]string{
+			{"Hostname", "Filesystem", "Shortfall", "Available", "Required"},
+			{"sdw2", "/backup", disk.FormatBytes(1024), disk.FormatBytes(1024), disk.FormatBytes(2048)},
+		}
+		if !reflect.DeepEqual(spaceUsageErr.Table(), expected) {
+			t.Errorf("returned %v want %v", spaceUsageErr.Table(), expected)
+		}
+	})
+
+	t.Run("does not check hosts without an estimate", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetDiskUsage(gomock.Any(), gomock.Any()).Times(0)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+		}
+
+		err := hub.CheckRsyncSnapshotDiskSpace(agentConns, backupDirs, nil)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+	})
+
+	t.Run("errors when checking disk usage on a host fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := errors.New("permission denied")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetDiskUsage(gomock.Any(), gomock.Any()).Return(nil, expected)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+		}
+
+		err := hub.CheckRsyncSnapshotDiskSpace(agentConns, backupDirs, map[string]int64{"sdw1": 2048})
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v, want %#v", err, expected)
+		}
+	})
+}
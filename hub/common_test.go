@@ -6,9 +6,12 @@ package hub
 import (
 	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"testing"
 
+	"google.golang.org/grpc"
+
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/testutils/exectest"
 	"github.com/greenplum-db/gpupgrade/utils/disk"
@@ -20,6 +23,7 @@ func init() {
 	ResetExecCommand()
 	rsync.ResetRsyncCommand()
 	ResetCheckDiskUsage()
+	ResetDiskUsages()
 
 	exectest.RegisterMains(
 		Success,
@@ -76,6 +80,20 @@ func ResetExecCommand() {
 	ExecCommand = nil
 }
 
+func (s *Server) NotifyInterceptor() grpc.StreamServerInterceptor {
+	return s.notifyInterceptor
+}
+
+func (s *Server) MetricsInterceptor() grpc.StreamServerInterceptor {
+	return s.metricsInterceptor
+}
+
+var CountProcessesRequested = countProcessesRequested
+
+func WriteMetrics(w io.Writer) error {
+	return registry.Write(w)
+}
+
 func SetCheckDiskUsage(usageFunc disk.CheckUsageType) {
 	checkDiskUsage = usageFunc
 }
@@ -84,6 +102,14 @@ func ResetCheckDiskUsage() {
 	checkDiskUsage = disk.CheckUsage
 }
 
+func SetDiskUsages(usagesFunc disk.UsagesType) {
+	diskUsages = usagesFunc
+}
+
+func ResetDiskUsages() {
+	diskUsages = disk.Usages
+}
+
 // MustCreateCluster creates a utils.Cluster and calls t.Fatalf() if there is
 // any error.
 func MustCreateCluster(t *testing.T, segments greenplum.SegConfigs) *greenplum.Cluster {
@@ -96,3 +122,7 @@ func MustCreateCluster(t *testing.T, segments greenplum.SegConfigs) *greenplum.C
 
 	return &cluster
 }
+
+func (s *Server) StepInterceptor() grpc.StreamServerInterceptor {
+	return s.stepInterceptor
+}
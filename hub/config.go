@@ -5,12 +5,20 @@ package hub
 
 import (
 	"context"
+	"sort"
 	"strconv"
+	"strings"
 
-	"github.com/greenplum-db/gpupgrade/idl"
-
+	"golang.org/x/xerrors"
+	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/notify"
+	"github.com/greenplum-db/gpupgrade/step"
 )
 
 func (s *Server) GetConfig(ctx context.Context, req *idl.GetConfigRequest) (*idl.GetConfigReply, error) {
@@ -41,3 +49,283 @@ func (s *Server) GetConfig(ctx context.Context, req *idl.GetConfigRequest) (*idl
 
 	return resp, nil
 }
+
+// mutableSetting is a setting that is safe to change between steps. Phases
+// are the most recently started steps during which the setting may be
+// changed. For example, a setting with the phases initialize and execute can
+// be changed after initialize and before finalize has started.
+type mutableSetting struct {
+	phases []idl.Step
+	set    func(s *Server, conf *config.Config, streams step.OutStreams, value string) error
+}
+
+var mutableSettings = map[string]mutableSetting{
+	"pg_upgrade_jobs": {
+		phases: []idl.Step{idl.Step_initialize, idl.Step_execute},
+		set: func(_ *Server, conf *config.Config, _ step.OutStreams, value string) error {
+			jobs, err := config.ParsePgUpgradeJobs(value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid value %q for pg_upgrade_jobs. Please specify a positive integer or %q.", value, config.AutoPgUpgradeJobs)
+			}
+
+			conf.PgUpgradeJobs = jobs
+			return nil
+		},
+	},
+	"master_pg_upgrade_jobs": {
+		phases: []idl.Step{idl.Step_initialize, idl.Step_execute},
+		set: func(_ *Server, conf *config.Config, _ step.OutStreams, value string) error {
+			jobs, err := strconv.ParseUint(value, 10, 0)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid value %q for master_pg_upgrade_jobs. Please specify a non-negative integer.", value)
+			}
+
+			conf.CoordinatorPgUpgradeJobs = uint(jobs)
+			return nil
+		},
+	},
+	"primary_pg_upgrade_jobs": {
+		phases: []idl.Step{idl.Step_initialize, idl.Step_execute},
+		set: func(_ *Server, conf *config.Config, _ step.OutStreams, value string) error {
+			jobs, err := strconv.ParseUint(value, 10, 0)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid value %q for primary_pg_upgrade_jobs. Please specify a non-negative integer.", value)
+			}
+
+			conf.PrimaryPgUpgradeJobs = uint(jobs)
+			return nil
+		},
+	},
+	"rsync_jobs": {
+		phases: []idl.Step{idl.Step_initialize, idl.Step_execute, idl.Step_finalize},
+		set: func(_ *Server, conf *config.Config, _ step.OutStreams, value string) error {
+			jobs, err := strconv.ParseUint(value, 10, 0)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid value %q for rsync_jobs. Please specify a non-negative integer.", value)
+			}
+
+			conf.RsyncJobs = uint(jobs)
+			return nil
+		},
+	},
+	"copy_fan_out": {
+		phases: []idl.Step{idl.Step_initialize, idl.Step_execute},
+		set: func(_ *Server, conf *config.Config, _ step.OutStreams, value string) error {
+			fanOut, err := strconv.ParseUint(value, 10, 0)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid value %q for copy_fan_out. Please specify a non-negative integer.", value)
+			}
+
+			if fanOut > 0 && conf.CopyDepth == 0 {
+				conf.CopyDepth = config.DefaultCopyDepth
+			}
+
+			conf.CopyFanOut = uint(fanOut)
+			return nil
+		},
+	},
+	"copy_depth": {
+		phases: []idl.Step{idl.Step_initialize, idl.Step_execute},
+		set: func(_ *Server, conf *config.Config, _ step.OutStreams, value string) error {
+			depth, err := strconv.ParseUint(value, 10, 0)
+			if err != nil || depth == 0 {
+				return status.Errorf(codes.InvalidArgument, "invalid value %q for copy_depth. Please specify a positive integer.", value)
+			}
+
+			conf.CopyDepth = uint(depth)
+			return nil
+		},
+	},
+	"use_hba_hostnames": {
+		phases: []idl.Step{idl.Step_initialize, idl.Step_execute},
+		set: func(_ *Server, conf *config.Config, _ step.OutStreams, value string) error {
+			useHbaHostnames, err := strconv.ParseBool(value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid value %q for use_hba_hostnames. Please specify either true or false.", value)
+			}
+
+			conf.UseHbaHostnames = useHbaHostnames
+			return nil
+		},
+	},
+	"webhook_urls": {
+		phases: []idl.Step{idl.Step_initialize, idl.Step_execute, idl.Step_finalize, idl.Step_revert},
+		set: func(_ *Server, conf *config.Config, _ step.OutStreams, value string) error {
+			urls, err := notify.ParseURLs(value)
+			if err != nil {
+				return status.Errorf(codes.InvalidArgument, "invalid value %q for webhook_urls: %v", value, err)
+			}
+
+			conf.WebhookURLs = urls
+			return nil
+		},
+	},
+	"parent_backup_dirs": {
+		phases: []idl.Step{idl.Step_initialize, idl.Step_execute},
+		set: func(s *Server, conf *config.Config, streams step.OutStreams, value string) error {
+			// The backup directories are in use once the coordinator has
+			// been copied into them.
+			copied, err := step.HasCompleted(idl.Step_execute, idl.Substep_copy_master)
+			if err != nil {
+				return err
+			}
+
+			if copied {
+				return status.Errorf(codes.FailedPrecondition, "parent_backup_dirs cannot be changed since the coordinator has already been copied to the backup directories.")
+			}
+
+			_, err = s.RestartAgents(context.Background(), nil)
+			if err != nil {
+				return err
+			}
+
+			return s.setParentBackupDirs(conf, streams, value)
+		},
+	},
+}
+
+// SetConfig changes a mutable setting between steps performing any needed side
+// effects and persisting the configuration. The settings lock is held
+// throughout such that a step cannot start until the change is complete.
+func (s *Server) SetConfig(ctx context.Context, req *idl.SetConfigRequest) (*idl.SetConfigReply, error) {
+	setting, ok := mutableSettings[req.GetName()]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%q is not a configuration setting that can be changed. Supported settings: %s.", req.GetName(), strings.Join(mutableSettingNames(), ", "))
+	}
+
+	s.settingsMutex.Lock()
+	defer s.settingsMutex.Unlock()
+
+	if s.activeSteps > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s cannot be changed while a step is in progress.", req.GetName())
+	}
+
+	phase, err := currentPhase()
+	if err != nil {
+		return nil, err
+	}
+
+	if !containsStep(setting.phases, phase) {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s cannot be changed during %s. It can only be changed after %s.", req.GetName(), phase, formatSteps(setting.phases))
+	}
+
+	inProgress, err := step.IsRunning(phase)
+	if err != nil {
+		return nil, err
+	}
+
+	if inProgress {
+		return nil, status.Errorf(codes.FailedPrecondition, "%s cannot be changed while %s is in progress.", req.GetName(), phase)
+	}
+
+	err = s.updateConfig(func(conf *config.Config) error {
+		return setting.set(s, conf, step.DevNullStream, req.GetValue())
+	}, req.GetName())
+	if err != nil {
+		return nil, err
+	}
+
+	return &idl.SetConfigReply{}, nil
+}
+
+// updateConfig changes a copy of the configuration and only uses it once it
+// has been written such that a failure leaves both the hub and config.json
+// unchanged.
+func (s *Server) updateConfig(change func(conf *config.Config) error, name string) error {
+	updated := *s.Config
+	err := change(&updated)
+	if err != nil {
+		return err
+	}
+
+	err = updated.Write()
+	if err != nil {
+		return xerrors.Errorf("save %s: %w", name, err)
+	}
+
+	*s.Config = updated
+	return nil
+}
+
+// setParentBackupDirs moves the backup directories on all hosts keeping their
+// contents such as snapshots of the source cluster.
+func (s *Server) setParentBackupDirs(conf *config.Config, streams step.OutStreams, parentBackupDirs string) error {
+	backupDirs, err := backupdir.ParseParentBackupDirs(parentBackupDirs, *conf.Source)
+	if err != nil {
+		return err
+	}
+
+	err = MoveBackupDirectories(streams, s.agentConns, conf.BackupDirs, backupDirs)
+	if err != nil {
+		return err
+	}
+
+	conf.BackupDirs = backupDirs
+	return nil
+}
+
+// stepInterceptor counts the step streams in progress such that SetConfig
+// rejects changes while a step is using the settings. A step waits for any
+// change in progress to complete before starting.
+func (s *Server) stepInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if _, ok := stepMethods[info.FullMethod]; !ok {
+		return handler(srv, stream)
+	}
+
+	s.settingsMutex.Lock()
+	s.activeSteps++
+	s.settingsMutex.Unlock()
+
+	defer func() {
+		s.settingsMutex.Lock()
+		s.activeSteps--
+		s.settingsMutex.Unlock()
+	}()
+
+	return handler(srv, stream)
+}
+
+// currentPhase returns the most recently started step.
+func currentPhase() (idl.Step, error) {
+	for _, st := range []idl.Step{idl.Step_revert, idl.Step_finalize, idl.Step_execute, idl.Step_initialize} {
+		started, err := step.HasStarted(st)
+		if err != nil {
+			return idl.Step_unknown_step, err
+		}
+
+		if started {
+			return st, nil
+		}
+	}
+
+	return idl.Step_unknown_step, nil
+}
+
+func mutableSettingNames() []string {
+	var names []string
+	for name := range mutableSettings {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+	return names
+}
+
+func containsStep(steps []idl.Step, s idl.Step) bool {
+	for _, st := range steps {
+		if st == s {
+			return true
+		}
+	}
+
+	return false
+}
+
+func formatSteps(steps []idl.Step) string {
+	var names []string
+	for _, st := range steps {
+		names = append(names, st.String())
+	}
+
+	return strings.Join(names, " or ")
+}
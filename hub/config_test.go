@@ -0,0 +1,248 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub_test
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/hub"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils"
+)
+
+func TestSetConfig(t *testing.T) {
+	stateDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, stateDir)
+
+	resetEnv := testutils.SetEnv(t, "GPUPGRADE_HOME", stateDir)
+	defer resetEnv()
+
+	substeps := filepath.Join(stateDir, step.SubstepsFileName)
+
+	t.Run("changes and persists a setting between steps", func(t *testing.T) {
+		testutils.MustWriteToFile(t, substeps, `{"initialize": {"saving_source_cluster_config": "complete"}}`)
+
+		conf := &config.Config{PgUpgradeJobs: 4}
+		server := hub.New(conf)
+
+		_, err := server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "pg_upgrade_jobs", Value: "8"})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		_, err = server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "primary_pg_upgrade_jobs", Value: "2"})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		_, err = server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "use_hba_hostnames", Value: "true"})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		persisted, err := config.Read()
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if persisted.PgUpgradeJobs != 8 || persisted.PrimaryPgUpgradeJobs != 2 || !persisted.UseHbaHostnames {
+			t.Errorf("got PgUpgradeJobs %d PrimaryPgUpgradeJobs %d UseHbaHostnames %t want 8, 2, and true", persisted.PgUpgradeJobs, persisted.PrimaryPgUpgradeJobs, persisted.UseHbaHostnames)
+		}
+
+		_, err = server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "pg_upgrade_jobs", Value: "auto"})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if server.PgUpgradeJobs != 0 {
+			t.Errorf("got PgUpgradeJobs %d want 0 for auto", server.PgUpgradeJobs)
+		}
+	})
+
+	t.Run("leaves the hub configuration unchanged when saving fails", func(t *testing.T) {
+		testutils.MustWriteToFile(t, substeps, `{"initialize": {"saving_source_cluster_config": "complete"}}`)
+
+		// Saving fails since config.json cannot be replaced by a file.
+		configFile := filepath.Join(stateDir, config.ConfigFileName)
+		testutils.MustRemoveAll(t, configFile)
+		testutils.MustCreateDir(t, filepath.Join(configFile, "dir"))
+		defer testutils.MustRemoveAll(t, configFile)
+
+		server := hub.New(&config.Config{PgUpgradeJobs: 4})
+
+		_, err := server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "pg_upgrade_jobs", Value: "8"})
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+
+		if server.PgUpgradeJobs != 4 {
+			t.Errorf("got PgUpgradeJobs %d want it to be unchanged", server.PgUpgradeJobs)
+		}
+	})
+
+	t.Run("errors while a step stream is in progress", func(t *testing.T) {
+		testutils.MustWriteToFile(t, substeps, `{"initialize": {"saving_source_cluster_config": "complete"}}`)
+
+		server := hub.New(&config.Config{PgUpgradeJobs: 4})
+
+		info := &grpc.StreamServerInfo{FullMethod: idl.CliToHub_Execute_FullMethodName, IsServerStream: true}
+		err := server.StepInterceptor()(nil, nil, info, func(srv interface{}, stream grpc.ServerStream) error {
+			_, err := server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "pg_upgrade_jobs", Value: "8"})
+			if status.Code(err) != codes.FailedPrecondition {
+				t.Errorf("got error %#v want code %s", err, codes.FailedPrecondition)
+			}
+
+			return nil
+		})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if server.PgUpgradeJobs != 4 {
+			t.Errorf("got PgUpgradeJobs %d want it to be unchanged", server.PgUpgradeJobs)
+		}
+
+		_, err = server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "pg_upgrade_jobs", Value: "8"})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+	})
+
+	t.Run("changes rsync_jobs after a failed finalize", func(t *testing.T) {
+		testutils.MustWriteToFile(t, substeps, `{"initialize": {"saving_source_cluster_config": "complete"}, "finalize": {"upgrade_mirrors": "failed"}}`)
+
+		server := hub.New(&config.Config{RsyncJobs: 4})
+
+		_, err := server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "rsync_jobs", Value: "2"})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		persisted, err := config.Read()
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if persisted.RsyncJobs != 2 {
+			t.Errorf("got RsyncJobs %d want 2", persisted.RsyncJobs)
+		}
+	})
+
+	t.Run("changes and clears webhook_urls after a failed finalize", func(t *testing.T) {
+		testutils.MustWriteToFile(t, substeps, `{"initialize": {"saving_source_cluster_config": "complete"}, "finalize": {"upgrade_mirrors": "failed"}}`)
+
+		server := hub.New(&config.Config{})
+
+		_, err := server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "webhook_urls", Value: "https://alerts.example.com/a,https://alerts.example.com/b"})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		persisted, err := config.Read()
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(persisted.WebhookURLs) != 2 || persisted.WebhookURLs[1] != "https://alerts.example.com/b" {
+			t.Errorf("got WebhookURLs %v", persisted.WebhookURLs)
+		}
+
+		_, err = server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "webhook_urls", Value: ""})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(server.WebhookURLs) != 0 {
+			t.Errorf("got WebhookURLs %v want none", server.WebhookURLs)
+		}
+	})
+
+	t.Run("defaults copy_depth when enabling copy_fan_out", func(t *testing.T) {
+		testutils.MustWriteToFile(t, substeps, `{"initialize": {"saving_source_cluster_config": "complete"}}`)
+
+		server := hub.New(&config.Config{})
+
+		_, err := server.SetConfig(context.Background(), &idl.SetConfigRequest{Name: "copy_fan_out", Value: "4"})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		persisted, err := config.Read()
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if persisted.CopyFanOut != 4 || persisted.CopyDepth != config.DefaultCopyDepth {
+			t.Errorf("got CopyFanOut %d CopyDepth %d want 4 and %d", persisted.CopyFanOut, persisted.CopyDepth, config.DefaultCopyDepth)
+		}
+	})
+
+	errorCases := []struct {
+		name     string
+		substeps string
+		request  *idl.SetConfigRequest
+		code     codes.Code
+	}{
+		{
+			name:     "errors on an unknown setting",
+			substeps: `{"initialize": {"saving_source_cluster_config": "complete"}}`,
+			request:  &idl.SetConfigRequest{Name: "mode", Value: "link"},
+			code:     codes.NotFound,
+		},
+		{
+			name:     "errors on an invalid value",
+			substeps: `{"initialize": {"saving_source_cluster_config": "complete"}}`,
+			request:  &idl.SetConfigRequest{Name: "pg_upgrade_jobs", Value: "0"},
+			code:     codes.InvalidArgument,
+		},
+		{
+			name:     "errors on an invalid webhook URL",
+			substeps: `{"initialize": {"saving_source_cluster_config": "complete"}}`,
+			request:  &idl.SetConfigRequest{Name: "webhook_urls", Value: "alerts.example.com"},
+			code:     codes.InvalidArgument,
+		},
+		{
+			name:     "errors when the setting cannot be changed in the current phase",
+			substeps: `{"initialize": {"saving_source_cluster_config": "complete"}, "finalize": {"upgrade_mirrors": "failed"}}`,
+			request:  &idl.SetConfigRequest{Name: "pg_upgrade_jobs", Value: "8"},
+			code:     codes.FailedPrecondition,
+		},
+		{
+			name:     "errors when a step is in progress",
+			substeps: `{"initialize": {"saving_source_cluster_config": "complete"}, "execute": {"upgrade_master": "running"}}`,
+			request:  &idl.SetConfigRequest{Name: "pg_upgrade_jobs", Value: "8"},
+			code:     codes.FailedPrecondition,
+		},
+		{
+			name:     "errors changing the backup directories once the coordinator has been copied",
+			substeps: `{"initialize": {"saving_source_cluster_config": "complete"}, "execute": {"copy_master": "complete", "upgrade_primaries": "failed"}}`,
+			request:  &idl.SetConfigRequest{Name: "parent_backup_dirs", Value: "/data"},
+			code:     codes.FailedPrecondition,
+		},
+	}
+
+	for _, c := range errorCases {
+		t.Run(c.name, func(t *testing.T) {
+			testutils.MustWriteToFile(t, substeps, c.substeps)
+
+			server := hub.New(&config.Config{PgUpgradeJobs: 4})
+			_, err := server.SetConfig(context.Background(), c.request)
+			if status.Code(err) != c.code {
+				t.Errorf("got error %#v want code %s", err, c.code)
+			}
+
+			if server.PgUpgradeJobs != 4 {
+				t.Errorf("got PgUpgradeJobs %d want it to be unchanged", server.PgUpgradeJobs)
+			}
+		})
+	}
+}
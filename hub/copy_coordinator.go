@@ -5,6 +5,8 @@ package hub
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"io"
 	"os"
 	"path/filepath"
@@ -15,7 +17,9 @@ import (
 
 	"github.com/greenplum-db/gpupgrade/config/backupdir"
 	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/upgrade"
 	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
 	"github.com/greenplum-db/gpupgrade/utils/rsync"
@@ -100,13 +104,7 @@ func CopyCoordinatorTablespaces(streams step.OutStreams, sourceVersion semver.Ve
 		return nil
 	}
 
-	var sourcePaths []string
-	if sourceVersion.Major == 5 {
-		// 5X always needs to include the --old-tablespaces-file
-		sourcePaths = append(sourcePaths, utils.GetStateDirOldTablespacesFile())
-	}
-
-	sourcePaths = append(sourcePaths, tablespaces.GetCoordinatorTablespaces().UserDefinedTablespacesLocations()...)
+	sourcePaths := coordinatorTablespacePaths(sourceVersion, tablespaces)
 
 	destinationHostToBackupDir := make(backupdir.AgentHostsToBackupDir)
 	for host, backupDir := range agentHostsToBackupDir {
@@ -117,3 +115,106 @@ func CopyCoordinatorTablespaces(streams step.OutStreams, sourceVersion semver.Ve
 
 	return Copy(streams, sourcePaths, destinationHostToBackupDir)
 }
+
+// coordinatorTablespacePaths returns the paths copied into the tablespace
+// backup directory of each host.
+func coordinatorTablespacePaths(sourceVersion semver.Version, tablespaces greenplum.Tablespaces) []string {
+	var sourcePaths []string
+	if sourceVersion.Major == 5 {
+		// 5X always needs to include the --old-tablespaces-file
+		sourcePaths = append(sourcePaths, utils.GetStateDirOldTablespacesFile())
+	}
+
+	return append(sourcePaths, tablespaces.GetCoordinatorTablespaces().UserDefinedTablespacesLocations()...)
+}
+
+// VerifyCoordinatorBackup creates a manifest of the upgraded coordinator data
+// directory and tablespaces copied to the backup directory of each host, and
+// has each agent verify its copy against it. A corrupted copy would otherwise
+// be restored into every primary on the host. Mismatches are reported per
+// host.
+func VerifyCoordinatorBackup(agentConns []*idl.Connection, coordinatorDataDir string, sourceVersion semver.Version, tablespaces greenplum.Tablespaces, agentHostsToBackupDir backupdir.AgentHostsToBackupDir) error {
+	dataDirectory, err := upgrade.CreateManifest(coordinatorDataDir, "")
+	if err != nil {
+		return err
+	}
+
+	var tablespaceManifest []*idl.ManifestEntry
+	for _, path := range coordinatorTablespacePaths(sourceVersion, tablespaces) {
+		// The paths are copied without a trailing slash, so each is copied
+		// into the tablespace backup directory by its base name.
+		entries, err := upgrade.CreateManifest(path, filepath.Base(path))
+		if err != nil {
+			return err
+		}
+
+		tablespaceManifest = append(tablespaceManifest, entries...)
+	}
+
+	request := func(conn *idl.Connection) error {
+		backupDir, ok := agentHostsToBackupDir[conn.Hostname]
+		if !ok {
+			return nil
+		}
+
+		err := sendManifest(conn, backupDir, dataDirectory, tablespaceManifest)
+		if err != nil {
+			return xerrors.Errorf("verify backup of upgraded master on host %s: %w", conn.Hostname, err)
+		}
+
+		return nil
+	}
+
+	return ExecuteRPC(agentConns, request)
+}
+
+// sendManifest streams the manifest to the agent in batches such that each
+// request stays well within the maximum gRPC message size.
+func sendManifest(conn *idl.Connection, backupDir string, dataDirectory []*idl.ManifestEntry, tablespaces []*idl.ManifestEntry) error {
+	stream, err := conn.AgentClient.VerifyCoordinatorBackup(context.Background())
+	if err != nil {
+		return err
+	}
+
+	requests := []*idl.VerifyCoordinatorBackupRequest{{BackupDir: backupDir}}
+	for _, batch := range manifestBatches(dataDirectory) {
+		requests = append(requests, &idl.VerifyCoordinatorBackupRequest{DataDirectory: batch})
+	}
+
+	for _, batch := range manifestBatches(tablespaces) {
+		requests = append(requests, &idl.VerifyCoordinatorBackupRequest{Tablespaces: batch})
+	}
+
+	for _, req := range requests {
+		err = stream.Send(req)
+		if errors.Is(err, io.EOF) {
+			// The agent ended the stream. Its error is returned below.
+			break
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+// manifestBatchSize is the number of manifest entries sent per request. Each
+// entry is a few hundred bytes at most.
+const manifestBatchSize = 1000
+
+func manifestBatches(entries []*idl.ManifestEntry) [][]*idl.ManifestEntry {
+	var batches [][]*idl.ManifestEntry
+	for len(entries) > manifestBatchSize {
+		batches = append(batches, entries[:manifestBatchSize])
+		entries = entries[manifestBatchSize:]
+	}
+
+	if len(entries) > 0 {
+		batches = append(batches, entries)
+	}
+
+	return batches
+}
@@ -8,17 +8,21 @@ import (
 	"fmt"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 	"testing"
 
 	"github.com/blang/semver/v4"
+	"github.com/golang/mock/gomock"
 
 	"github.com/greenplum-db/gpupgrade/config/backupdir"
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/hub"
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/idl/mock_idl"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/testutils"
 	"github.com/greenplum-db/gpupgrade/testutils/exectest"
@@ -437,3 +441,145 @@ func verifyArgs(t *testing.T, actualArgsChan chan []string, expectedArgs Args) {
 		t.Errorf("want %v", expectedArgs)
 	}
 }
+
+func TestVerifyCoordinatorBackup(t *testing.T) {
+	coordinatorDataDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, coordinatorDataDir)
+
+	testutils.MustWriteToFile(t, filepath.Join(coordinatorDataDir, "PG_VERSION"), "9.4")
+
+	tablespaceDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, tablespaceDir)
+
+	testutils.MustWriteToFile(t, filepath.Join(tablespaceDir, "PG_VERSION"), "9.4")
+
+	tablespaces := greenplum.Tablespaces{
+		1: {16384: &idl.TablespaceInfo{Location: tablespaceDir, UserDefined: true}},
+	}
+
+	agentHostsToBackupDir := backupdir.AgentHostsToBackupDir{"sdw1": "/data1/.gpupgrade", "sdw2": "/data2/.gpupgrade"}
+
+	// verifyStream returns a stream recording the requests sent to it.
+	verifyStream := func(ctrl *gomock.Controller, requests *[]*idl.VerifyCoordinatorBackupRequest, closeErr error) *mock_idl.MockAgent_VerifyCoordinatorBackupClient {
+		stream := mock_idl.NewMockAgent_VerifyCoordinatorBackupClient(ctrl)
+		stream.EXPECT().Send(gomock.Any()).DoAndReturn(func(req *idl.VerifyCoordinatorBackupRequest) error {
+			*requests = append(*requests, req)
+			return nil
+		}).AnyTimes()
+		stream.EXPECT().CloseAndRecv().Return(&idl.VerifyCoordinatorBackupReply{}, closeErr)
+		return stream
+	}
+
+	t.Run("sends the manifest to each host with a backup directory", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		verifyRequests := func(backupDir string, requests []*idl.VerifyCoordinatorBackupRequest) {
+			if len(requests) != 3 {
+				t.Fatalf("got %d requests want 3", len(requests))
+			}
+
+			if requests[0].GetBackupDir() != backupDir {
+				t.Errorf("got backup directory %q want %q", requests[0].GetBackupDir(), backupDir)
+			}
+
+			dataDirectory := requests[1].GetDataDirectory()
+			if len(dataDirectory) != 1 || dataDirectory[0].GetPath() != "PG_VERSION" {
+				t.Errorf("got data directory manifest %v", dataDirectory)
+			}
+
+			// tablespaces are copied into the backup directory by their base name
+			expected := filepath.Join(filepath.Base(tablespaceDir), "PG_VERSION")
+			tablespaceManifest := requests[2].GetTablespaces()
+			if len(tablespaceManifest) != 1 || tablespaceManifest[0].GetPath() != expected {
+				t.Errorf("got tablespace manifest %v want path %q", tablespaceManifest, expected)
+			}
+		}
+
+		var sdw1Requests, sdw2Requests []*idl.VerifyCoordinatorBackupRequest
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().VerifyCoordinatorBackup(gomock.Any()).Return(verifyStream(ctrl, &sdw1Requests, nil), nil)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().VerifyCoordinatorBackup(gomock.Any()).Return(verifyStream(ctrl, &sdw2Requests, nil), nil)
+
+		standby := mock_idl.NewMockAgentClient(ctrl)
+		standby.EXPECT().VerifyCoordinatorBackup(gomock.Any()).Times(0)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+			{AgentClient: standby, Hostname: "standby"},
+		}
+
+		err := hub.VerifyCoordinatorBackup(agentConns, coordinatorDataDir, semver.MustParse("6.0.0"), tablespaces, agentHostsToBackupDir)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		verifyRequests("/data1/.gpupgrade", sdw1Requests)
+		verifyRequests("/data2/.gpupgrade", sdw2Requests)
+	})
+
+	t.Run("sends large manifests in batches", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		largeDataDir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, largeDataDir)
+
+		for i := 0; i < 1001; i++ {
+			testutils.MustWriteToFile(t, filepath.Join(largeDataDir, strconv.Itoa(i)), "")
+		}
+
+		var requests []*idl.VerifyCoordinatorBackupRequest
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().VerifyCoordinatorBackup(gomock.Any()).Return(verifyStream(ctrl, &requests, nil), nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+		}
+
+		err := hub.VerifyCoordinatorBackup(agentConns, largeDataDir, semver.MustParse("6.0.0"), greenplum.Tablespaces{}, agentHostsToBackupDir)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		var sizes []int
+		for _, req := range requests {
+			sizes = append(sizes, len(req.GetDataDirectory()))
+		}
+
+		expected := []int{0, 1000, 1}
+		if !reflect.DeepEqual(sizes, expected) {
+			t.Errorf("got batch sizes %v want %v", sizes, expected)
+		}
+	})
+
+	t.Run("reports mismatches per host", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := errors.New("1 files do not match the manifest")
+		var sdw1Requests, sdw2Requests []*idl.VerifyCoordinatorBackupRequest
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().VerifyCoordinatorBackup(gomock.Any()).Return(verifyStream(ctrl, &sdw1Requests, expected), nil)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().VerifyCoordinatorBackup(gomock.Any()).Return(verifyStream(ctrl, &sdw2Requests, nil), nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		err := hub.VerifyCoordinatorBackup(agentConns, coordinatorDataDir, semver.MustParse("6.0.0"), tablespaces, agentHostsToBackupDir)
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v want %#v", err, expected)
+		}
+
+		if err == nil || !strings.Contains(err.Error(), "host sdw1") {
+			t.Errorf("expected error %v to contain the host", err)
+		}
+	})
+}
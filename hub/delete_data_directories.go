@@ -78,16 +78,7 @@ func DeleteTargetTablespaces(streams step.OutStreams, agentConns []*idl.Connecti
 }
 
 func DeleteTargetTablespacesOnCoordinator(streams step.OutStreams, target *greenplum.Cluster, coordinatorTablespaces greenplum.SegmentTablespaces, catalogVersion string) error {
-	var dirs []string
-	for _, tsInfo := range coordinatorTablespaces {
-		if !tsInfo.GetUserDefined() {
-			continue
-		}
-
-		path := upgrade.TablespacePath(tsInfo.GetLocation(), int32(target.Coordinator().DbID), target.Version.Major, catalogVersion)
-		dirs = append(dirs, path)
-	}
-
+	dirs := targetTablespaceDirs(target, target.Coordinator(), coordinatorTablespaces, catalogVersion)
 	return upgrade.DeleteTablespaceDirectories(streams, dirs)
 }
 
@@ -107,15 +98,7 @@ func DeleteTargetTablespacesOnPrimaries(agentConns []*idl.Connection, target *gr
 
 		var dirs []string
 		for _, seg := range primaries {
-			segTablespaces := tablespaces[int32(seg.DbID)]
-			for _, tsInfo := range segTablespaces {
-				if !tsInfo.GetUserDefined() {
-					continue
-				}
-
-				path := upgrade.TablespacePath(tsInfo.GetLocation(), int32(seg.DbID), target.Version.Major, catalogVersion)
-				dirs = append(dirs, path)
-			}
+			dirs = append(dirs, targetTablespaceDirs(target, seg, tablespaces[int32(seg.DbID)], catalogVersion)...)
 		}
 
 		req := &idl.DeleteTablespaceRequest{Dirs: dirs}
@@ -125,3 +108,19 @@ func DeleteTargetTablespacesOnPrimaries(agentConns []*idl.Connection, target *gr
 
 	return ExecuteRPC(agentConns, request)
 }
+
+// targetTablespaceDirs returns the upgraded user defined tablespace
+// directories of the segment.
+func targetTablespaceDirs(target *greenplum.Cluster, seg greenplum.SegConfig, segTablespaces greenplum.SegmentTablespaces, catalogVersion string) []string {
+	var dirs []string
+	for _, tsInfo := range segTablespaces {
+		if !tsInfo.GetUserDefined() {
+			continue
+		}
+
+		path := upgrade.TablespacePath(tsInfo.GetLocation(), int32(seg.DbID), target.Version.Major, catalogVersion)
+		dirs = append(dirs, path)
+	}
+
+	return dirs
+}
@@ -0,0 +1,150 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+	"sort"
+
+	"github.com/blang/semver/v4"
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+// Relay copies the coordinator backup from a host that already has it to
+// another host. An empty source is the hub.
+type Relay struct {
+	Source      string
+	Destination string
+}
+
+// DistributionTree plans copying the coordinator backup to the hosts in at
+// most depth rounds. In each round every host that has the backup, including
+// the hub, copies it to up to fanOut hosts that do not. In the last round the
+// remaining hosts are spread evenly over the segment hosts that have the
+// backup, such that the hub is not the bottleneck. The hub only copies to the
+// remaining hosts when no segment host has the backup.
+func DistributionTree(hosts []string, fanOut int, depth int) [][]Relay {
+	remaining := make([]string, len(hosts))
+	copy(remaining, hosts)
+	sort.Strings(remaining)
+
+	holders := []string{""}
+	var rounds [][]Relay
+	for round := 1; round <= depth && len(remaining) > 0; round++ {
+		var relays []Relay
+		if round == depth {
+			senders := holders
+			if len(holders) > 1 {
+				senders = holders[1:]
+			}
+
+			for i, host := range remaining {
+				relays = append(relays, Relay{Source: senders[i%len(senders)], Destination: host})
+			}
+			remaining = nil
+		} else {
+			for _, holder := range holders {
+				for i := 0; i < fanOut && len(remaining) > 0; i++ {
+					relays = append(relays, Relay{Source: holder, Destination: remaining[0]})
+					remaining = remaining[1:]
+				}
+			}
+		}
+
+		for _, relay := range relays {
+			holders = append(holders, relay.Destination)
+		}
+
+		rounds = append(rounds, relays)
+	}
+
+	return rounds
+}
+
+// DistributeCoordinatorBackup copies the upgraded coordinator data directory
+// and tablespaces to the backup directory of each host following the
+// DistributionTree. The hub copies to the first hosts, which then relay the
+// backup to their peers. This avoids the coordinator host's network and disk
+// becoming the bottleneck when copying to many hosts.
+func DistributeCoordinatorBackup(streams step.OutStreams, agentConns []*idl.Connection, coordinatorDataDir string, sourceVersion semver.Version, tablespaces greenplum.Tablespaces, agentHostsToBackupDir backupdir.AgentHostsToBackupDir, fanOut uint, depth uint) error {
+	conns := make(map[string]bool)
+	for _, conn := range agentConns {
+		conns[conn.Hostname] = true
+	}
+
+	var hosts []string
+	for host := range agentHostsToBackupDir {
+		if !conns[host] {
+			return xerrors.Errorf("no agent connection to host %s to relay the coordinator backup", host)
+		}
+
+		hosts = append(hosts, host)
+	}
+
+	for i, round := range DistributionTree(hosts, int(fanOut), int(depth)) {
+		fromHub := make(backupdir.AgentHostsToBackupDir)
+		fromAgents := make(map[string][]*idl.RelayCoordinatorBackupRequest_Target)
+		for _, relay := range round {
+			if relay.Source == "" {
+				fromHub[relay.Destination] = agentHostsToBackupDir[relay.Destination]
+				continue
+			}
+
+			fromAgents[relay.Source] = append(fromAgents[relay.Source], &idl.RelayCoordinatorBackupRequest_Target{
+				Host:      relay.Destination,
+				BackupDir: agentHostsToBackupDir[relay.Destination],
+			})
+		}
+
+		errs := make(chan error, 1)
+		go func() {
+			if len(fromHub) == 0 {
+				errs <- nil
+				return
+			}
+
+			err := CopyCoordinatorDataDir(streams, coordinatorDataDir, fromHub)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			errs <- CopyCoordinatorTablespaces(streams, sourceVersion, tablespaces, fromHub)
+		}()
+
+		request := func(conn *idl.Connection) error {
+			targets := fromAgents[conn.Hostname]
+			if len(targets) == 0 {
+				return nil
+			}
+
+			req := &idl.RelayCoordinatorBackupRequest{
+				BackupDir: agentHostsToBackupDir[conn.Hostname],
+				Targets:   targets,
+			}
+
+			_, err := conn.AgentClient.RelayCoordinatorBackup(context.Background(), req)
+			if err != nil {
+				return xerrors.Errorf("relay coordinator backup from host %s: %w", conn.Hostname, err)
+			}
+
+			return nil
+		}
+
+		var err error
+		err = errorlist.Append(err, ExecuteRPC(agentConns, request))
+		err = errorlist.Append(err, <-errs)
+		if err != nil {
+			return xerrors.Errorf("copy coordinator backup in round %d: %w", i+1, err)
+		}
+	}
+
+	return nil
+}
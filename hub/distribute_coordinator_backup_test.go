@@ -0,0 +1,188 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub_test
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
+
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/hub"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/idl/mock_idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/utils/rsync"
+)
+
+func TestDistributionTree(t *testing.T) {
+	hosts := []string{"sdw6", "sdw5", "sdw4", "sdw3", "sdw2", "sdw1"}
+
+	cases := []struct {
+		name     string
+		fanOut   int
+		depth    int
+		expected [][]hub.Relay
+	}{
+		{
+			name:   "copies from the hub to every host with a depth of one",
+			fanOut: 2,
+			depth:  1,
+			expected: [][]hub.Relay{{
+				{"", "sdw1"}, {"", "sdw2"}, {"", "sdw3"}, {"", "sdw4"}, {"", "sdw5"}, {"", "sdw6"},
+			}},
+		},
+		{
+			name:   "relays to the remaining hosts from the segment hosts in the last round",
+			fanOut: 2,
+			depth:  2,
+			expected: [][]hub.Relay{
+				{{"", "sdw1"}, {"", "sdw2"}},
+				{{"sdw1", "sdw3"}, {"sdw2", "sdw4"}, {"sdw1", "sdw5"}, {"sdw2", "sdw6"}},
+			},
+		},
+		{
+			name:   "every host with the backup copies to up to fan out hosts in each round",
+			fanOut: 1,
+			depth:  3,
+			expected: [][]hub.Relay{
+				{{"", "sdw1"}},
+				{{"", "sdw2"}, {"sdw1", "sdw3"}},
+				{{"sdw1", "sdw4"}, {"sdw2", "sdw5"}, {"sdw3", "sdw6"}},
+			},
+		},
+		{
+			name:   "stops once every host has the backup",
+			fanOut: 3,
+			depth:  5,
+			expected: [][]hub.Relay{
+				{{"", "sdw1"}, {"", "sdw2"}, {"", "sdw3"}},
+				{{"", "sdw4"}, {"", "sdw5"}, {"", "sdw6"}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			rounds := hub.DistributionTree(hosts, c.fanOut, c.depth)
+			if !reflect.DeepEqual(rounds, c.expected) {
+				t.Errorf("got %v want %v", rounds, c.expected)
+			}
+		})
+	}
+}
+
+func TestDistributeCoordinatorBackup(t *testing.T) {
+	version := semver.MustParse("6.20.0")
+	agentHostsToBackupDir := backupdir.AgentHostsToBackupDir{
+		"sdw1": "/data1/.gpupgrade",
+		"sdw2": "/data2/.gpupgrade",
+		"sdw3": "/data3/.gpupgrade",
+	}
+
+	t.Run("copies to the first host which relays to its peers in the next round", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var mu sync.Mutex
+		var copies []string
+
+		rsync.SetRsyncCommand(exectest.NewCommandWithVerifier(hub.Success, func(_ string, args ...string) {
+			mu.Lock()
+			defer mu.Unlock()
+			copies = append(copies, args[len(args)-1])
+		}))
+		defer rsync.ResetRsyncCommand()
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().RelayCoordinatorBackup(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, req *idl.RelayCoordinatorBackupRequest, _ ...grpc.CallOption) (*idl.RelayCoordinatorBackupReply, error) {
+				expected := &idl.RelayCoordinatorBackupRequest{
+					BackupDir: "/data1/.gpupgrade",
+					Targets: []*idl.RelayCoordinatorBackupRequest_Target{
+						{Host: "sdw2", BackupDir: "/data2/.gpupgrade"},
+						{Host: "sdw3", BackupDir: "/data3/.gpupgrade"},
+					},
+				}
+				if !reflect.DeepEqual(req, expected) {
+					t.Errorf("got request %v want %v", req, expected)
+				}
+
+				mu.Lock()
+				defer mu.Unlock()
+				copies = append(copies, "relay from sdw1")
+				return &idl.RelayCoordinatorBackupReply{}, nil
+			})
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().RelayCoordinatorBackup(gomock.Any(), gomock.Any()).Times(0)
+
+		sdw3 := mock_idl.NewMockAgentClient(ctrl)
+		sdw3.EXPECT().RelayCoordinatorBackup(gomock.Any(), gomock.Any()).Times(0)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+			{AgentClient: sdw3, Hostname: "sdw3"},
+		}
+
+		err := hub.DistributeCoordinatorBackup(step.DevNullStream, agentConns, "/data/qddir/seg-1", version, nil, agentHostsToBackupDir, 1, 2)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		expected := []string{"sdw1:/data1/.gpupgrade/coordinator-post-upgrade-backup", "relay from sdw1"}
+		if !reflect.DeepEqual(copies, expected) {
+			t.Errorf("got copies %q want %q", copies, expected)
+		}
+	})
+
+	t.Run("returns relay errors with the failed round", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		rsync.SetRsyncCommand(exectest.NewCommand(hub.Success))
+		defer rsync.ResetRsyncCommand()
+
+		expected := errors.New("permission denied")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().RelayCoordinatorBackup(gomock.Any(), gomock.Any()).Return(nil, expected)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().RelayCoordinatorBackup(gomock.Any(), gomock.Any()).Times(0)
+
+		sdw3 := mock_idl.NewMockAgentClient(ctrl)
+		sdw3.EXPECT().RelayCoordinatorBackup(gomock.Any(), gomock.Any()).Times(0)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+			{AgentClient: sdw3, Hostname: "sdw3"},
+		}
+
+		err := hub.DistributeCoordinatorBackup(step.DevNullStream, agentConns, "/data/qddir/seg-1", version, nil, agentHostsToBackupDir, 1, 2)
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v want %#v", err, expected)
+		}
+
+		if err != nil && !strings.Contains(err.Error(), "round 2") {
+			t.Errorf("expected error %q to contain the round", err.Error())
+		}
+	})
+
+	t.Run("errors when a host has no agent connection", func(t *testing.T) {
+		err := hub.DistributeCoordinatorBackup(step.DevNullStream, nil, "/data/qddir/seg-1", version, nil, agentHostsToBackupDir, 1, 2)
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
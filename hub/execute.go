@@ -5,9 +5,8 @@ package hub
 
 import (
 	"context"
-	"fmt"
 
-	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/config"
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/utils"
@@ -53,33 +52,29 @@ func (s *Server) Execute(req *idl.ExecuteRequest, stream idl.CliToHub_ExecuteSer
 		return s.Source.Stop(streams)
 	})
 
+	// In link mode pg_upgrade modifies the source data directories, so a
+	// source cluster without mirrors and a standby can only be reverted by
+	// restoring a snapshot. The snapshot is taken after the source cluster is
+	// stopped such that it is consistent.
+	st.RunConditionally(idl.Substep_snapshot_source_cluster, s.Snapshot.Enabled() && s.Mode == idl.Mode_link, func(streams step.OutStreams) error {
+		return SnapshotSourceCluster(streams, s.agentConns, s.Source, s.Snapshot, s.BackupDirs, idl.SnapshotDirectoriesRequest_snapshot)
+	})
+
 	pgUpgradeTimestamp := utils.System.Now().Format(TimeStringFormat)
 	st.Run(idl.Substep_upgrade_master, func(streams step.OutStreams) error {
-		return UpgradeCoordinator(streams, s.BackupDirs.CoordinatorBackupDir, req.GetPgUpgradeVerbose(), req.GetSkipPgUpgradeChecks(), s.PgUpgradeJobs, s.Source, s.Intermediate, idl.PgOptions_upgrade, s.Mode, pgUpgradeTimestamp)
+		return UpgradeCoordinator(streams, s.BackupDirs.CoordinatorBackupDir, req.GetPgUpgradeVerbose(), req.GetSkipPgUpgradeChecks(), CoordinatorPgUpgradeJobs(s.Config), s.Source, s.Intermediate, idl.PgOptions_upgrade, s.Mode, pgUpgradeTimestamp)
 	})
 
 	st.Run(idl.Substep_copy_master, func(streams step.OutStreams) error {
 		// The execute backup directory flag takes precedence over the value set
 		// during initialize. The execute flag is used as an emergency stop gap
 		// to set the backup directory where it is used without needing to
-		// revert and re-run initialize and execute.
+		// revert and re-run initialize and execute. Between steps prefer
+		// "gpupgrade config set parent_backup_dirs".
 		if req.GetParentBackupDirs() != "" {
-			err = DeleteBackupDirectories(streams, s.agentConns, s.BackupDirs)
-			if err != nil {
-				return err
-			}
-
-			s.Config.BackupDirs, err = backupdir.ParseParentBackupDirs(req.GetParentBackupDirs(), *s.Source)
-			if err != nil {
-				return err
-			}
-
-			err = s.Config.Write()
-			if err != nil {
-				return fmt.Errorf("save backup directories: %w", err)
-			}
-
-			err = CreateBackupDirectories(streams, s.agentConns, s.BackupDirs)
+			err = s.updateConfig(func(conf *config.Config) error {
+				return s.setParentBackupDirs(conf, streams, req.GetParentBackupDirs())
+			}, "backup directories")
 			if err != nil {
 				return err
 			}
@@ -100,6 +95,15 @@ single directory such as /dir. To specify different directories for each host
 use the form "host1:/dir1,host2:/dir2,host3:/dir3" where the first host must be 
 the master.`
 
+		if s.CopyFanOut > 0 {
+			err := DistributeCoordinatorBackup(streams, s.agentConns, s.Intermediate.CoordinatorDataDir(), s.Source.Version, s.Source.Tablespaces, s.BackupDirs.AgentHostsToBackupDir, s.CopyFanOut, s.CopyDepth)
+			if err != nil {
+				return utils.NewNextActionErr(err, nextAction)
+			}
+
+			return nil
+		}
+
 		err := CopyCoordinatorDataDir(streams, s.Intermediate.CoordinatorDataDir(), s.BackupDirs.AgentHostsToBackupDir)
 		if err != nil {
 			return utils.NewNextActionErr(err, nextAction)
@@ -113,8 +117,17 @@ the master.`
 		return nil
 	})
 
+	st.Run(idl.Substep_verify_master_backup, func(streams step.OutStreams) error {
+		return VerifyCoordinatorBackup(s.agentConns, s.Intermediate.CoordinatorDataDir(), s.Source.Version, s.Source.Tablespaces, s.BackupDirs.AgentHostsToBackupDir)
+	})
+
 	st.Run(idl.Substep_upgrade_primaries, func(streams step.OutStreams) error {
-		return UpgradePrimaries(s.agentConns, s.BackupDirs.AgentHostsToBackupDir, req.GetPgUpgradeVerbose(), req.GetSkipPgUpgradeChecks(), s.PgUpgradeJobs, s.Source, s.Intermediate, idl.PgOptions_upgrade, s.Mode, pgUpgradeTimestamp)
+		pgUpgradeJobs, err := PrimaryPgUpgradeJobs(s.agentConns, s.Config)
+		if err != nil {
+			return err
+		}
+
+		return UpgradePrimaries(s.agentConns, s.BackupDirs.AgentHostsToBackupDir, req.GetPgUpgradeVerbose(), req.GetSkipPgUpgradeChecks(), pgUpgradeJobs, s.Source, s.Intermediate, idl.PgOptions_upgrade, s.Mode, pgUpgradeTimestamp)
 	})
 
 	st.AlwaysRun(idl.Substep_start_target_cluster, func(streams step.OutStreams) error {
@@ -7,7 +7,11 @@ import (
 	"context"
 	"time"
 
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/history"
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/report"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/upgrade"
 	"github.com/greenplum-db/gpupgrade/utils"
@@ -19,6 +23,30 @@ func (s *Server) Finalize(req *idl.FinalizeRequest, stream idl.CliToHub_Finalize
 		return err
 	}
 
+	if req.GetDeferMirrors() && !s.MirrorsDeferred {
+		mirrorsUpgraded, err := step.HasRun(idl.Step_finalize, idl.Substep_upgrade_mirrors)
+		if err != nil {
+			return err
+		}
+
+		if mirrorsUpgraded {
+			return xerrors.New("Cannot defer the mirrors since finalize has already started upgrading them. Re-run finalize without --defer-mirrors.")
+		}
+
+		s.MirrorsDeferred = true
+		if err := s.Config.Write(); err != nil {
+			return xerrors.Errorf("save deferred mirrors: %w", err)
+		}
+	}
+
+	// When the mirrors and standby are deferred they are added by "gpupgrade
+	// add-mirrors" after finalize. Until then the clusters consist of only the
+	// coordinator and primaries.
+	source, intermediate, target := s.Source, s.Intermediate, s.Target
+	if s.MirrorsDeferred {
+		source, intermediate, target = s.Source.PrimariesOnly(), s.Intermediate.PrimariesOnly(), s.Target.PrimariesOnly()
+	}
+
 	st.AlwaysRun(idl.Substep_ensure_gpupgrade_agents_are_running, func(_ step.OutStreams) error {
 		_, err := RestartAgents(context.Background(), nil, AgentHosts(s.Source), s.AgentPort, utils.GetStateDir())
 		if err != nil {
@@ -37,20 +65,20 @@ func (s *Server) Finalize(req *idl.FinalizeRequest, stream idl.CliToHub_Finalize
 		return s.Intermediate.CheckActiveConnections(streams)
 	})
 
-	st.RunConditionally(idl.Substep_upgrade_mirrors, s.Source.HasMirrors() && s.Mode == idl.Mode_link, func(streams step.OutStreams) error {
-		return UpgradeMirrorsUsingRsync(s.agentConns, s.Source, s.Intermediate, s.UseHbaHostnames)
+	st.RunConditionally(idl.Substep_upgrade_mirrors, source.HasMirrors() && s.Mode == idl.Mode_link, func(streams step.OutStreams) error {
+		return UpgradeMirrorsUsingRsync(streams, s.agentConns, s.Source, s.Intermediate, s.UseHbaHostnames, s.RsyncJobs)
 	})
 
-	st.RunConditionally(idl.Substep_upgrade_mirrors, s.Source.HasMirrors() && s.Mode != idl.Mode_link, func(streams step.OutStreams) error {
+	st.RunConditionally(idl.Substep_upgrade_mirrors, source.HasMirrors() && s.Mode != idl.Mode_link, func(streams step.OutStreams) error {
 		return UpgradeMirrorsUsingGpAddMirrors(streams, s.Intermediate, s.UseHbaHostnames)
 	})
 
-	st.RunConditionally(idl.Substep_upgrade_standby, s.Source.HasStandby(), func(streams step.OutStreams) error {
+	st.RunConditionally(idl.Substep_upgrade_standby, source.HasStandby(), func(streams step.OutStreams) error {
 		return UpgradeStandby(streams, s.Intermediate, s.UseHbaHostnames)
 	})
 
 	st.Run(idl.Substep_wait_for_cluster_to_be_ready_after_adding_mirrors_and_standby, func(streams step.OutStreams) error {
-		return s.Intermediate.WaitForClusterToBeReady()
+		return intermediate.WaitForClusterToBeReady()
 	})
 
 	st.AlwaysRun(idl.Substep_shutdown_target_cluster, func(streams step.OutStreams) error {
@@ -62,7 +90,7 @@ func (s *Server) Finalize(req *idl.FinalizeRequest, stream idl.CliToHub_Finalize
 			return err
 		}
 
-		if err := UpdateCatalog(s.Intermediate, s.Target); err != nil {
+		if err := UpdateCatalog(s.Intermediate, target); err != nil {
 			return err
 		}
 
@@ -70,14 +98,22 @@ func (s *Server) Finalize(req *idl.FinalizeRequest, stream idl.CliToHub_Finalize
 	})
 
 	st.Run(idl.Substep_update_data_directories, func(_ step.OutStreams) error {
-		return RenameDataDirectories(s.agentConns, s.Source, s.Intermediate)
+		if err := RenameDataDirectories(s.agentConns, source, intermediate); err != nil {
+			return err
+		}
+
+		if !s.MirrorsDeferred {
+			return nil
+		}
+
+		return ArchiveMirrorDataDirectories(s.agentConns, s.Source, s.Intermediate)
 	})
 
 	st.Run(idl.Substep_update_target_conf_files, func(streams step.OutStreams) error {
 		return UpdateConfFiles(s.agentConns, streams,
-			s.Target.Version,
-			s.Intermediate,
-			s.Target,
+			target.Version,
+			intermediate,
+			target,
 		)
 	})
 
@@ -86,25 +122,44 @@ func (s *Server) Finalize(req *idl.FinalizeRequest, stream idl.CliToHub_Finalize
 	})
 
 	st.AlwaysRun(idl.Substep_wait_for_cluster_to_be_ready_after_updating_catalog, func(streams step.OutStreams) error {
-		return s.Target.WaitForClusterToBeReady()
+		return target.WaitForClusterToBeReady()
 	})
 
 	var logArchiveDir string
-	st.AlwaysRun(idl.Substep_archive_log_directories, func(_ step.OutStreams) error {
+	st.AlwaysRun(idl.Substep_archive_log_directories, func(streams step.OutStreams) error {
 		logDir, err := utils.GetLogDir()
 		if err != nil {
 			return err
 		}
 
 		logArchiveDir = GetLogArchiveDir(logDir, s.UpgradeID, time.Now())
-		return ArchiveLogDirectories(logDir, logArchiveDir, s.agentConns, s.Config.Target.CoordinatorHostname())
+		err = ArchiveLogDirectories(logDir, logArchiveDir, s.agentConns, s.Config.Target.CoordinatorHostname())
+		if err != nil {
+			return err
+		}
+
+		archivedPaths := []report.ArchivedPath{{Description: "Log directory", Path: logArchiveDir}}
+		archivedPaths = append(archivedPaths, ArchivedDataDirectories(s.Source, s.Intermediate)...)
+		s.writeReport(history.Finalized, s.Target, logArchiveDir, archivedPaths)
+		return nil
+	})
+
+	snapshotTaken, err := step.HasCompleted(idl.Step_execute, idl.Substep_snapshot_source_cluster)
+	if err != nil {
+		return err
+	}
+
+	// The snapshots are no longer needed since finalize cannot be reverted.
+	st.RunConditionally(idl.Substep_delete_source_cluster_snapshot, snapshotTaken, func(streams step.OutStreams) error {
+		return SnapshotSourceCluster(streams, s.agentConns, s.Source, s.Snapshot, s.BackupDirs, idl.SnapshotDirectoriesRequest_delete)
 	})
 
 	st.Run(idl.Substep_delete_backupdir, func(streams step.OutStreams) error {
 		return DeleteBackupDirectories(streams, s.agentConns, s.BackupDirs)
 	})
 
-	st.AlwaysRun(idl.Substep_delete_segment_statedirs, func(_ step.OutStreams) error {
+	// Keep the state directories for "gpupgrade add-mirrors".
+	st.RunConditionally(idl.Substep_delete_segment_statedirs, !s.MirrorsDeferred, func(_ step.OutStreams) error {
 		return DeleteStateDirectories(s.agentConns, s.Source.CoordinatorHostname())
 	})
 
@@ -119,6 +174,7 @@ func (s *Server) Finalize(req *idl.FinalizeRequest, stream idl.CliToHub_Finalize
 			LogArchiveDirectory:                    logArchiveDir,
 			ArchivedSourceCoordinatorDataDirectory: s.Config.Intermediate.CoordinatorDataDir() + upgrade.OldSuffix,
 			UpgradeID:                              s.Config.UpgradeID,
+			MirrorsDeferred:                        s.MirrorsDeferred,
 		},
 	}}}}
 
@@ -24,6 +24,7 @@ import (
 	"github.com/greenplum-db/gpupgrade/testutils"
 	"github.com/greenplum-db/gpupgrade/testutils/exectest"
 	"github.com/greenplum-db/gpupgrade/testutils/testlog"
+	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
 )
 
@@ -167,6 +168,35 @@ func TestRestartAgent(t *testing.T) {
 			t.Errorf("unexpected errr %#v", err)
 		}
 	})
+
+	t.Run("starts agents with the cluster name of a named cluster", func(t *testing.T) {
+		host := "host1"
+
+		t.Setenv(utils.ClusterNameEnv, "prod")
+
+		execCmd := exectest.NewCommandWithVerifier(gpupgrade_agent, func(name string, args ...string) {
+			cmd := fmt.Sprintf("bash -c \"%s/gpupgrade agent --daemonize --port %d --state-directory %s --cluster-name prod\"", testutils.MustGetExecutablePath(t), port, stateDir)
+			expected := []string{host, cmd}
+			if !reflect.DeepEqual(args, expected) {
+				t.Errorf("got %q want %q", args, expected)
+			}
+		})
+		hub.SetExecCommand(execCmd)
+		defer hub.ResetExecCommand()
+
+		dialer := func(ctx context.Context, address string) (net.Conn, error) {
+			if strings.HasPrefix(address, host) { // fail connection attempts to host
+				return nil, immediateFailure{}
+			}
+
+			return listener.Dial()
+		}
+
+		_, err := hub.RestartAgents(ctx, dialer, hostnames, port, stateDir)
+		if err != nil {
+			t.Errorf("unexpected errr %#v", err)
+		}
+	})
 }
 
 // immediateFailure is an error that is explicitly marked non-temporary for
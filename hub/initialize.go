@@ -8,8 +8,10 @@ import (
 	"log"
 
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/report"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/upgrade/snapshot"
 	"github.com/greenplum-db/gpupgrade/utils"
 )
 
@@ -71,7 +73,30 @@ the master.
 	})
 
 	st.RunConditionally(idl.Substep_check_disk_space, req.GetDiskFreeRatio() > 0, func(streams step.OutStreams) error {
-		return CheckDiskSpace(streams, s.agentConns, req.GetDiskFreeRatio(), s.Source, s.Source.Tablespaces)
+		err := CheckDiskSpace(streams, s.agentConns, req.GetDiskFreeRatio(), s.Source, s.Source.Tablespaces)
+		if err != nil {
+			return err
+		}
+
+		// Both copy mode and the rsync snapshot taken in link mode require
+		// room for a full copy of the primaries.
+		switch {
+		case s.Mode == idl.Mode_copy:
+			return CheckCopyModeDiskSpace(s.agentConns, s.Source, s.Source.Tablespaces, req.GetCopyModeDiskBytesByHost())
+		case s.Snapshot.Provider == snapshot.RsyncProvider:
+			return CheckRsyncSnapshotDiskSpace(s.agentConns, s.BackupDirs, req.GetCopyModeDiskBytesByHost())
+		}
+
+		return nil
+	})
+
+	st.Run(idl.Substep_record_disk_usage, func(_ step.OutStreams) error {
+		usage, err := DiskUsage(s.agentConns, s.Source)
+		if err != nil {
+			return err
+		}
+
+		return report.WriteDiskUsage(utils.GetStateDir(), usage)
 	})
 
 	return st.Err()
@@ -129,6 +154,18 @@ func (s *Server) InitializeCreateCluster(req *idl.InitializeCreateClusterRequest
 		return s.Source.WaitForClusterToBeReady()
 	})
 
+	st.RunConditionally(idl.Substep_check_clone_support, s.Mode == idl.Mode_clone, func(stream step.OutStreams) error {
+		err := CheckCloneSupport(s.agentConns, s.Source, s.Intermediate)
+		if err != nil {
+			nextAction := `Clone mode requires a target cluster of version 7 or later, and the source
+data directories and tablespaces to be on filesystems supporting reflinks such
+as XFS or btrfs. Revert and re-run initialize with "--mode copy" or "--mode link".`
+			return utils.NewNextActionErr(err, nextAction)
+		}
+
+		return nil
+	})
+
 	st.AlwaysRun(idl.Substep_check_upgrade, func(stream step.OutStreams) error {
 		if req.GetSkipPgUpgradeChecks() {
 			log.Print("skipping pg_upgrade checks")
@@ -137,11 +174,16 @@ func (s *Server) InitializeCreateCluster(req *idl.InitializeCreateClusterRequest
 
 		pgUpgradeTimestamp := utils.System.Now().Format(TimeStringFormat)
 
-		if err := UpgradeCoordinator(stream, s.BackupDirs.CoordinatorBackupDir, req.GetPgUpgradeVerbose(), req.GetSkipPgUpgradeChecks(), s.PgUpgradeJobs, s.Source, s.Intermediate, idl.PgOptions_check, s.Mode, pgUpgradeTimestamp); err != nil {
+		if err := UpgradeCoordinator(stream, s.BackupDirs.CoordinatorBackupDir, req.GetPgUpgradeVerbose(), req.GetSkipPgUpgradeChecks(), CoordinatorPgUpgradeJobs(s.Config), s.Source, s.Intermediate, idl.PgOptions_check, s.Mode, pgUpgradeTimestamp); err != nil {
+			return err
+		}
+
+		pgUpgradeJobs, err := PrimaryPgUpgradeJobs(s.agentConns, s.Config)
+		if err != nil {
 			return err
 		}
 
-		return UpgradePrimaries(s.agentConns, s.BackupDirs.AgentHostsToBackupDir, req.GetPgUpgradeVerbose(), req.GetSkipPgUpgradeChecks(), s.PgUpgradeJobs, s.Source, s.Intermediate, idl.PgOptions_check, s.Mode, pgUpgradeTimestamp)
+		return UpgradePrimaries(s.agentConns, s.BackupDirs.AgentHostsToBackupDir, req.GetPgUpgradeVerbose(), req.GetSkipPgUpgradeChecks(), pgUpgradeJobs, s.Source, s.Intermediate, idl.PgOptions_check, s.Mode, pgUpgradeTimestamp)
 	})
 
 	message := &idl.Message{Contents: &idl.Message_Response{Response: &idl.Response{Contents: &idl.Response_InitializeResponse{
@@ -0,0 +1,177 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+	"errors"
+	"log"
+	"net"
+	"net/http"
+	"strconv"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/metrics"
+	"github.com/greenplum-db/gpupgrade/utils/disk"
+)
+
+const (
+	pgUpgradeProcess = "pg_upgrade"
+	rsyncProcess     = "rsync"
+)
+
+// The metrics are package level since they are updated from helpers such as
+// the disk space checks which do not have access to the Server.
+var (
+	registry = metrics.NewRegistry()
+
+	currentStep        = registry.NewGauge("gpupgrade_current_step", "Set to 1 while the hub runs the step.", "step")
+	stepsTotal         = registry.NewCounter("gpupgrade_steps_total", "Number of steps run by outcome.", "step", "status")
+	currentSubstep     = registry.NewGauge("gpupgrade_current_substep", "Set to 1 while the hub runs the substep.", "step", "substep")
+	substepDuration    = registry.NewGauge("gpupgrade_substep_duration_seconds", "Duration of the last run of the substep.", "step", "substep")
+	substepsTotal      = registry.NewCounter("gpupgrade_substeps_total", "Number of substeps run by status.", "step", "substep", "status")
+	agentConnected     = registry.NewGauge("gpupgrade_agent_connected", "Set to 1 when the gRPC connection to the agent is ready and 0 otherwise.", "host")
+	processesRequested = registry.NewGauge("gpupgrade_processes_requested", "Number of pg_upgrade and rsync processes requested from the agent by requests still running. The agent may run fewer at once such as when limited by rsync_jobs.", "host", "process")
+	diskAvailable      = registry.NewGauge("gpupgrade_disk_available_bytes", "Available disk space found by the last disk space check.", "host", "filesystem")
+	diskRequired       = registry.NewGauge("gpupgrade_disk_required_bytes", "Required disk space found by the last disk space check.", "host", "filesystem")
+)
+
+// startMetrics serves the metrics over http when a metrics port is configured.
+func (s *Server) startMetrics() (*http.Server, error) {
+	if s.Config == nil || s.MetricsPort == 0 {
+		return nil, nil
+	}
+
+	listener, err := net.Listen("tcp", ":"+strconv.Itoa(s.MetricsPort))
+	if err != nil {
+		return nil, err
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", registry)
+
+	server := &http.Server{Handler: mux, ReadHeaderTimeout: 10 * time.Second}
+	go func() {
+		err := server.Serve(listener)
+		if err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Printf("serving metrics: %v", err)
+		}
+	}()
+
+	return server, nil
+}
+
+// metricsInterceptor records the running step and substeps along with the
+// status and duration of finished substeps.
+func (s *Server) metricsInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	st, ok := stepMethods[info.FullMethod]
+	if !ok {
+		return handler(srv, stream)
+	}
+
+	currentStep.Reset()
+	currentStep.Set(1, st.String())
+
+	m := &metricsStream{ServerStream: stream, step: st, started: make(map[idl.Substep]time.Time)}
+	err := handler(srv, m)
+
+	for substep := range m.started {
+		currentSubstep.Delete(st.String(), substep.String())
+	}
+	currentStep.Delete(st.String())
+
+	outcome := idl.Status_complete
+	if err != nil {
+		outcome = idl.Status_failed
+	}
+	stepsTotal.Inc(st.String(), outcome.String())
+
+	return err
+}
+
+type metricsStream struct {
+	grpc.ServerStream
+	step    idl.Step
+	started map[idl.Substep]time.Time
+}
+
+func (m *metricsStream) SendMsg(msg interface{}) error {
+	if message, ok := msg.(*idl.Message); ok && message.GetStatus() != nil {
+		substep := message.GetStatus().GetStep()
+		substepStatus := message.GetStatus().GetStatus()
+
+		if substepStatus == idl.Status_running {
+			m.started[substep] = time.Now()
+			currentSubstep.Set(1, m.step.String(), substep.String())
+		} else {
+			if start, ok := m.started[substep]; ok {
+				substepDuration.Set(time.Since(start).Seconds(), m.step.String(), substep.String())
+				delete(m.started, substep)
+			}
+
+			currentSubstep.Delete(m.step.String(), substep.String())
+			substepsTotal.Inc(m.step.String(), substep.String(), substepStatus.String())
+		}
+	}
+
+	return m.ServerStream.SendMsg(msg)
+}
+
+// countProcessesRequested counts the pg_upgrade and rsync processes requested
+// from the agent on the host for the duration of each request. The agent may
+// run them in smaller batches so this is an upper bound of those running.
+func countProcessesRequested(host string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		var process string
+		var count int
+
+		switch r := req.(type) {
+		case *idl.UpgradePrimariesRequest:
+			process, count = pgUpgradeProcess, len(r.GetOpts())
+		case *idl.RsyncRequest:
+			process, count = rsyncProcess, len(r.GetOptions())
+		case *idl.RelayCoordinatorBackupRequest:
+			process, count = rsyncProcess, len(r.GetTargets())
+		}
+
+		if count == 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		defer trackProcesses(host, process, count)()
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
+
+// trackProcesses counts the processes as requested until the returned function
+// is called.
+func trackProcesses(host string, process string, count int) func() {
+	processesRequested.Add(float64(count), host, process)
+	return func() {
+		processesRequested.Add(-float64(count), host, process)
+	}
+}
+
+func recordAgentConnectivity(agentConns []*idl.Connection) {
+	for _, conn := range agentConns {
+		connected := 0.0
+		if conn.Conn.GetState() == connectivity.Ready {
+			connected = 1
+		}
+
+		agentConnected.Set(connected, conn.Hostname)
+	}
+}
+
+func recordDiskUsage(usages disk.FileSystemDiskUsage) {
+	for _, usage := range usages {
+		// disk usage is reported in kilobytes
+		diskAvailable.Set(float64(usage.GetAvailable())*1024, usage.GetHost(), usage.GetFs())
+		diskRequired.Set(float64(usage.GetRequired())*1024, usage.GetHost(), usage.GetFs())
+	}
+}
@@ -0,0 +1,239 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/hub"
+	"github.com/greenplum-db/gpupgrade/idl"
+)
+
+func mustWriteMetrics(t *testing.T) string {
+	t.Helper()
+
+	var b strings.Builder
+	err := hub.WriteMetrics(&b)
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	return b.String()
+}
+
+// sampleValue returns the value of the sample or zero when it is not found.
+func sampleValue(t *testing.T, sample string) float64 {
+	t.Helper()
+
+	for _, line := range strings.Split(mustWriteMetrics(t), "\n") {
+		if !strings.HasPrefix(line, sample+" ") {
+			continue
+		}
+
+		value, err := strconv.ParseFloat(strings.TrimPrefix(line, sample+" "), 64)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		return value
+	}
+
+	return 0
+}
+
+func TestMetricsInterceptor(t *testing.T) {
+	statusMessage := func(substep idl.Substep, substepStatus idl.Status) *idl.Message {
+		return &idl.Message{Contents: &idl.Message_Status{Status: &idl.SubstepStatus{Step: substep, Status: substepStatus}}}
+	}
+
+	t.Run("records the running step and substep along with finished substeps", func(t *testing.T) {
+		hubServer := hub.New(&config.Config{})
+		defer hubServer.Stop(false)
+
+		stepsCompleted := `gpupgrade_steps_total{step="finalize",status="complete"}`
+		substepsCompleted := `gpupgrade_substeps_total{step="finalize",substep="upgrade_mirrors",status="complete"}`
+		stepsBefore := sampleValue(t, stepsCompleted)
+		substepsBefore := sampleValue(t, substepsCompleted)
+
+		var during string
+		stream := &sendRecorder{}
+		info := &grpc.StreamServerInfo{FullMethod: idl.CliToHub_Finalize_FullMethodName, IsServerStream: true}
+		err := hubServer.MetricsInterceptor()(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+			err := stream.SendMsg(statusMessage(idl.Substep_upgrade_mirrors, idl.Status_running))
+			if err != nil {
+				return err
+			}
+
+			during = mustWriteMetrics(t)
+
+			err = stream.SendMsg(statusMessage(idl.Substep_upgrade_mirrors, idl.Status_complete))
+			if err != nil {
+				return err
+			}
+
+			return stream.SendMsg(statusMessage(idl.Substep_upgrade_standby, idl.Status_running))
+		})
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		if len(stream.sent) != 3 {
+			t.Errorf("got %d messages sent to the CLI want 3", len(stream.sent))
+		}
+
+		for _, expected := range []string{
+			`gpupgrade_current_step{step="finalize"} 1`,
+			`gpupgrade_current_substep{step="finalize",substep="upgrade_mirrors"} 1`,
+		} {
+			if !strings.Contains(during, expected) {
+				t.Errorf("expected metrics during the step to contain %q got\n%s", expected, during)
+			}
+		}
+
+		if sampleValue(t, stepsCompleted) != stepsBefore+1 {
+			t.Errorf("expected %s to be incremented got\n%s", stepsCompleted, mustWriteMetrics(t))
+		}
+
+		if sampleValue(t, substepsCompleted) != substepsBefore+1 {
+			t.Errorf("expected %s to be incremented got\n%s", substepsCompleted, mustWriteMetrics(t))
+		}
+
+		after := mustWriteMetrics(t)
+		if !strings.Contains(after, `gpupgrade_substep_duration_seconds{step="finalize",substep="upgrade_mirrors"} `) {
+			t.Errorf("expected metrics after the step to contain the substep duration got\n%s", after)
+		}
+
+		for _, unexpected := range []string{`gpupgrade_current_step{`, `gpupgrade_current_substep{`} {
+			if strings.Contains(after, unexpected) {
+				t.Errorf("expected metrics after the step to not contain %q got\n%s", unexpected, after)
+			}
+		}
+	})
+
+	t.Run("counts failed steps", func(t *testing.T) {
+		hubServer := hub.New(&config.Config{})
+		defer hubServer.Stop(false)
+
+		stepsFailed := `gpupgrade_steps_total{step="revert",status="failed"}`
+		before := sampleValue(t, stepsFailed)
+
+		expected := errors.New("permission denied")
+		info := &grpc.StreamServerInfo{FullMethod: idl.CliToHub_Revert_FullMethodName, IsServerStream: true}
+		err := hubServer.MetricsInterceptor()(nil, &sendRecorder{}, info, func(srv interface{}, stream grpc.ServerStream) error {
+			return expected
+		})
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v want %#v", err, expected)
+		}
+
+		if sampleValue(t, stepsFailed) != before+1 {
+			t.Errorf("expected failed revert to be counted got\n%s", mustWriteMetrics(t))
+		}
+	})
+}
+
+func TestCountProcessesRequested(t *testing.T) {
+	cases := []struct {
+		name    string
+		request interface{}
+		sample  string
+		count   int
+	}{
+		{
+			name:    "pg_upgrade",
+			request: &idl.UpgradePrimariesRequest{Opts: []*idl.PgOptions{{}, {}}},
+			sample:  `gpupgrade_processes_requested{host="metrics-sdw1",process="pg_upgrade"}`,
+			count:   2,
+		},
+		{
+			name:    "rsync",
+			request: &idl.RsyncRequest{Options: []*idl.RsyncRequest_RsyncOptions{{}, {}, {}}},
+			sample:  `gpupgrade_processes_requested{host="metrics-sdw1",process="rsync"}`,
+			count:   3,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("counts %s processes requested while the agent runs them", c.name), func(t *testing.T) {
+			var during string
+			invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+				during = mustWriteMetrics(t)
+				return nil
+			}
+
+			err := hub.CountProcessesRequested("metrics-sdw1")(context.Background(), "/idl.Agent/Method", c.request, nil, nil, invoker)
+			if err != nil {
+				t.Errorf("unexpected error %#v", err)
+			}
+
+			running := fmt.Sprintf("%s %d\n", c.sample, c.count)
+			if !strings.Contains(during, running) {
+				t.Errorf("expected metrics to contain %q got\n%s", running, during)
+			}
+
+			finished := c.sample + " 0\n"
+			if !strings.Contains(mustWriteMetrics(t), finished) {
+				t.Errorf("expected metrics to contain %q got\n%s", finished, mustWriteMetrics(t))
+			}
+		})
+	}
+}
+
+func TestMetricsListener(t *testing.T) {
+	port, closeListener := mustListen(t)
+	closeListener()
+
+	hubServer := hub.New(&config.Config{MetricsPort: port})
+
+	errs := make(chan error, 1)
+	go func() {
+		errs <- hubServer.Start(0, false)
+	}()
+
+	url := fmt.Sprintf("http://localhost:%d/metrics", port)
+
+	var body []byte
+	var err error
+	for start := time.Now(); time.Since(start) < 10*time.Second; time.Sleep(50 * time.Millisecond) {
+		var resp *http.Response
+		resp, err = http.Get(url)
+		if err != nil {
+			continue
+		}
+
+		body, err = io.ReadAll(resp.Body)
+		resp.Body.Close()
+		break
+	}
+	if err != nil {
+		t.Fatalf("scraping metrics: %v", err)
+	}
+
+	if !strings.Contains(string(body), "# TYPE gpupgrade_processes_requested gauge") {
+		t.Errorf("unexpected metrics %s", body)
+	}
+
+	hubServer.Stop(false)
+
+	err = <-errs
+	if err != nil {
+		t.Errorf("unexpected error %#v", err)
+	}
+
+	_, err = http.Get(url)
+	if err == nil {
+		t.Errorf("expected metrics listener to be stopped")
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"path/filepath"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+// MoveBackupDirectories moves the backup directory on each host from source to
+// target keeping their contents such as snapshots of the source cluster.
+func MoveBackupDirectories(streams step.OutStreams, agentConns []*idl.Connection, source backupdir.BackupDirs, target backupdir.BackupDirs) error {
+	_, err := fmt.Fprintf(streams.Stdout(), "moving backup directory on all hosts\n")
+	if err != nil {
+		return err
+	}
+
+	err = MoveBackupDirectory(source.CoordinatorBackupDir, target.CoordinatorBackupDir)
+	if err != nil {
+		return err
+	}
+
+	request := func(conn *idl.Connection) error {
+		if _, ok := target.AgentHostsToBackupDir[conn.Hostname]; !ok {
+			return nil
+		}
+
+		req := &idl.MoveBackupDirectoryRequest{
+			Source: source.AgentHostsToBackupDir[conn.Hostname],
+			Target: target.AgentHostsToBackupDir[conn.Hostname],
+		}
+		_, err := conn.AgentClient.MoveBackupDirectory(context.Background(), req)
+		return err
+	}
+
+	return ExecuteRPC(agentConns, request)
+}
+
+// MoveBackupDirectory moves the backup directory from source to target, which
+// may be on a different filesystem. The target is created when there is no
+// source such as when it was already moved.
+func MoveBackupDirectory(source string, target string) error {
+	if source == "" || filepath.Clean(source) == filepath.Clean(target) {
+		return CreateBackupDirectory(target)
+	}
+
+	exist, err := upgrade.PathExist(source)
+	if err != nil {
+		return err
+	}
+
+	if !exist {
+		log.Printf("backup directory %q not found when moving it to %q. It was already moved from a previous run.", source, target)
+		return CreateBackupDirectory(target)
+	}
+
+	log.Printf("moving backup directory %q to %q", source, target)
+	err = utils.System.MkdirAll(filepath.Dir(target), 0700)
+	if err != nil {
+		return xerrors.Errorf("create parent of backup directory %q: %w", target, err)
+	}
+
+	// Remove an empty target such that the source is not moved into it.
+	err = utils.System.Remove(target)
+	if err != nil && !utils.System.IsNotExist(err) {
+		return xerrors.Errorf("backup directory %q already exists: %w", target, err)
+	}
+
+	err = utils.Move(source, target)
+	if err != nil {
+		return xerrors.Errorf("move backup directory %q to %q: %w", source, target, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/hub"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/idl/mock_idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils"
+)
+
+func TestMoveBackupDirectory(t *testing.T) {
+	t.Run("moves the backup directory with its contents", func(t *testing.T) {
+		dir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, dir)
+
+		source := filepath.Join(dir, "source", ".gpupgrade")
+		target := filepath.Join(dir, "target", ".gpupgrade")
+		testutils.MustCreateDir(t, filepath.Join(source, "snapshots"))
+		testutils.MustWriteToFile(t, filepath.Join(source, "snapshots", "PG_VERSION"), "9.4")
+
+		err := hub.MoveBackupDirectory(source, target)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		testutils.PathMustNotExist(t, source)
+		testutils.PathMustExist(t, filepath.Join(target, "snapshots", "PG_VERSION"))
+	})
+
+	t.Run("creates the target when the source was already moved", func(t *testing.T) {
+		dir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, dir)
+
+		target := filepath.Join(dir, "target", ".gpupgrade")
+		err := hub.MoveBackupDirectory(filepath.Join(dir, "source", ".gpupgrade"), target)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		testutils.PathMustExist(t, target)
+	})
+
+	t.Run("errors when the target is not empty", func(t *testing.T) {
+		dir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, dir)
+
+		source := filepath.Join(dir, "source", ".gpupgrade")
+		target := filepath.Join(dir, "target", ".gpupgrade")
+		testutils.MustCreateDir(t, source)
+		testutils.MustCreateDir(t, target)
+		testutils.MustWriteToFile(t, filepath.Join(target, "file"), "")
+
+		err := hub.MoveBackupDirectory(source, target)
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+
+		testutils.PathMustExist(t, source)
+	})
+}
+
+func TestMoveBackupDirectories(t *testing.T) {
+	dir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, dir)
+
+	source := backupdir.BackupDirs{
+		CoordinatorBackupDir:  filepath.Join(dir, "source", ".gpupgrade"),
+		AgentHostsToBackupDir: backupdir.AgentHostsToBackupDir{"sdw1": "/data1/.gpupgrade"},
+	}
+	target := backupdir.BackupDirs{
+		CoordinatorBackupDir:  filepath.Join(dir, "target", ".gpupgrade"),
+		AgentHostsToBackupDir: backupdir.AgentHostsToBackupDir{"sdw1": "/data2/.gpupgrade"},
+	}
+	testutils.MustCreateDir(t, source.CoordinatorBackupDir)
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sdw1 := mock_idl.NewMockAgentClient(ctrl)
+	sdw1.EXPECT().MoveBackupDirectory(
+		gomock.Any(),
+		&idl.MoveBackupDirectoryRequest{Source: "/data1/.gpupgrade", Target: "/data2/.gpupgrade"},
+	).Return(&idl.MoveBackupDirectoryReply{}, nil)
+
+	standby := mock_idl.NewMockAgentClient(ctrl)
+	standby.EXPECT().MoveBackupDirectory(gomock.Any(), gomock.Any()).Times(0)
+
+	agentConns := []*idl.Connection{
+		{AgentClient: sdw1, Hostname: "sdw1"},
+		{AgentClient: standby, Hostname: "standby"},
+	}
+
+	err := hub.MoveBackupDirectories(step.DevNullStream, agentConns, source, target)
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	testutils.PathMustExist(t, target.CoordinatorBackupDir)
+	testutils.PathMustNotExist(t, source.CoordinatorBackupDir)
+}
@@ -0,0 +1,99 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/notify"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+// notifyTimeout bounds how long stopping the hub waits for pending
+// notifications to be delivered.
+const notifyTimeout = 10 * time.Second
+
+// stepMethods are the streaming RPCs that run a step.
+var stepMethods = map[string]idl.Step{
+	idl.CliToHub_Initialize_FullMethodName:              idl.Step_initialize,
+	idl.CliToHub_InitializeCreateCluster_FullMethodName: idl.Step_initialize,
+	idl.CliToHub_Execute_FullMethodName:                 idl.Step_execute,
+	idl.CliToHub_Finalize_FullMethodName:                idl.Step_finalize,
+	idl.CliToHub_Revert_FullMethodName:                  idl.Step_revert,
+	idl.CliToHub_AddMirrors_FullMethodName:              idl.Step_add_mirrors,
+}
+
+// notifyInterceptor sends webhook notifications when a step starts, completes,
+// or fails, and for each substep status sent to the CLI.
+func (s *Server) notifyInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	st, ok := stepMethods[info.FullMethod]
+	if !ok {
+		return handler(srv, stream)
+	}
+
+	start := time.Now()
+	s.notify(notify.Event{Type: notify.StepStarted, Step: st.String()})
+
+	err := handler(srv, &notifyingStream{ServerStream: stream, server: s, step: st, started: make(map[idl.Substep]time.Time)})
+
+	event := notify.Event{Type: notify.StepCompleted, Step: st.String(), Seconds: time.Since(start).Seconds()}
+	if err != nil {
+		event.Type = notify.StepFailed
+		event.Error = err.Error()
+
+		if statusErr, ok := status.FromError(err); ok {
+			event.Error = statusErr.Message()
+			for _, detail := range statusErr.Details() {
+				if nextActions, ok := detail.(*idl.NextActions); ok {
+					event.NextActions = nextActions.GetNextActions()
+				}
+			}
+		}
+	}
+
+	s.notify(event)
+	return err
+}
+
+func (s *Server) notify(event notify.Event) {
+	if s.notifier == nil || s.Config == nil {
+		return
+	}
+
+	event.UpgradeID = s.UpgradeID
+	event.ClusterName = utils.GetClusterName()
+	s.notifier.Notify(s.WebhookURLs, event)
+}
+
+// notifyingStream notifies of each substep status sent to the CLI along with
+// the duration of finished substeps.
+type notifyingStream struct {
+	grpc.ServerStream
+	server  *Server
+	step    idl.Step
+	started map[idl.Substep]time.Time
+}
+
+func (n *notifyingStream) SendMsg(m interface{}) error {
+	if msg, ok := m.(*idl.Message); ok && msg.GetStatus() != nil {
+		substep := msg.GetStatus().GetStep()
+		substepStatus := msg.GetStatus().GetStatus()
+
+		event := notify.Event{Type: notify.SubstepStatus, Step: n.step.String(), Substep: substep.String(), Status: substepStatus.String()}
+		if substepStatus == idl.Status_running {
+			n.started[substep] = time.Now()
+		} else if start, ok := n.started[substep]; ok {
+			event.Seconds = time.Since(start).Seconds()
+			delete(n.started, substep)
+		}
+
+		n.server.notify(event)
+	}
+
+	return n.ServerStream.SendMsg(m)
+}
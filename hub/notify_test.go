@@ -0,0 +1,151 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub_test
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/hub"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/notify"
+)
+
+type sendRecorder struct {
+	grpc.ServerStream
+	sent []interface{}
+}
+
+func (s *sendRecorder) SendMsg(m interface{}) error {
+	s.sent = append(s.sent, m)
+	return nil
+}
+
+func (s *sendRecorder) Context() context.Context {
+	return context.Background()
+}
+
+func TestNotifyInterceptor(t *testing.T) {
+	var mutex sync.Mutex
+	var events []notify.Event
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var event notify.Event
+		err := json.NewDecoder(r.Body).Decode(&event)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		mutex.Lock()
+		events = append(events, event)
+		mutex.Unlock()
+	}))
+	defer server.Close()
+
+	statusMessage := func(substep idl.Substep, substepStatus idl.Status) *idl.Message {
+		return &idl.Message{Contents: &idl.Message_Status{Status: &idl.SubstepStatus{Step: substep, Status: substepStatus}}}
+	}
+
+	t.Run("notifies of step and substep events", func(t *testing.T) {
+		events = nil
+
+		conf := &config.Config{UpgradeID: "AAAAAAAAAAA", WebhookURLs: []string{server.URL}}
+		hubServer := hub.New(conf)
+
+		statusErr, err := status.New(codes.Internal, "substep \"upgrade_master\": failed").WithDetails(&idl.NextActions{NextActions: "Fix pg_upgrade."})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		stream := &sendRecorder{}
+		info := &grpc.StreamServerInfo{FullMethod: idl.CliToHub_Execute_FullMethodName, IsServerStream: true}
+		err = hubServer.NotifyInterceptor()(nil, stream, info, func(srv interface{}, stream grpc.ServerStream) error {
+			for _, msg := range []*idl.Message{
+				statusMessage(idl.Substep_upgrade_master, idl.Status_running),
+				{Contents: &idl.Message_Chunk{Chunk: &idl.Chunk{Buffer: []byte("output")}}},
+				statusMessage(idl.Substep_upgrade_master, idl.Status_failed),
+			} {
+				err := stream.SendMsg(msg)
+				if err != nil {
+					return err
+				}
+			}
+
+			return statusErr.Err()
+		})
+		if status.Convert(err).Message() != statusErr.Message() {
+			t.Errorf("got error %#v want %#v", err, statusErr.Err())
+		}
+
+		hubServer.Stop(false)
+
+		if len(stream.sent) != 3 {
+			t.Errorf("got %d messages sent to the CLI want 3", len(stream.sent))
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if len(events) != 4 {
+			t.Fatalf("got events %+v want 4", events)
+		}
+
+		expected := []struct{ eventType, substep, status string }{
+			{notify.StepStarted, "", ""},
+			{notify.SubstepStatus, "upgrade_master", "running"},
+			{notify.SubstepStatus, "upgrade_master", "failed"},
+			{notify.StepFailed, "", ""},
+		}
+		for i, e := range expected {
+			event := events[i]
+			if event.Type != e.eventType || event.Substep != e.substep || event.Status != e.status || event.Step != "execute" || event.UpgradeID != "AAAAAAAAAAA" {
+				t.Errorf("got event %+v want %+v", event, e)
+			}
+		}
+
+		failed := events[3]
+		if failed.Error != statusErr.Message() || failed.NextActions != "Fix pg_upgrade." {
+			t.Errorf("got failed event %+v", failed)
+		}
+	})
+
+	t.Run("does not notify for other methods or without webhook URLs", func(t *testing.T) {
+		events = nil
+
+		hubServer := hub.New(&config.Config{WebhookURLs: []string{server.URL}})
+		info := &grpc.StreamServerInfo{FullMethod: "/idl.CliToHub/Other", IsServerStream: true}
+		err := hubServer.NotifyInterceptor()(nil, &sendRecorder{}, info, func(srv interface{}, stream grpc.ServerStream) error {
+			return stream.SendMsg(statusMessage(idl.Substep_upgrade_master, idl.Status_running))
+		})
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+		hubServer.Stop(false)
+
+		hubServer = hub.New(&config.Config{})
+		info = &grpc.StreamServerInfo{FullMethod: idl.CliToHub_Execute_FullMethodName, IsServerStream: true}
+		err = hubServer.NotifyInterceptor()(nil, &sendRecorder{}, info, func(srv interface{}, stream grpc.ServerStream) error {
+			return nil
+		})
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+		hubServer.Stop(false)
+
+		mutex.Lock()
+		defer mutex.Unlock()
+
+		if len(events) != 0 {
+			t.Errorf("got events %+v want none", events)
+		}
+	})
+}
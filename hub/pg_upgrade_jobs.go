@@ -0,0 +1,131 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+	"log"
+	"runtime"
+	"sort"
+	"sync"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/idl"
+)
+
+var numCPU = runtime.NumCPU
+
+// XXX: for internal testing only
+func SetNumCPU(f func() int) {
+	numCPU = f
+}
+
+// XXX: for internal testing only
+func ResetNumCPU() {
+	numCPU = runtime.NumCPU
+}
+
+// AutoPgUpgradeJobs divides the CPUs of a host among the segments upgraded in
+// parallel on it. The jobs are limited by the number of databases since
+// pg_upgrade upgrades each database with a single job. A databases count of
+// zero is unknown and does not limit the jobs.
+func AutoPgUpgradeJobs(cpus uint, segments uint, databases uint) uint {
+	if segments == 0 {
+		segments = 1
+	}
+
+	jobs := cpus / segments
+	if databases > 0 && jobs > databases {
+		jobs = databases
+	}
+
+	if jobs == 0 {
+		jobs = 1
+	}
+
+	return jobs
+}
+
+// CoordinatorPgUpgradeJobs returns the pg_upgrade jobs for the coordinator.
+// CoordinatorPgUpgradeJobs takes precedence over PgUpgradeJobs which when zero
+// sizes the jobs from the CPUs of the coordinator host. The chosen value is
+// logged.
+func CoordinatorPgUpgradeJobs(conf *config.Config) uint {
+	jobs := conf.CoordinatorPgUpgradeJobs
+	if jobs == 0 {
+		jobs = conf.PgUpgradeJobs
+	}
+
+	if jobs == 0 {
+		jobs = AutoPgUpgradeJobs(uint(numCPU()), 1, conf.Databases)
+	}
+
+	log.Printf("using %d pg_upgrade jobs for the master", jobs)
+	return jobs
+}
+
+// PrimaryPgUpgradeJobs returns the pg_upgrade jobs for the primaries on each
+// host. PrimaryPgUpgradeJobs takes precedence over PgUpgradeJobs which when
+// zero sizes the jobs from the CPUs reported by each agent and the primaries
+// on its host. The chosen values are logged.
+func PrimaryPgUpgradeJobs(agentConns []*idl.Connection, conf *config.Config) (map[string]uint, error) {
+	primaries := conf.Intermediate.SelectSegments(func(seg *greenplum.SegConfig) bool {
+		return seg.IsPrimary() && !seg.IsCoordinator()
+	})
+
+	segmentsPerHost := make(map[string]uint)
+	for _, seg := range primaries {
+		segmentsPerHost[seg.Hostname]++
+	}
+
+	jobs := conf.PrimaryPgUpgradeJobs
+	if jobs == 0 {
+		jobs = conf.PgUpgradeJobs
+	}
+
+	hostJobs := make(map[string]uint)
+	if jobs > 0 {
+		for host := range segmentsPerHost {
+			hostJobs[host] = jobs
+		}
+	} else {
+		var mutex sync.Mutex
+		request := func(conn *idl.Connection) error {
+			segments, ok := segmentsPerHost[conn.Hostname]
+			if !ok {
+				return nil
+			}
+
+			reply, err := conn.AgentClient.GetHostInfo(context.Background(), &idl.GetHostInfoRequest{})
+			if err != nil {
+				return xerrors.Errorf("get host info on host %s: %w", conn.Hostname, err)
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+			hostJobs[conn.Hostname] = AutoPgUpgradeJobs(uint(reply.GetCpuCount()), segments, conf.Databases)
+			return nil
+		}
+
+		err := ExecuteRPC(agentConns, request)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var hosts []string
+	for host := range hostJobs {
+		hosts = append(hosts, host)
+	}
+	sort.Strings(hosts)
+
+	for _, host := range hosts {
+		log.Printf("using %d pg_upgrade jobs for each of the %d primaries on host %s", hostJobs[host], segmentsPerHost[host], host)
+	}
+
+	return hostJobs, nil
+}
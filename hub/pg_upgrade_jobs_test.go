@@ -0,0 +1,136 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub_test
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/hub"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/idl/mock_idl"
+)
+
+func TestAutoPgUpgradeJobs(t *testing.T) {
+	cases := []struct {
+		name      string
+		cpus      uint
+		segments  uint
+		databases uint
+		expected  uint
+	}{
+		{"divides the CPUs among the segments", 16, 4, 0, 4},
+		{"is limited by the number of databases", 16, 1, 3, 3},
+		{"uses at least one job on dense hosts", 4, 8, 10, 1},
+		{"treats no segments as one", 8, 0, 0, 8},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			jobs := hub.AutoPgUpgradeJobs(c.cpus, c.segments, c.databases)
+			if jobs != c.expected {
+				t.Errorf("got %d want %d", jobs, c.expected)
+			}
+		})
+	}
+}
+
+func TestCoordinatorPgUpgradeJobs(t *testing.T) {
+	hub.SetNumCPU(func() int { return 32 })
+	defer hub.ResetNumCPU()
+
+	cases := []struct {
+		name     string
+		conf     *config.Config
+		expected uint
+	}{
+		{"uses the coordinator override", &config.Config{PgUpgradeJobs: 4, CoordinatorPgUpgradeJobs: 8}, 8},
+		{"uses pg_upgrade_jobs", &config.Config{PgUpgradeJobs: 4}, 4},
+		{"sizes the jobs from the CPUs and databases", &config.Config{Databases: 12}, 12},
+		{"sizes the jobs from the CPUs when the databases are unknown", &config.Config{}, 32},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			jobs := hub.CoordinatorPgUpgradeJobs(c.conf)
+			if jobs != c.expected {
+				t.Errorf("got %d want %d", jobs, c.expected)
+			}
+		})
+	}
+}
+
+func TestPrimaryPgUpgradeJobs(t *testing.T) {
+	intermediate := hub.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "coordinator", DataDir: "/data/qddir/seg-1", Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg0", Role: greenplum.PrimaryRole},
+		{DbID: 3, ContentID: 1, Hostname: "sdw1", DataDir: "/data/dbfast2/seg1", Role: greenplum.PrimaryRole},
+		{DbID: 4, ContentID: 2, Hostname: "sdw1", DataDir: "/data/dbfast3/seg2", Role: greenplum.PrimaryRole},
+		{DbID: 5, ContentID: 3, Hostname: "sdw1", DataDir: "/data/dbfast4/seg3", Role: greenplum.PrimaryRole},
+		{DbID: 6, ContentID: 4, Hostname: "sdw2", DataDir: "/data/dbfast1/seg4", Role: greenplum.PrimaryRole},
+		{DbID: 7, ContentID: 0, Hostname: "sdw2", DataDir: "/data/mirror1/seg0", Role: greenplum.MirrorRole},
+	})
+
+	t.Run("uses the primary override on every host", func(t *testing.T) {
+		conf := &config.Config{Intermediate: intermediate, PgUpgradeJobs: 4, PrimaryPgUpgradeJobs: 2}
+
+		hostJobs, err := hub.PrimaryPgUpgradeJobs(nil, conf)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := map[string]uint{"sdw1": 2, "sdw2": 2}
+		if !reflect.DeepEqual(hostJobs, expected) {
+			t.Errorf("got %v want %v", hostJobs, expected)
+		}
+	})
+
+	t.Run("sizes the jobs from the CPUs reported by each agent", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetHostInfo(gomock.Any(), &idl.GetHostInfoRequest{}).Return(&idl.GetHostInfoReply{CpuCount: 16}, nil)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().GetHostInfo(gomock.Any(), &idl.GetHostInfoRequest{}).Return(&idl.GetHostInfoReply{CpuCount: 16}, nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		conf := &config.Config{Intermediate: intermediate, Databases: 8}
+		hostJobs, err := hub.PrimaryPgUpgradeJobs(agentConns, conf)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := map[string]uint{"sdw1": 4, "sdw2": 8}
+		if !reflect.DeepEqual(hostJobs, expected) {
+			t.Errorf("got %v want %v", hostJobs, expected)
+		}
+	})
+
+	t.Run("returns agent errors", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := errors.New("connection refused")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().GetHostInfo(gomock.Any(), gomock.Any()).Return(nil, expected)
+
+		agentConns := []*idl.Connection{{AgentClient: sdw1, Hostname: "sdw1"}}
+
+		_, err := hub.PrimaryPgUpgradeJobs(agentConns, &config.Config{Intermediate: intermediate})
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v want %#v", err, expected)
+		}
+	})
+}
@@ -76,3 +76,25 @@ func RenameSegmentDataDirs(agentConns []*idl.Connection, renames RenameMap) erro
 
 	return ExecuteRPC(agentConns, request)
 }
+
+// ArchiveMirrorDataDirectories archives the source mirror and standby data
+// directories without replacing them. This is used when the mirrors and standby
+// are deferred such that "gpupgrade add-mirrors" can later create the upgraded
+// mirrors and standby in their place.
+func ArchiveMirrorDataDirectories(agentConns []*idl.Connection, source *greenplum.Cluster, intermediate *greenplum.Cluster) error {
+	m := make(RenameMap)
+
+	for _, seg := range source.Mirrors {
+		m[seg.Hostname] = append(m[seg.Hostname], &idl.RenameDirectories{
+			Source:      seg.DataDir,
+			Target:      intermediate.Mirrors[seg.ContentID].DataDir,
+			ArchiveOnly: true,
+		})
+	}
+
+	if err := RenameSegmentDataDirs(agentConns, m); err != nil {
+		return xerrors.Errorf("archiving mirror data directories: %w", err)
+	}
+
+	return nil
+}
@@ -6,6 +6,7 @@ package hub
 import (
 	"context"
 	"os"
+	"sort"
 	"sync"
 
 	"github.com/greenplum-db/gpupgrade/greenplum"
@@ -39,6 +40,83 @@ func RsyncCoordinatorAndPrimaries(stream step.OutStreams, agentConns []*idl.Conn
 	return err
 }
 
+// RsyncAffectedSegments rsyncs only the coordinator and primaries of the
+// given contents from their standby and mirrors. The remaining segments are
+// left as is.
+func RsyncAffectedSegments(stream step.OutStreams, agentConns []*idl.Connection, source *greenplum.Cluster, contents []int) error {
+	affected := source.WithContents(contents...)
+
+	var err error
+	if _, ok := affected.Primaries[-1]; ok {
+		err = errorlist.Append(err, RsyncCoordinator(stream, source.Standby(), source.Coordinator()))
+		err = errorlist.Append(err, RsyncCoordinatorTablespaces(stream, source.StandbyHostname(), source.Tablespaces[int32(source.Coordinator().DbID)], source.Tablespaces[int32(source.Standby().DbID)]))
+		if err != nil {
+			return err
+		}
+	}
+
+	if err := RsyncPrimaries(agentConns, affected); err != nil {
+		return err
+	}
+
+	return RsyncPrimariesTablespaces(agentConns, affected, source.Tablespaces)
+}
+
+// FindAffectedContents returns the content IDs of the source coordinator and
+// primaries whose data directories pg_upgrade started linking.
+func FindAffectedContents(agentConns []*idl.Connection, source *greenplum.Cluster) ([]int, error) {
+	contents := []int{}
+
+	linked, err := upgrade.IsLinked(source.CoordinatorDataDir())
+	if err != nil {
+		return nil, err
+	}
+
+	if linked {
+		contents = append(contents, source.Coordinator().ContentID)
+	}
+
+	var mutex sync.Mutex
+	request := func(conn *idl.Connection) error {
+		primaries := source.SelectSegments(func(seg *greenplum.SegConfig) bool {
+			return seg.IsOnHost(conn.Hostname) && seg.IsPrimary()
+		})
+		sort.Sort(primaries)
+
+		if len(primaries) == 0 {
+			return nil
+		}
+
+		dataDirToContent := make(map[string]int)
+		req := &idl.FindLinkedDataDirectoriesRequest{}
+		for _, primary := range primaries {
+			dataDirToContent[primary.DataDir] = primary.ContentID
+			req.Datadirs = append(req.Datadirs, primary.DataDir)
+		}
+
+		reply, err := conn.AgentClient.FindLinkedDataDirectories(context.Background(), req)
+		if err != nil {
+			return err
+		}
+
+		mutex.Lock()
+		defer mutex.Unlock()
+		for _, dir := range reply.GetDatadirs() {
+			contents = append(contents, dataDirToContent[dir])
+		}
+
+		return nil
+	}
+
+	err = ExecuteRPC(agentConns, request)
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Ints(contents)
+	return contents, nil
+}
+
 func RsyncCoordinatorAndPrimariesTablespaces(stream step.OutStreams, agentConns []*idl.Connection, source *greenplum.Cluster) error {
 	var wg sync.WaitGroup
 	errs := make(chan error, 2)
@@ -292,6 +292,78 @@ func TestRsyncCoordinatorAndPrimaries(t *testing.T) {
 	})
 }
 
+func TestFindAffectedContents(t *testing.T) {
+	t.Run("returns the contents of the coordinator and primaries that were linked", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		coordinatorDir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, coordinatorDir)
+
+		testutils.MustCreateDir(t, filepath.Join(coordinatorDir, "global"))
+		testutils.MustWriteToFile(t, filepath.Join(coordinatorDir, "global", "pg_control.old"), "")
+
+		cluster := hub.MustCreateCluster(t, greenplum.SegConfigs{
+			{ContentID: -1, Hostname: "coordinator", DataDir: coordinatorDir, Role: greenplum.PrimaryRole},
+			{ContentID: -1, Hostname: "standby", DataDir: "/data/standby", Role: greenplum.MirrorRole},
+			{ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg1", Role: greenplum.PrimaryRole},
+			{ContentID: 0, Hostname: "sdw2", DataDir: "/data/dbfast_mirror1/seg1", Role: greenplum.MirrorRole},
+			{ContentID: 1, Hostname: "sdw1", DataDir: "/data/dbfast2/seg2", Role: greenplum.PrimaryRole},
+			{ContentID: 1, Hostname: "sdw2", DataDir: "/data/dbfast_mirror2/seg2", Role: greenplum.MirrorRole},
+			{ContentID: 2, Hostname: "sdw2", DataDir: "/data/dbfast3/seg3", Role: greenplum.PrimaryRole},
+			{ContentID: 2, Hostname: "sdw1", DataDir: "/data/dbfast_mirror3/seg3", Role: greenplum.MirrorRole},
+		})
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().FindLinkedDataDirectories(
+			gomock.Any(),
+			&idl.FindLinkedDataDirectoriesRequest{Datadirs: []string{"/data/dbfast1/seg1", "/data/dbfast2/seg2"}},
+		).Return(&idl.FindLinkedDataDirectoriesReply{Datadirs: []string{"/data/dbfast2/seg2"}}, nil)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().FindLinkedDataDirectories(
+			gomock.Any(),
+			&idl.FindLinkedDataDirectoriesRequest{Datadirs: []string{"/data/dbfast3/seg3"}},
+		).Return(&idl.FindLinkedDataDirectoriesReply{}, nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		contents, err := hub.FindAffectedContents(agentConns, cluster)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		expected := []int{-1, 1}
+		if !reflect.DeepEqual(contents, expected) {
+			t.Errorf("got contents %v want %v", contents, expected)
+		}
+	})
+
+	t.Run("errors when finding linked data directories fails", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		cluster := hub.MustCreateCluster(t, greenplum.SegConfigs{
+			{ContentID: -1, Hostname: "coordinator", DataDir: "/does/not/exist", Role: greenplum.PrimaryRole},
+			{ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg1", Role: greenplum.PrimaryRole},
+		})
+
+		expected := errors.New("permission denied")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().FindLinkedDataDirectories(gomock.Any(), gomock.Any()).Return(nil, expected)
+
+		agentConns := []*idl.Connection{{AgentClient: sdw1, Hostname: "sdw1"}}
+
+		_, err := hub.FindAffectedContents(agentConns, cluster)
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v want %#v", err, expected)
+		}
+	})
+}
+
 // RestoreCoordinatorAndPrimariesPgControl invokes the restoration of pg_control on
 // coordinator and segments. So, not testing pg_control restoration on segments separately.
 func TestRestoreCoordinatorAndPrimariesPgControl(t *testing.T) {
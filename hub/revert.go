@@ -11,34 +11,56 @@ import (
 	"github.com/pkg/errors"
 	"golang.org/x/xerrors"
 
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/history"
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/report"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/utils"
 )
 
-func (s *Server) Revert(_ *idl.RevertRequest, stream idl.CliToHub_RevertServer) (err error) {
+func (s *Server) Revert(req *idl.RevertRequest, stream idl.CliToHub_RevertServer) (err error) {
 	st, err := step.Begin(idl.Step_revert, stream)
 	if err != nil {
 		return err
 	}
 
-	hasExecuteStarted, err := step.HasStarted(idl.Step_execute)
+	// If initialize exits early then only archiving the log directory and
+	// deleting state directories need to be run.
+	configCreated, err := step.HasCompleted(idl.Step_initialize, idl.Substep_saving_source_cluster_config)
 	if err != nil {
 		return err
 	}
 
-	if !s.Source.HasAllMirrorsAndStandby() && (s.Mode == idl.Mode_link) && hasExecuteStarted {
-		return errors.New(`The source cluster does not have standby and/or mirrors and is being upgraded in link mode. Execute has started.
-Cannot revert and restore the source cluster. Please contact support.`)
+	// A partial revert only restores the source coordinator and primaries
+	// that pg_upgrade linked. The remaining segments were not modified.
+	var affectedContents []int
+	partial := req.GetPartial() && configCreated && s.Mode == idl.Mode_link
+	if partial {
+		affectedContents, err = s.affectedContents()
+		if err != nil {
+			return err
+		}
+
+		// The affected contents can no longer be determined once pg_control
+		// has been restored, so restore all segments.
+		partial = affectedContents != nil
 	}
 
-	// If initialize exits early then only archiving the log directory and
-	// deleting state directories need to be run.
-	configCreated, err := step.HasCompleted(idl.Step_initialize, idl.Substep_saving_source_cluster_config)
+	source := s.Source
+	if partial {
+		source = s.Source.WithContents(affectedContents...)
+	}
+
+	snapshotTaken, err := step.HasCompleted(idl.Step_execute, idl.Substep_snapshot_source_cluster)
 	if err != nil {
 		return err
 	}
 
+	if err := s.checkRevertable(source, snapshotTaken); err != nil {
+		return err
+	}
+
 	agentsStarted, err := step.HasCompleted(idl.Step_initialize, idl.Substep_start_agents)
 	if err != nil {
 		return err
@@ -79,7 +101,22 @@ Cannot revert and restore the source cluster. Please contact support.`)
 		return RestoreCoordinatorAndPrimariesPgControl(streams, s.agentConns, s.Source)
 	})
 
-	st.RunConditionally(idl.Substep_restore_source_cluster, configCreated && s.Mode == idl.Mode_link && s.Source.HasAllMirrorsAndStandby(), func(stream step.OutStreams) error {
+	shouldRestore := configCreated && s.Mode == idl.Mode_link && (snapshotTaken || source.HasAllMirrorsAndStandby())
+	if partial {
+		shouldRestore = shouldRestore && len(affectedContents) > 0
+	}
+
+	st.RunConditionally(idl.Substep_restore_source_cluster, shouldRestore, func(stream step.OutStreams) error {
+		// Prefer the snapshot since it was taken of the stopped source
+		// cluster and does not depend on the mirrors and standby.
+		if snapshotTaken {
+			return SnapshotSourceCluster(stream, s.agentConns, source, s.Snapshot, s.BackupDirs, idl.SnapshotDirectoriesRequest_restore)
+		}
+
+		if partial {
+			return RsyncAffectedSegments(stream, s.agentConns, s.Source, affectedContents)
+		}
+
 		if err := RsyncCoordinatorAndPrimaries(stream, s.agentConns, s.Source); err != nil {
 			return err
 		}
@@ -122,14 +159,26 @@ Cannot revert and restore the source cluster. Please contact support.`)
 	})
 
 	var logArchiveDir string
-	st.AlwaysRun(idl.Substep_archive_log_directories, func(_ step.OutStreams) error {
+	st.AlwaysRun(idl.Substep_archive_log_directories, func(streams step.OutStreams) error {
 		logDir, err := utils.GetLogDir()
 		if err != nil {
 			return err
 		}
 
 		logArchiveDir = GetLogArchiveDir(logDir, s.UpgradeID, time.Now())
-		return ArchiveLogDirectories(logDir, logArchiveDir, s.agentConns, s.Config.Source.CoordinatorHostname())
+		err = ArchiveLogDirectories(logDir, logArchiveDir, s.agentConns, s.Config.Source.CoordinatorHostname())
+		if err != nil {
+			return err
+		}
+
+		s.writeReport(history.Reverted, s.Source, logArchiveDir, []report.ArchivedPath{
+			{Description: "Log directory", Path: logArchiveDir},
+		})
+		return nil
+	})
+
+	st.RunConditionally(idl.Substep_delete_source_cluster_snapshot, snapshotTaken, func(streams step.OutStreams) error {
+		return SnapshotSourceCluster(streams, s.agentConns, s.Source, s.Snapshot, s.BackupDirs, idl.SnapshotDirectoriesRequest_delete)
 	})
 
 	st.RunConditionally(idl.Substep_delete_backupdir, configCreated, func(streams step.OutStreams) error {
@@ -158,3 +207,89 @@ Cannot revert and restore the source cluster. Please contact support.`)
 
 	return st.Err()
 }
+
+// checkRevertable returns an error when the segments of the source cluster
+// to be restored cannot be restored. They can always be restored from a
+// snapshot.
+func (s *Server) checkRevertable(source *greenplum.Cluster, snapshotTaken bool) error {
+	if snapshotTaken {
+		return nil
+	}
+
+	hasExecuteStarted, err := step.HasStarted(idl.Step_execute)
+	if err != nil {
+		return err
+	}
+
+	if !source.HasAllMirrorsAndStandby() && (s.Mode == idl.Mode_link) && hasExecuteStarted {
+		return errors.New(`The source cluster does not have standby and/or mirrors and is being upgraded in link mode. Execute has started.
+Cannot revert and restore the source cluster. Please contact support.`)
+	}
+
+	return nil
+}
+
+// affectedContents returns the content IDs of the source coordinator and
+// primaries that pg_upgrade linked, starting any stopped agents to find them.
+// They are saved to the configuration since restoring pg_control removes the
+// evidence, and a resumed revert must restore the same segments. Nil is
+// returned when they can no longer be determined.
+func (s *Server) affectedContents() ([]int, error) {
+	contents, searched, err := s.findAffectedContents(func() ([]*idl.Connection, error) {
+		_, err := RestartAgents(context.Background(), nil, AgentHosts(s.Source), s.AgentPort, utils.GetStateDir())
+		if err != nil {
+			return nil, err
+		}
+
+		return s.AgentConns()
+	})
+	if err != nil || !searched {
+		return contents, err
+	}
+
+	s.AffectedContents = contents
+	if err := s.Config.Write(); err != nil {
+		return nil, xerrors.Errorf("save affected contents: %w", err)
+	}
+
+	return contents, nil
+}
+
+// findAffectedContents is the read-only part of affectedContents used by
+// revert --dry-run. The agents are only reached through connect, and searched
+// is set when they were asked for the segments modified by pg_upgrade.
+func (s *Server) findAffectedContents(connect func() ([]*idl.Connection, error)) (contents []int, searched bool, err error) {
+	if s.AffectedContents != nil {
+		return s.AffectedContents, false, nil
+	}
+
+	hasExecuteStarted, err := step.HasStarted(idl.Step_execute)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !hasExecuteStarted {
+		return []int{}, false, nil
+	}
+
+	pgControlRestored, err := step.HasRun(idl.Step_revert, idl.Substep_restore_pgcontrol)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if pgControlRestored {
+		return nil, false, nil
+	}
+
+	agentConns, err := connect()
+	if err != nil {
+		return nil, false, err
+	}
+
+	contents, err = FindAffectedContents(agentConns, s.Source)
+	if err != nil {
+		return nil, false, xerrors.Errorf("find segments modified by pg_upgrade: %w", err)
+	}
+
+	return contents, true, nil
+}
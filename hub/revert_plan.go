@@ -0,0 +1,315 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+	"os"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+)
+
+// RevertPlan returns the directories revert would delete and restore on each
+// host without changing anything. The same safety checks revert runs are
+// performed on each host. Substeps that already completed during a previous
+// revert are skipped as revert would skip them.
+func (s *Server) RevertPlan(ctx context.Context, req *idl.RevertPlanRequest) (*idl.RevertPlanReply, error) {
+	configCreated, err := step.HasCompleted(idl.Step_initialize, idl.Substep_saving_source_cluster_config)
+	if err != nil {
+		return nil, err
+	}
+
+	var affectedContents []int
+	partial := req.GetPartial() && configCreated && s.Mode == idl.Mode_link
+	if partial {
+		affectedContents, _, err = s.findAffectedContents(s.connectRunningAgents)
+		if err != nil {
+			return nil, err
+		}
+
+		partial = affectedContents != nil
+	}
+
+	source := s.Source
+	if partial {
+		source = s.Source.WithContents(affectedContents...)
+	}
+
+	snapshotTaken, err := step.HasCompleted(idl.Step_execute, idl.Substep_snapshot_source_cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := s.checkRevertable(source, snapshotTaken); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	if !configCreated {
+		return &idl.RevertPlanReply{}, nil
+	}
+
+	agentsStarted, err := step.HasCompleted(idl.Step_initialize, idl.Substep_start_agents)
+	if err != nil {
+		return nil, err
+	}
+
+	if agentsStarted {
+		_, err = s.connectRunningAgents()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	substeps := []struct {
+		substep   idl.Substep
+		condition bool
+		actions   func() []*idl.RevertAction
+	}{
+		{idl.Substep_delete_target_cluster_datadirs, true, func() []*idl.RevertAction {
+			return DeleteDataDirectoryActions(s.Intermediate)
+		}},
+		{idl.Substep_delete_tablespaces, true, func() []*idl.RevertAction {
+			return DeleteTablespaceActions(s.Intermediate, s.Intermediate.CatalogVersion, s.Source.Tablespaces)
+		}},
+		{idl.Substep_restore_source_cluster, s.Mode == idl.Mode_link && snapshotTaken, func() []*idl.RevertAction {
+			return RestoreSnapshotActions(source)
+		}},
+		{idl.Substep_restore_source_cluster, s.Mode == idl.Mode_link && !snapshotTaken && source.HasAllMirrorsAndStandby(), func() []*idl.RevertAction {
+			return RestoreSourceClusterActions(source)
+		}},
+		{idl.Substep_delete_backupdir, true, func() []*idl.RevertAction {
+			return DeleteBackupDirectoryActions(s.BackupDirs, s.Source.CoordinatorHostname())
+		}},
+	}
+
+	var actions []*idl.RevertAction
+	for _, sub := range substeps {
+		completed, err := step.HasCompleted(idl.Step_revert, sub.substep)
+		if err != nil {
+			return nil, err
+		}
+
+		if !sub.condition || completed {
+			continue
+		}
+
+		actions = append(actions, sub.actions()...)
+	}
+
+	// Revert only acts on the coordinator host and the hosts with agents.
+	actions = actionsOnHosts(actions, s.Source.CoordinatorHostname(), s.agentConns)
+
+	err = VerifyRevertActions(s.agentConns, s.Source.CoordinatorHostname(), actions)
+	if err != nil {
+		return nil, err
+	}
+
+	return &idl.RevertPlanReply{Actions: actions}, nil
+}
+
+// connectRunningAgents connects to the agents without starting any that are
+// stopped since a dry run must not change anything.
+func (s *Server) connectRunningAgents() ([]*idl.Connection, error) {
+	agentConns, err := s.AgentConns()
+	if err != nil {
+		return nil, status.Errorf(codes.FailedPrecondition,
+			`%v. Start the agents with "gpupgrade restart-services" and rerun the dry run.`, err)
+	}
+
+	return agentConns, nil
+}
+
+// DeleteDataDirectoryActions returns the intermediate coordinator and primary
+// data directories deleted by DeleteCoordinatorAndPrimaryDataDirectories.
+func DeleteDataDirectoryActions(intermediate *greenplum.Cluster) []*idl.RevertAction {
+	actions := []*idl.RevertAction{{
+		Type: idl.RevertAction_delete_data_directory,
+		Host: intermediate.CoordinatorHostname(),
+		Path: intermediate.CoordinatorDataDir(),
+	}}
+
+	for _, seg := range intermediate.SelectSegments(func(seg *greenplum.SegConfig) bool { return seg.IsPrimary() }) {
+		actions = append(actions, &idl.RevertAction{
+			Type: idl.RevertAction_delete_data_directory,
+			Host: seg.Hostname,
+			Path: seg.DataDir,
+		})
+	}
+
+	return actions
+}
+
+// DeleteTablespaceActions returns the upgraded tablespace directories deleted
+// by DeleteTargetTablespaces.
+func DeleteTablespaceActions(target *greenplum.Cluster, catalogVersion string, sourceTablespaces greenplum.Tablespaces) []*idl.RevertAction {
+	var actions []*idl.RevertAction
+	segs := append(greenplum.SegConfigs{target.Coordinator()}, target.SelectSegments(func(seg *greenplum.SegConfig) bool { return seg.IsPrimary() })...)
+	for _, seg := range segs {
+		for _, dir := range targetTablespaceDirs(target, seg, sourceTablespaces[int32(seg.DbID)], catalogVersion) {
+			actions = append(actions, &idl.RevertAction{
+				Type: idl.RevertAction_delete_tablespace_directory,
+				Host: seg.Hostname,
+				Path: dir,
+			})
+		}
+	}
+
+	return actions
+}
+
+// RestoreSourceClusterActions returns the rsyncs of RsyncCoordinatorAndPrimaries
+// and RsyncCoordinatorAndPrimariesTablespaces which restore the source
+// coordinator from the standby and the source primaries from their mirrors.
+// The coordinator is only restored when the cluster contains it.
+func RestoreSourceClusterActions(source *greenplum.Cluster) []*idl.RevertAction {
+	var actions []*idl.RevertAction
+	coordinator, standby := source.Coordinator(), source.Standby()
+	_, hasCoordinator := source.Primaries[-1]
+
+	if hasCoordinator {
+		actions = append(actions, &idl.RevertAction{
+			Type:            idl.RevertAction_rsync_data_directory,
+			Host:            coordinator.Hostname,
+			SourceHost:      standby.Hostname,
+			Source:          standby.DataDir + string(os.PathSeparator),
+			DestinationHost: coordinator.Hostname,
+			Destination:     coordinator.DataDir,
+		})
+	}
+
+	mirrors := source.SelectSegments(func(seg *greenplum.SegConfig) bool {
+		return !seg.IsStandby() && seg.IsMirror()
+	})
+
+	for _, mirror := range mirrors {
+		primary := source.Primaries[mirror.ContentID]
+		actions = append(actions, &idl.RevertAction{
+			Type:            idl.RevertAction_rsync_data_directory,
+			Host:            mirror.Hostname,
+			SourceHost:      mirror.Hostname,
+			Source:          mirror.DataDir + string(os.PathSeparator),
+			DestinationHost: primary.Hostname,
+			Destination:     primary.DataDir,
+		})
+	}
+
+	for oid, tsInfo := range source.Tablespaces[int32(coordinator.DbID)] {
+		if !hasCoordinator || !tsInfo.GetUserDefined() {
+			continue
+		}
+
+		actions = append(actions, &idl.RevertAction{
+			Type:            idl.RevertAction_rsync_tablespace_directory,
+			Host:            coordinator.Hostname,
+			SourceHost:      standby.Hostname,
+			Source:          source.Tablespaces[int32(standby.DbID)][oid].GetLocation() + string(os.PathSeparator),
+			DestinationHost: coordinator.Hostname,
+			Destination:     tsInfo.GetLocation(),
+		})
+	}
+
+	for _, mirror := range mirrors {
+		primary := source.Primaries[mirror.ContentID]
+		for oid, mirrorTsInfo := range source.Tablespaces[int32(mirror.DbID)] {
+			if !mirrorTsInfo.GetUserDefined() {
+				continue
+			}
+
+			actions = append(actions, &idl.RevertAction{
+				Type:            idl.RevertAction_rsync_tablespace_directory,
+				Host:            mirror.Hostname,
+				SourceHost:      mirror.Hostname,
+				Source:          mirrorTsInfo.GetLocation() + string(os.PathSeparator),
+				DestinationHost: primary.Hostname,
+				Destination:     source.Tablespaces[int32(primary.DbID)][oid].GetLocation(),
+			})
+		}
+	}
+
+	return actions
+}
+
+// DeleteBackupDirectoryActions returns the backup directories deleted by
+// DeleteBackupDirectories.
+func DeleteBackupDirectoryActions(backupDirs backupdir.BackupDirs, coordinatorHost string) []*idl.RevertAction {
+	actions := []*idl.RevertAction{{
+		Type: idl.RevertAction_delete_backup_directory,
+		Host: coordinatorHost,
+		Path: backupDirs.CoordinatorBackupDir,
+	}}
+
+	for host, dir := range backupDirs.AgentHostsToBackupDir {
+		actions = append(actions, &idl.RevertAction{
+			Type: idl.RevertAction_delete_backup_directory,
+			Host: host,
+			Path: dir,
+		})
+	}
+
+	return actions
+}
+
+// VerifyRevertActions runs the safety checks for each action on the host it
+// runs on. Actions on the coordinator host are verified by the hub.
+func VerifyRevertActions(agentConns []*idl.Connection, coordinatorHost string, actions []*idl.RevertAction) error {
+	var local []*idl.RevertAction
+	for _, action := range actions {
+		if action.GetHost() == coordinatorHost {
+			local = append(local, action)
+		}
+	}
+
+	upgrade.VerifyRevertActions(local)
+
+	request := func(conn *idl.Connection) error {
+		var hostActions []*idl.RevertAction
+		for _, action := range actions {
+			if action.GetHost() == conn.Hostname && action.GetHost() != coordinatorHost {
+				hostActions = append(hostActions, action)
+			}
+		}
+
+		if len(hostActions) == 0 {
+			return nil
+		}
+
+		reply, err := conn.AgentClient.VerifyRevertActions(context.Background(), &idl.VerifyRevertActionsRequest{Actions: hostActions})
+		if err != nil {
+			return err
+		}
+
+		for i, verified := range reply.GetActions() {
+			if i < len(hostActions) {
+				hostActions[i].VerificationError = verified.GetVerificationError()
+			}
+		}
+
+		return nil
+	}
+
+	return ExecuteRPC(agentConns, request)
+}
+
+func actionsOnHosts(actions []*idl.RevertAction, coordinatorHost string, agentConns []*idl.Connection) []*idl.RevertAction {
+	hosts := map[string]bool{coordinatorHost: true}
+	for _, conn := range agentConns {
+		hosts[conn.Hostname] = true
+	}
+
+	var filtered []*idl.RevertAction
+	for _, action := range actions {
+		if hosts[action.GetHost()] {
+			filtered = append(filtered, action)
+		}
+	}
+
+	return filtered
+}
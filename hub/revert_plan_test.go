@@ -0,0 +1,175 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub_test
+
+import (
+	"errors"
+	"sort"
+	"testing"
+
+	"github.com/blang/semver/v4"
+	"github.com/golang/mock/gomock"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/hub"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/idl/mock_idl"
+	"github.com/greenplum-db/gpupgrade/testutils"
+)
+
+func TestRevertActions(t *testing.T) {
+	source := hub.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "coordinator", DataDir: "/data/qddir", Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: -1, Hostname: "standby", DataDir: "/data/standby", Role: greenplum.MirrorRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg1", Role: greenplum.PrimaryRole},
+		{DbID: 4, ContentID: 0, Hostname: "msdw1", DataDir: "/data/dbfast_mirror1/seg1", Role: greenplum.MirrorRole},
+		{DbID: 5, ContentID: 1, Hostname: "sdw2", DataDir: "/data/dbfast2/seg2", Role: greenplum.PrimaryRole},
+		{DbID: 6, ContentID: 1, Hostname: "msdw2", DataDir: "/data/dbfast_mirror2/seg2", Role: greenplum.MirrorRole},
+	})
+	source.Tablespaces = testutils.CreateTablespaces()
+
+	intermediate := hub.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "coordinator", DataDir: "/data/qddir.ABC.-1", Role: greenplum.PrimaryRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg.ABC.1", Role: greenplum.PrimaryRole},
+		{DbID: 5, ContentID: 1, Hostname: "sdw2", DataDir: "/data/dbfast2/seg.ABC.2", Role: greenplum.PrimaryRole},
+	})
+	intermediate.Version = semver.MustParse("6.20.0")
+
+	t.Run("deletes the intermediate coordinator and primary data directories", func(t *testing.T) {
+		actions := hub.DeleteDataDirectoryActions(intermediate)
+
+		expected := []*idl.RevertAction{
+			{Type: idl.RevertAction_delete_data_directory, Host: "coordinator", Path: "/data/qddir.ABC.-1"},
+			{Type: idl.RevertAction_delete_data_directory, Host: "sdw1", Path: "/data/dbfast1/seg.ABC.1"},
+			{Type: idl.RevertAction_delete_data_directory, Host: "sdw2", Path: "/data/dbfast2/seg.ABC.2"},
+		}
+		assertActions(t, actions, expected)
+	})
+
+	t.Run("deletes the upgraded tablespace directories", func(t *testing.T) {
+		actions := hub.DeleteTablespaceActions(intermediate, "301908232", source.Tablespaces)
+
+		expected := []*idl.RevertAction{
+			{Type: idl.RevertAction_delete_tablespace_directory, Host: "coordinator", Path: "/tmp/user_ts/m/qddir/16384/1/GPDB_6_301908232"},
+			{Type: idl.RevertAction_delete_tablespace_directory, Host: "sdw1", Path: "/tmp/user_ts/p1/16384/3/GPDB_6_301908232"},
+			{Type: idl.RevertAction_delete_tablespace_directory, Host: "sdw2", Path: "/tmp/user_ts/p2/16384/5/GPDB_6_301908232"},
+		}
+		assertActions(t, actions, expected)
+	})
+
+	t.Run("restores the source cluster from the standby and mirrors", func(t *testing.T) {
+		actions := hub.RestoreSourceClusterActions(source)
+
+		expected := []*idl.RevertAction{
+			{Type: idl.RevertAction_rsync_data_directory, Host: "coordinator", SourceHost: "standby", Source: "/data/standby/", DestinationHost: "coordinator", Destination: "/data/qddir"},
+			{Type: idl.RevertAction_rsync_data_directory, Host: "msdw1", SourceHost: "msdw1", Source: "/data/dbfast_mirror1/seg1/", DestinationHost: "sdw1", Destination: "/data/dbfast1/seg1"},
+			{Type: idl.RevertAction_rsync_data_directory, Host: "msdw2", SourceHost: "msdw2", Source: "/data/dbfast_mirror2/seg2/", DestinationHost: "sdw2", Destination: "/data/dbfast2/seg2"},
+			{Type: idl.RevertAction_rsync_tablespace_directory, Host: "coordinator", SourceHost: "standby", Source: "/tmp/user_ts/m/standby/16384/", DestinationHost: "coordinator", Destination: "/tmp/user_ts/m/qddir/16384"},
+			{Type: idl.RevertAction_rsync_tablespace_directory, Host: "msdw1", SourceHost: "msdw1", Source: "/tmp/user_ts/m1/16384/", DestinationHost: "sdw1", Destination: "/tmp/user_ts/p1/16384"},
+			{Type: idl.RevertAction_rsync_tablespace_directory, Host: "msdw2", SourceHost: "msdw2", Source: "/tmp/user_ts/m2/16384/", DestinationHost: "sdw2", Destination: "/tmp/user_ts/p2/16384"},
+		}
+		assertActions(t, actions, expected)
+	})
+
+	t.Run("restores only the affected primaries of a partial revert", func(t *testing.T) {
+		actions := hub.RestoreSourceClusterActions(source.WithContents(1))
+
+		expected := []*idl.RevertAction{
+			{Type: idl.RevertAction_rsync_data_directory, Host: "msdw2", SourceHost: "msdw2", Source: "/data/dbfast_mirror2/seg2/", DestinationHost: "sdw2", Destination: "/data/dbfast2/seg2"},
+			{Type: idl.RevertAction_rsync_tablespace_directory, Host: "msdw2", SourceHost: "msdw2", Source: "/tmp/user_ts/m2/16384/", DestinationHost: "sdw2", Destination: "/tmp/user_ts/p2/16384"},
+		}
+		assertActions(t, actions, expected)
+	})
+
+	t.Run("deletes the backup directories", func(t *testing.T) {
+		backupDirs := backupdir.BackupDirs{
+			CoordinatorBackupDir:  "/data/.gpupgrade",
+			AgentHostsToBackupDir: backupdir.AgentHostsToBackupDir{"sdw1": "/data1/.gpupgrade"},
+		}
+
+		actions := hub.DeleteBackupDirectoryActions(backupDirs, "coordinator")
+
+		expected := []*idl.RevertAction{
+			{Type: idl.RevertAction_delete_backup_directory, Host: "coordinator", Path: "/data/.gpupgrade"},
+			{Type: idl.RevertAction_delete_backup_directory, Host: "sdw1", Path: "/data1/.gpupgrade"},
+		}
+		assertActions(t, actions, expected)
+	})
+}
+
+func TestVerifyRevertActions(t *testing.T) {
+	t.Run("verifies the actions on each host with its agent", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		sdw1Action := &idl.RevertAction{Type: idl.RevertAction_delete_data_directory, Host: "sdw1", Path: "/data/dbfast1/seg.ABC.1"}
+		sdw2Action := &idl.RevertAction{Type: idl.RevertAction_delete_data_directory, Host: "sdw2", Path: "/data/dbfast2/seg.ABC.2"}
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().VerifyRevertActions(gomock.Any(), &idl.VerifyRevertActionsRequest{Actions: []*idl.RevertAction{sdw1Action}}).
+			Return(&idl.VerifyRevertActionsReply{Actions: []*idl.RevertAction{{VerificationError: "invalid data directory"}}}, nil)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().VerifyRevertActions(gomock.Any(), &idl.VerifyRevertActionsRequest{Actions: []*idl.RevertAction{sdw2Action}}).
+			Return(&idl.VerifyRevertActionsReply{Actions: []*idl.RevertAction{{}}}, nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		err := hub.VerifyRevertActions(agentConns, "coordinator", []*idl.RevertAction{sdw1Action, sdw2Action})
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		if sdw1Action.GetVerificationError() != "invalid data directory" || sdw2Action.GetVerificationError() != "" {
+			t.Errorf("got verification errors %q and %q", sdw1Action.GetVerificationError(), sdw2Action.GetVerificationError())
+		}
+	})
+
+	t.Run("returns errors from the agents", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := errors.New("connection refused")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().VerifyRevertActions(gomock.Any(), gomock.Any()).Return(nil, expected)
+
+		agentConns := []*idl.Connection{{AgentClient: sdw1, Hostname: "sdw1"}}
+
+		err := hub.VerifyRevertActions(agentConns, "coordinator", []*idl.RevertAction{{Host: "sdw1"}})
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v want %#v", err, expected)
+		}
+	})
+}
+
+func assertActions(t *testing.T, actions []*idl.RevertAction, expected []*idl.RevertAction) {
+	t.Helper()
+
+	less := func(actions []*idl.RevertAction) func(i, j int) bool {
+		return func(i, j int) bool {
+			if actions[i].GetType() != actions[j].GetType() {
+				return actions[i].GetType() < actions[j].GetType()
+			}
+
+			return actions[i].GetHost() < actions[j].GetHost()
+		}
+	}
+	sort.Slice(actions, less(actions))
+	sort.Slice(expected, less(expected))
+
+	if len(actions) != len(expected) {
+		t.Fatalf("got %d actions %v want %d %v", len(actions), actions, len(expected), expected)
+	}
+
+	for i := range actions {
+		if !proto.Equal(actions[i], expected[i]) {
+			t.Errorf("got action %v want %v", actions[i], expected[i])
+		}
+	}
+}
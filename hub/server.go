@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"log"
 	"net"
+	"net/http"
 	"os"
 	"strconv"
 	"strings"
@@ -24,6 +25,8 @@ import (
 	"github.com/greenplum-db/gpupgrade/config"
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/notify"
+	"github.com/greenplum-db/gpupgrade/tracing"
 	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/daemon"
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
@@ -38,10 +41,18 @@ var ErrHubStopped = errors.New("hub is stopped")
 type Server struct {
 	*config.Config
 
-	agentConns []*idl.Connection
-	mutex      sync.Mutex
-	gRPCserver *grpc.Server
-	listener   net.Listener
+	agentConns    []*idl.Connection
+	mutex         sync.Mutex
+	gRPCserver    *grpc.Server
+	listener      net.Listener
+	metricsServer *http.Server
+	notifier      *notify.Notifier
+
+	// settingsMutex guards the settings changed by SetConfig and the number
+	// of step streams in progress such that settings are not changed while
+	// a step is using them.
+	settingsMutex sync.Mutex
+	activeSteps   int
 
 	// This is used both as a channel to communicate from Start() to
 	// Stop() to indicate to Stop() that it can finally terminate
@@ -55,8 +66,9 @@ type Server struct {
 
 func New(conf *config.Config) *Server {
 	return &Server{
-		Config:  conf,
-		stopped: make(chan struct{}, 1),
+		Config:   conf,
+		notifier: notify.New(),
+		stopped:  make(chan struct{}, 1),
 	}
 }
 
@@ -70,16 +82,29 @@ func (s *Server) Start(port int, daemonize bool) error {
 		defer logger.WritePanics()
 		return handler(ctx, req)
 	}
-	gRPCserver := grpc.NewServer(grpc.UnaryInterceptor(interceptor))
+	gRPCserver := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptor, tracing.UnaryServerInterceptor),
+		grpc.ChainStreamInterceptor(s.stepInterceptor, tracing.StreamServerInterceptor, s.notifyInterceptor, s.metricsInterceptor),
+	)
+
+	metricsServer, err := s.startMetrics()
+	if err != nil {
+		listener.Close() //nolint
+		return fmt.Errorf("listen on metrics port %d: %w", s.MetricsPort, err)
+	}
 
 	s.mutex.Lock()
 	if s.stopped == nil {
 		// Stop() has already been called; return without serving.
 		s.mutex.Unlock()
+		if metricsServer != nil {
+			metricsServer.Close() //nolint
+		}
 		return ErrHubStopped
 	}
 	s.gRPCserver = gRPCserver
 	s.listener = listener
+	s.metricsServer = metricsServer
 	s.mutex.Unlock()
 
 	idl.RegisterCliToHubServer(gRPCserver, s)
@@ -152,6 +177,17 @@ func (s *Server) Stop(closeAgentConns bool) {
 		<-s.stopped // block until it is OK to stop
 	}
 
+	if s.metricsServer != nil {
+		err := s.metricsServer.Close()
+		if err != nil {
+			log.Printf("stopping metrics server: %v", err)
+		}
+	}
+
+	if s.notifier != nil {
+		s.notifier.Close(notifyTimeout)
+	}
+
 	// Mark this server stopped so that a concurrent Start() doesn't try to
 	// start things up again.
 	s.stopped = nil
@@ -214,8 +250,12 @@ func RestartAgents(ctx context.Context,
 				errs <- err
 				return
 			}
-			cmd := ExecCommand("ssh", host,
-				fmt.Sprintf("bash -c \"%s agent --daemonize --port %d --state-directory %s\"", path, port, stateDir))
+			agentCmd := fmt.Sprintf("%s agent --daemonize --port %d --state-directory %s", path, port, stateDir)
+			if name := utils.GetClusterName(); name != "" {
+				agentCmd += fmt.Sprintf(" --%s %s", utils.ClusterNameFlag, name)
+			}
+
+			cmd := ExecCommand("ssh", host, fmt.Sprintf("bash -c \"%s\"", agentCmd))
 			stdout, err := cmd.Output()
 			if err != nil {
 				errs <- err
@@ -277,11 +317,15 @@ func (s *Server) AgentConns() ([]*idl.Connection, error) {
 		ctx, cancelFunc := context.WithTimeout(context.Background(), DialTimeout)
 		conn, err := gRPCDialer(ctx,
 			host+":"+strconv.Itoa(s.AgentPort),
-			grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock())
+			grpc.WithTransportCredentials(insecure.NewCredentials()), grpc.WithBlock(),
+			grpc.WithChainUnaryInterceptor(tracing.UnaryClientInterceptor(host), countProcessesRequested(host)),
+			grpc.WithChainStreamInterceptor(tracing.StreamClientInterceptor(host)))
 		if err != nil {
+			agentConnected.Set(0, host)
 			cancelFunc()
 			return nil, xerrors.Errorf("agent connections: %w", err)
 		}
+		agentConnected.Set(1, host)
 		s.agentConns = append(s.agentConns, &idl.Connection{
 			Conn:          conn,
 			AgentClient:   idl.NewAgentClient(conn),
@@ -325,6 +369,8 @@ func (a *AgentsNotReadyError) Is(err error) bool {
 func EnsureConnsAreReady(agentConns []*idl.Connection, timeout time.Duration) error {
 	startTime := time.Now()
 	for {
+		recordAgentConnectivity(agentConns)
+
 		agentsNotReady := AgentsGrpcStatus{}
 		for _, conn := range agentConns {
 			if conn.Conn.GetState() != connectivity.Ready {
@@ -0,0 +1,131 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/upgrade/snapshot"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+// SnapshotDirectories returns the directories of the source coordinator and
+// primaries that pg_upgrade modifies in link mode for each host.
+func SnapshotDirectories(source *greenplum.Cluster) map[string][]*idl.SnapshotDirectory {
+	hostDirs := make(map[string][]*idl.SnapshotDirectory)
+
+	segs := source.SelectSegments(func(seg *greenplum.SegConfig) bool {
+		return seg.IsCoordinator() || seg.IsPrimary()
+	})
+	sort.Sort(segs)
+
+	for _, seg := range segs {
+		hostDirs[seg.Hostname] = append(hostDirs[seg.Hostname], segmentSnapshotDirectories(source, seg)...)
+	}
+
+	return hostDirs
+}
+
+// segmentSnapshotDirectories returns the data directory and user defined
+// tablespace locations of the segment. Each directory is named after the dbid
+// of the segment which is unique within the cluster.
+func segmentSnapshotDirectories(source *greenplum.Cluster, seg greenplum.SegConfig) []*idl.SnapshotDirectory {
+	dirs := []*idl.SnapshotDirectory{{
+		Path: seg.DataDir,
+		Name: fmt.Sprintf("datadir-%d", seg.DbID),
+	}}
+
+	var oids []int
+	for oid, tsInfo := range source.Tablespaces[int32(seg.DbID)] {
+		if tsInfo.GetUserDefined() {
+			oids = append(oids, int(oid))
+		}
+	}
+	sort.Ints(oids)
+
+	for _, oid := range oids {
+		dirs = append(dirs, &idl.SnapshotDirectory{
+			Path: source.Tablespaces[int32(seg.DbID)][int32(oid)].GetLocation(),
+			Name: fmt.Sprintf("tablespace-%d-%d", seg.DbID, oid),
+		})
+	}
+
+	return dirs
+}
+
+// SnapshotSourceCluster snapshots, restores, or deletes the snapshots of the
+// source coordinator and primaries. The coordinator is handled by the hub,
+// and the primaries by the agent on their host.
+func SnapshotSourceCluster(streams step.OutStreams, agentConns []*idl.Connection, source *greenplum.Cluster, settings snapshot.Settings, backupDirs backupdir.BackupDirs, action idl.SnapshotDirectoriesRequest_Action) error {
+	errs := make(chan error, 1)
+	go func() {
+		// A partial revert may not restore the coordinator.
+		if _, ok := source.Primaries[-1]; !ok {
+			errs <- nil
+			return
+		}
+
+		provider, err := snapshot.NewProvider(settings.Proto(), backupDirs.CoordinatorBackupDir)
+		if err != nil {
+			errs <- err
+			return
+		}
+
+		errs <- snapshot.Run(streams, provider, action, segmentSnapshotDirectories(source, source.Coordinator()))
+	}()
+
+	request := func(conn *idl.Connection) error {
+		primaries := source.SelectSegments(func(seg *greenplum.SegConfig) bool {
+			return seg.IsOnHost(conn.Hostname) && seg.IsPrimary()
+		})
+
+		if len(primaries) == 0 {
+			return nil
+		}
+		sort.Sort(primaries)
+
+		req := &idl.SnapshotDirectoriesRequest{
+			Action:    action,
+			Settings:  settings.Proto(),
+			BackupDir: backupDirs.AgentHostsToBackupDir[conn.Hostname],
+		}
+
+		for _, primary := range primaries {
+			req.Directories = append(req.Directories, segmentSnapshotDirectories(source, primary)...)
+		}
+
+		_, err := conn.AgentClient.SnapshotDirectories(context.Background(), req)
+		return err
+	}
+
+	var err error
+	err = errorlist.Append(err, ExecuteRPC(agentConns, request))
+	err = errorlist.Append(err, <-errs)
+
+	return err
+}
+
+// RestoreSnapshotActions returns the directories restored from their
+// snapshot by SnapshotSourceCluster.
+func RestoreSnapshotActions(source *greenplum.Cluster) []*idl.RevertAction {
+	var actions []*idl.RevertAction
+	for host, dirs := range SnapshotDirectories(source) {
+		for _, dir := range dirs {
+			actions = append(actions, &idl.RevertAction{
+				Type:   idl.RevertAction_restore_snapshot,
+				Host:   host,
+				Path:   dir.GetPath(),
+				Source: dir.GetName(),
+			})
+		}
+	}
+
+	return actions
+}
@@ -0,0 +1,210 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub_test
+
+import (
+	"errors"
+	"reflect"
+	"sort"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+
+	"github.com/greenplum-db/gpupgrade/config/backupdir"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/hub"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/idl/mock_idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/upgrade/snapshot"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+func snapshotCluster(t *testing.T) *greenplum.Cluster {
+	cluster := hub.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "coordinator", DataDir: "/data/qddir/seg-1", Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg0", Role: greenplum.PrimaryRole},
+		{DbID: 3, ContentID: 1, Hostname: "sdw1", DataDir: "/data/dbfast2/seg1", Role: greenplum.PrimaryRole},
+		{DbID: 4, ContentID: 2, Hostname: "sdw2", DataDir: "/data/dbfast3/seg2", Role: greenplum.PrimaryRole},
+	})
+
+	cluster.Tablespaces = greenplum.Tablespaces{
+		1: {
+			1663:  {Location: "/data/qddir/seg-1/base", UserDefined: false},
+			16384: {Location: "/tablespace/1/16384", UserDefined: true},
+		},
+		2: {
+			16384: {Location: "/tablespace/2/16384", UserDefined: true},
+		},
+	}
+
+	return cluster
+}
+
+func TestSnapshotDirectories(t *testing.T) {
+	hostDirs := hub.SnapshotDirectories(snapshotCluster(t))
+
+	expected := map[string][]*idl.SnapshotDirectory{
+		"coordinator": {
+			{Path: "/data/qddir/seg-1", Name: "datadir-1"},
+			{Path: "/tablespace/1/16384", Name: "tablespace-1-16384"},
+		},
+		"sdw1": {
+			{Path: "/data/dbfast1/seg0", Name: "datadir-2"},
+			{Path: "/tablespace/2/16384", Name: "tablespace-2-16384"},
+			{Path: "/data/dbfast2/seg1", Name: "datadir-3"},
+		},
+		"sdw2": {
+			{Path: "/data/dbfast3/seg2", Name: "datadir-4"},
+		},
+	}
+
+	if !reflect.DeepEqual(hostDirs, expected) {
+		t.Errorf("got %v want %v", hostDirs, expected)
+	}
+}
+
+func TestSnapshotSourceCluster(t *testing.T) {
+	settings := snapshot.Settings{Provider: snapshot.CommandProvider, SnapshotCommand: "snap", RestoreCommand: "restore"}
+	backupDirs := backupdir.BackupDirs{
+		CoordinatorBackupDir:  "/data/.gpupgrade",
+		AgentHostsToBackupDir: backupdir.AgentHostsToBackupDir{"sdw1": "/data1/.gpupgrade", "sdw2": "/data2/.gpupgrade"},
+	}
+
+	t.Run("snapshots the coordinator locally and the primaries on their hosts", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var commands int
+		snapshot.SetBashCommand(exectest.NewCommandWithVerifier(exectest.Success, func(string, ...string) {
+			commands++
+		}))
+		defer snapshot.ResetBashCommand()
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().SnapshotDirectories(
+			gomock.Any(),
+			&idl.SnapshotDirectoriesRequest{
+				Action:    idl.SnapshotDirectoriesRequest_snapshot,
+				Settings:  settings.Proto(),
+				BackupDir: "/data1/.gpupgrade",
+				Directories: []*idl.SnapshotDirectory{
+					{Path: "/data/dbfast1/seg0", Name: "datadir-2"},
+					{Path: "/tablespace/2/16384", Name: "tablespace-2-16384"},
+					{Path: "/data/dbfast2/seg1", Name: "datadir-3"},
+				},
+			},
+		).Return(&idl.SnapshotDirectoriesReply{}, nil)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().SnapshotDirectories(
+			gomock.Any(),
+			&idl.SnapshotDirectoriesRequest{
+				Action:      idl.SnapshotDirectoriesRequest_snapshot,
+				Settings:    settings.Proto(),
+				BackupDir:   "/data2/.gpupgrade",
+				Directories: []*idl.SnapshotDirectory{{Path: "/data/dbfast3/seg2", Name: "datadir-4"}},
+			},
+		).Return(&idl.SnapshotDirectoriesReply{}, nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		err := hub.SnapshotSourceCluster(step.DevNullStream, agentConns, snapshotCluster(t), settings, backupDirs, idl.SnapshotDirectoriesRequest_snapshot)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		// the coordinator data directory and its tablespace
+		if commands != 2 {
+			t.Errorf("got %d snapshot commands want 2", commands)
+		}
+	})
+
+	t.Run("restores only the segments of the source cluster", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		snapshot.SetBashCommand(exectest.NewCommandWithVerifier(exectest.Success, func(string, ...string) {
+			t.Errorf("expected the coordinator to not be restored")
+		}))
+		defer snapshot.ResetBashCommand()
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().SnapshotDirectories(gomock.Any(), gomock.Any()).Times(0)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().SnapshotDirectories(
+			gomock.Any(),
+			&idl.SnapshotDirectoriesRequest{
+				Action:      idl.SnapshotDirectoriesRequest_restore,
+				Settings:    settings.Proto(),
+				BackupDir:   "/data2/.gpupgrade",
+				Directories: []*idl.SnapshotDirectory{{Path: "/data/dbfast3/seg2", Name: "datadir-4"}},
+			},
+		).Return(&idl.SnapshotDirectoriesReply{}, nil)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		source := snapshotCluster(t).WithContents(2)
+		err := hub.SnapshotSourceCluster(step.DevNullStream, agentConns, source, settings, backupDirs, idl.SnapshotDirectoriesRequest_restore)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+	})
+
+	t.Run("returns errors from the coordinator and agents", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		snapshot.SetBashCommand(exectest.NewCommand(exectest.Failure))
+		defer snapshot.ResetBashCommand()
+
+		expected := errors.New("permission denied")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().SnapshotDirectories(gomock.Any(), gomock.Any()).Return(nil, expected)
+
+		agentConns := []*idl.Connection{{AgentClient: sdw1, Hostname: "sdw1"}}
+
+		err := hub.SnapshotSourceCluster(step.DevNullStream, agentConns, snapshotCluster(t), settings, backupDirs, idl.SnapshotDirectoriesRequest_snapshot)
+
+		var errs errorlist.Errors
+		if !errors.As(err, &errs) {
+			t.Fatalf("got error %#v want type %T", err, errs)
+		}
+
+		// the agent error followed by the coordinator data directory and
+		// tablespace errors
+		if len(errs) != 3 {
+			t.Fatalf("got %d errors want 3", len(errs))
+		}
+
+		if !errors.Is(errs[0], expected) {
+			t.Errorf("got error %#v want %#v", errs[0], expected)
+		}
+	})
+}
+
+func TestRestoreSnapshotActions(t *testing.T) {
+	actions := hub.RestoreSnapshotActions(snapshotCluster(t).WithContents(-1, 2))
+	sort.Slice(actions, func(i, j int) bool {
+		return actions[i].GetPath() < actions[j].GetPath()
+	})
+
+	expected := []*idl.RevertAction{
+		{Type: idl.RevertAction_restore_snapshot, Host: "sdw2", Path: "/data/dbfast3/seg2", Source: "datadir-4"},
+		{Type: idl.RevertAction_restore_snapshot, Host: "coordinator", Path: "/data/qddir/seg-1", Source: "datadir-1"},
+		{Type: idl.RevertAction_restore_snapshot, Host: "coordinator", Path: "/tablespace/1/16384", Source: "tablespace-1-16384"},
+	}
+
+	if !reflect.DeepEqual(actions, expected) {
+		t.Errorf("got %v want %v", actions, expected)
+	}
+}
@@ -4,6 +4,7 @@
 package hub
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -14,6 +15,7 @@ import (
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/tracing"
 	"github.com/greenplum-db/gpupgrade/upgrade"
 	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
@@ -58,7 +60,11 @@ func UpgradeCoordinator(streams step.OutStreams, backupDir string, pgUpgradeVerb
 		return err
 	}
 
+	done := trackProcesses(intermediate.Coordinator().Hostname, pgUpgradeProcess, 1)
+	_, span := tracing.Start(context.Background(), "pg_upgrade", tracing.KindInternal, tracing.Int(tracing.ContentIDKey, intermediate.Coordinator().ContentID))
 	err = upgrade.Run(streams.Stdout(), streams.Stderr(), opts)
+	span.End(err)
+	done()
 	if err != nil {
 		if opts.Action != idl.PgOptions_check {
 			return xerrors.Errorf("%s master: %v", action, err)
@@ -6,17 +6,80 @@ package hub
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
 
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/utils"
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+	"github.com/greenplum-db/gpupgrade/utils/schema"
 )
 
-func UpgradeMirrorsUsingRsync(agentConns []*idl.Connection, source *greenplum.Cluster, intermediate *greenplum.Cluster, useHbaHostnames bool) error {
+// RsyncedMirrorsFileName records the content IDs of the mirror data
+// directories rsynced so far such that re-running finalize after a failure
+// skips them.
+const RsyncedMirrorsFileName = "rsynced_mirrors.json"
+
+// RsyncedMirrorsSchema is the versioned format of rsynced_mirrors.json.
+var RsyncedMirrorsSchema = schema.Schema{
+	File: RsyncedMirrorsFileName,
+	Migrations: []schema.Migration{
+		schema.AddVersion,
+	},
+}
+
+type rsyncedMirrors struct {
+	SchemaVersion int
+	ContentIDs    []int
+}
+
+// rsyncAttempts is the number of times a mirror data directory is rsynced
+// before it is reported as failed.
+const rsyncAttempts = 3
+
+const defaultRsyncRetryDelay = 5 * time.Second
+
+var rsyncRetryDelay = defaultRsyncRetryDelay
+
+// XXX: for internal testing only
+func SetRsyncRetryDelay(delay time.Duration) {
+	rsyncRetryDelay = delay
+}
+
+func ResetRsyncRetryDelay() {
+	rsyncRetryDelay = defaultRsyncRetryDelay
+}
+
+// MirrorRsyncError is returned for a mirror data directory that failed to
+// rsync after all attempts.
+type MirrorRsyncError struct {
+	ContentID int
+	Hostname  string
+	Err       error
+}
+
+func (e MirrorRsyncError) Error() string {
+	return fmt.Sprintf("content %d on host %q: %v", e.ContentID, e.Hostname, e.Err)
+}
+
+func (e MirrorRsyncError) Unwrap() error {
+	return e.Err
+}
+
+func UpgradeMirrorsUsingRsync(streams step.OutStreams, agentConns []*idl.Connection, source *greenplum.Cluster, intermediate *greenplum.Cluster, useHbaHostnames bool, jobs uint) error {
 	db, err := sql.Open("pgx", intermediate.Connection())
 	if err != nil {
 		return err
@@ -35,7 +98,7 @@ func UpgradeMirrorsUsingRsync(agentConns []*idl.Connection, source *greenplum.Cl
 		return err
 	}
 
-	if err := RsyncMirrorDataDirsOnSegments(agentConns, source, intermediate); err != nil {
+	if err := RsyncMirrorDataDirsOnSegments(streams, agentConns, source, intermediate, jobs); err != nil {
 		return err
 	}
 
@@ -78,14 +141,40 @@ func UpgradeMirrorsUsingRsync(agentConns []*idl.Connection, source *greenplum.Cl
 	return nil
 }
 
-func RsyncMirrorDataDirsOnSegments(agentConns []*idl.Connection, source *greenplum.Cluster, intermediate *greenplum.Cluster) error {
+// RsyncMirrorDataDirsOnSegments rsyncs each mirror data directory from its
+// primary host. Each mirror is rsynced with its own request such that up to
+// jobs mirrors are rsynced in parallel on each host, and a failed mirror is
+// retried without re-syncing the others. Zero jobs rsyncs every mirror on a
+// host in parallel. Mirrors that fail all attempts are reported by content ID.
+// Mirrors that were rsynced are recorded in the state directory and skipped
+// when finalize is re-run.
+func RsyncMirrorDataDirsOnSegments(streams step.OutStreams, agentConns []*idl.Connection, source *greenplum.Cluster, intermediate *greenplum.Cluster, jobs uint) error {
+	progress, err := newRsyncProgress(streams, filepath.Join(utils.GetStateDir(), RsyncedMirrorsFileName))
+	if err != nil {
+		return err
+	}
+
+	for _, conn := range agentConns {
+		progress.total += len(primariesOnHost(source, conn.Hostname))
+	}
+
 	request := func(conn *idl.Connection) error {
-		sourcePrimaries := source.SelectSegments(func(seg *greenplum.SegConfig) bool {
-			return seg.IsOnHost(conn.Hostname) && !seg.IsCoordinator() && seg.IsPrimary()
-		})
+		sourcePrimaries := primariesOnHost(source, conn.Hostname)
+
+		limit := int(jobs)
+		if limit == 0 {
+			limit = len(sourcePrimaries)
+		}
+
+		var wg sync.WaitGroup
+		sem := make(chan struct{}, limit)
+		errs := make(chan error, len(sourcePrimaries))
 
-		var opts []*idl.RsyncRequest_RsyncOptions
 		for _, sourcePrimary := range sourcePrimaries {
+			if progress.skip(sourcePrimary.ContentID, intermediate.Mirrors[sourcePrimary.ContentID].Hostname) {
+				continue
+			}
+
 			intermediatePrimary := intermediate.Primaries[sourcePrimary.ContentID]
 			intermediateMirror := intermediate.Mirrors[sourcePrimary.ContentID]
 
@@ -98,15 +187,187 @@ func RsyncMirrorDataDirsOnSegments(agentConns []*idl.Connection, source *greenpl
 				Options:         []string{"--archive", "--delete", "--hard-links", "--size-only", "--no-inc-recursive"},
 			}
 
-			opts = append(opts, opt)
+			contentID := sourcePrimary.ContentID
+
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+
+				sem <- struct{}{}
+				defer func() { <-sem }()
+
+				err := rsyncMirrorDataDir(conn, opt, contentID, progress)
+				if err != nil {
+					errs <- MirrorRsyncError{ContentID: contentID, Hostname: opt.GetDestinationHost(), Err: err}
+				}
+			}()
+		}
+
+		wg.Wait()
+		close(errs)
+
+		var err error
+		for e := range errs {
+			err = errorlist.Append(err, e)
 		}
 
-		req := &idl.RsyncRequest{Options: opts}
-		_, err := conn.AgentClient.RsyncDataDirectories(context.Background(), req)
 		return err
 	}
 
-	return ExecuteRPC(agentConns, request)
+	err = ExecuteRPC(agentConns, request)
+	if err != nil {
+		return xerrors.Errorf("rsync mirror data directories for content IDs %s: %w", failedContentIDs(err), err)
+	}
+
+	return nil
+}
+
+func rsyncMirrorDataDir(conn *idl.Connection, opt *idl.RsyncRequest_RsyncOptions, contentID int, progress *rsyncProgress) error {
+	var err error
+	for attempt := 1; attempt <= rsyncAttempts; attempt++ {
+		if attempt > 1 {
+			progress.printf("Retrying rsync of mirror content %d to host %q (attempt %d of %d) after error: %v\n", contentID, opt.GetDestinationHost(), attempt, rsyncAttempts, err)
+			time.Sleep(rsyncRetryDelay)
+		}
+
+		_, err = conn.AgentClient.RsyncDataDirectories(context.Background(), &idl.RsyncRequest{Options: []*idl.RsyncRequest_RsyncOptions{opt}})
+		if err == nil {
+			return progress.done(contentID, opt.GetDestinationHost())
+		}
+	}
+
+	return err
+}
+
+// rsyncProgress reports and records the mirrors rsynced so far. It is safe to
+// use concurrently.
+type rsyncProgress struct {
+	mutex     sync.Mutex
+	streams   step.OutStreams
+	path      string
+	rsynced   map[int]bool
+	completed int
+	total     int
+}
+
+func newRsyncProgress(streams step.OutStreams, path string) (*rsyncProgress, error) {
+	progress := &rsyncProgress{streams: streams, path: path, rsynced: make(map[int]bool)}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return progress, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = RsyncedMirrorsSchema.Migrate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var mirrors rsyncedMirrors
+	err = json.Unmarshal(data, &mirrors)
+	if err != nil {
+		return nil, xerrors.Errorf("read %q: %w", path, err)
+	}
+
+	for _, contentID := range mirrors.ContentIDs {
+		progress.rsynced[contentID] = true
+	}
+
+	return progress, nil
+}
+
+// skip returns whether the mirror was rsynced by a previous run.
+func (p *rsyncProgress) skip(contentID int, hostname string) bool {
+	p.mutex.Lock()
+	if !p.rsynced[contentID] {
+		p.mutex.Unlock()
+		return false
+	}
+
+	p.completed++
+	completed := p.completed
+	p.mutex.Unlock()
+
+	p.printf("Skipping mirror content %d on host %q which was already rsynced (%d of %d)\n", contentID, hostname, completed, p.total)
+	return true
+}
+
+func (p *rsyncProgress) done(contentID int, hostname string) error {
+	p.mutex.Lock()
+	p.completed++
+	completed := p.completed
+	err := p.record(contentID)
+	p.mutex.Unlock()
+
+	if err != nil {
+		return xerrors.Errorf("record rsynced mirror content %d: %w", contentID, err)
+	}
+
+	p.printf("Rsynced mirror content %d to host %q (%d of %d)\n", contentID, hostname, completed, p.total)
+	return nil
+}
+
+// record must be called with the mutex held.
+func (p *rsyncProgress) record(contentID int) error {
+	p.rsynced[contentID] = true
+
+	mirrors := rsyncedMirrors{SchemaVersion: RsyncedMirrorsSchema.Version()}
+	for id := range p.rsynced {
+		mirrors.ContentIDs = append(mirrors.ContentIDs, id)
+	}
+	sort.Ints(mirrors.ContentIDs)
+
+	data, err := json.MarshalIndent(mirrors, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return utils.AtomicallyWrite(p.path, data)
+}
+
+func (p *rsyncProgress) printf(format string, args ...any) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	msg := fmt.Sprintf(format, args...)
+	log.Print(msg)
+	fmt.Fprint(p.streams.Stdout(), msg)
+}
+
+func primariesOnHost(cluster *greenplum.Cluster, hostname string) greenplum.SegConfigs {
+	return cluster.SelectSegments(func(seg *greenplum.SegConfig) bool {
+		return seg.IsOnHost(hostname) && !seg.IsCoordinator() && seg.IsPrimary()
+	})
+}
+
+// failedContentIDs returns the sorted content IDs of the mirrors that failed
+// to rsync.
+func failedContentIDs(err error) string {
+	var errs errorlist.Errors
+	if !errors.As(err, &errs) {
+		errs = errorlist.Errors{err}
+	}
+
+	var contents []int
+	for _, e := range errs {
+		var rsyncErr MirrorRsyncError
+		if errors.As(e, &rsyncErr) {
+			contents = append(contents, rsyncErr.ContentID)
+		}
+	}
+
+	sort.Ints(contents)
+
+	var ids []string
+	for _, content := range contents {
+		ids = append(ids, strconv.Itoa(content))
+	}
+
+	return strings.Join(ids, ", ")
 }
 
 func RsyncMirrorTablespacesOnSegments(agentConns []*idl.Connection, source *greenplum.Cluster, intermediate *greenplum.Cluster) error {
@@ -4,20 +4,40 @@
 package hub_test
 
 import (
+	"context"
 	"errors"
+	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
+	"google.golang.org/grpc"
 
 	"github.com/greenplum-db/gpupgrade/greenplum"
 	"github.com/greenplum-db/gpupgrade/hub"
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/idl/mock_idl"
+	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/testutils"
 	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+	"github.com/greenplum-db/gpupgrade/utils/schema"
 )
 
 func TestRsyncMirrorDataDirsOnSegments(t *testing.T) {
+	hub.SetRsyncRetryDelay(0)
+	defer hub.ResetRsyncRetryDelay()
+
+	stateDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, stateDir)
+
+	resetEnv := testutils.SetEnv(t, "GPUPGRADE_HOME", stateDir)
+	defer resetEnv()
+
+	rsyncedMirrors := filepath.Join(stateDir, hub.RsyncedMirrorsFileName)
+
 	source := hub.MustCreateCluster(t, greenplum.SegConfigs{
 		{DbID: 1, ContentID: -1, Hostname: "coordinator", DataDir: "/data/qddir/seg-1", Port: 15432, Role: greenplum.PrimaryRole},
 		{DbID: 2, ContentID: -1, Hostname: "standby", DataDir: "/data/standby", Port: 16432, Role: greenplum.MirrorRole},
@@ -37,6 +57,8 @@ func TestRsyncMirrorDataDirsOnSegments(t *testing.T) {
 	})
 
 	t.Run("succeeds", func(t *testing.T) {
+		defer testutils.MustRemoveAll(t, rsyncedMirrors)
+
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -73,13 +95,15 @@ func TestRsyncMirrorDataDirsOnSegments(t *testing.T) {
 			{AgentClient: sdw2, Hostname: "sdw2"},
 		}
 
-		err := hub.RsyncMirrorDataDirsOnSegments(agentConns, intermediate, source)
+		err := hub.RsyncMirrorDataDirsOnSegments(step.DevNullStream, agentConns, intermediate, source, 4)
 		if err != nil {
 			t.Errorf("unexpected err %#v", err)
 		}
 	})
 
 	t.Run("returns errors when failing on segments", func(t *testing.T) {
+		defer testutils.MustRemoveAll(t, rsyncedMirrors)
+
 		ctrl := gomock.NewController(t)
 		defer ctrl.Finish()
 
@@ -88,20 +112,20 @@ func TestRsyncMirrorDataDirsOnSegments(t *testing.T) {
 		sdw1.EXPECT().RsyncDataDirectories(
 			gomock.Any(),
 			gomock.Any(),
-		).Return(nil, expected)
+		).Return(nil, expected).Times(3)
 
 		sdw2 := mock_idl.NewMockAgentClient(ctrl)
 		sdw2.EXPECT().RsyncDataDirectories(
 			gomock.Any(),
 			gomock.Any(),
-		).Return(nil, expected)
+		).Return(nil, expected).Times(3)
 
 		agentConns := []*idl.Connection{
 			{AgentClient: sdw1, Hostname: "sdw1"},
 			{AgentClient: sdw2, Hostname: "sdw2"},
 		}
 
-		err := hub.RsyncMirrorDataDirsOnSegments(agentConns, intermediate, source)
+		err := hub.RsyncMirrorDataDirsOnSegments(step.DevNullStream, agentConns, intermediate, source, 4)
 		var errs errorlist.Errors
 		if !errors.As(err, &errs) {
 			t.Fatalf("error %#v does not contain type %T", err, errs)
@@ -116,6 +140,215 @@ func TestRsyncMirrorDataDirsOnSegments(t *testing.T) {
 				t.Errorf("got error %#v, want %#v", err, expected)
 			}
 		}
+
+		if !strings.Contains(err.Error(), "for content IDs 0, 1:") {
+			t.Errorf("expected error %q to contain the failed content IDs", err.Error())
+		}
+	})
+
+	t.Run("retries a failed mirror and reports only the mirrors that fail every attempt", func(t *testing.T) {
+		defer testutils.MustRemoveAll(t, rsyncedMirrors)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := errors.New("connection reset")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		gomock.InOrder(
+			sdw1.EXPECT().RsyncDataDirectories(gomock.Any(), gomock.Any()).Return(nil, expected),
+			sdw1.EXPECT().RsyncDataDirectories(gomock.Any(), gomock.Any()).Return(&idl.RsyncReply{}, nil),
+		)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		sdw2.EXPECT().RsyncDataDirectories(gomock.Any(), gomock.Any()).Return(nil, expected).Times(3)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		streams := &step.BufferedStreams{}
+		err := hub.RsyncMirrorDataDirsOnSegments(streams, agentConns, intermediate, source, 4)
+
+		var rsyncErr hub.MirrorRsyncError
+		if !errors.As(err, &rsyncErr) {
+			t.Fatalf("got error %#v want type %T", err, rsyncErr)
+		}
+
+		if rsyncErr.ContentID != 1 || rsyncErr.Hostname != "sdw1" || !errors.Is(err, expected) {
+			t.Errorf("got %#v want content 1 on host sdw1", rsyncErr)
+		}
+
+		if !strings.Contains(err.Error(), "for content IDs 1:") {
+			t.Errorf("expected error %q to contain only content ID 1", err.Error())
+		}
+
+		for _, msg := range []string{
+			`Retrying rsync of mirror content 0 to host "sdw2" (attempt 2 of 3)`,
+			`Rsynced mirror content 0 to host "sdw2" (1 of 2)`,
+			`Retrying rsync of mirror content 1 to host "sdw1" (attempt 3 of 3)`,
+		} {
+			if !strings.Contains(streams.StdoutBuf.String(), msg) {
+				t.Errorf("expected stdout %q to contain %q", streams.StdoutBuf.String(), msg)
+			}
+		}
+	})
+
+	t.Run("limits the number of mirrors rsynced in parallel on each host", func(t *testing.T) {
+		defer testutils.MustRemoveAll(t, rsyncedMirrors)
+
+		source := hub.MustCreateCluster(t, greenplum.SegConfigs{
+			{DbID: 1, ContentID: -1, Hostname: "coordinator", DataDir: "/data/qddir/seg-1", Role: greenplum.PrimaryRole},
+			{DbID: 2, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg1", Role: greenplum.PrimaryRole},
+			{DbID: 3, ContentID: 0, Hostname: "sdw2", DataDir: "/data/dbfast_mirror1/seg1", Role: greenplum.MirrorRole},
+			{DbID: 4, ContentID: 1, Hostname: "sdw1", DataDir: "/data/dbfast2/seg2", Role: greenplum.PrimaryRole},
+			{DbID: 5, ContentID: 1, Hostname: "sdw2", DataDir: "/data/dbfast_mirror2/seg2", Role: greenplum.MirrorRole},
+			{DbID: 6, ContentID: 2, Hostname: "sdw1", DataDir: "/data/dbfast3/seg3", Role: greenplum.PrimaryRole},
+			{DbID: 7, ContentID: 2, Hostname: "sdw2", DataDir: "/data/dbfast_mirror3/seg3", Role: greenplum.MirrorRole},
+		})
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		var running, maxRunning int32
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().RsyncDataDirectories(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, req *idl.RsyncRequest, opts ...grpc.CallOption) (*idl.RsyncReply, error) {
+				if len(req.GetOptions()) != 1 {
+					t.Errorf("got %d rsync options want 1 per request", len(req.GetOptions()))
+				}
+
+				n := atomic.AddInt32(&running, 1)
+				defer atomic.AddInt32(&running, -1)
+
+				for {
+					max := atomic.LoadInt32(&maxRunning)
+					if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+						break
+					}
+				}
+
+				time.Sleep(10 * time.Millisecond)
+				return &idl.RsyncReply{}, nil
+			}).Times(3)
+
+		agentConns := []*idl.Connection{{AgentClient: sdw1, Hostname: "sdw1"}}
+
+		err := hub.RsyncMirrorDataDirsOnSegments(step.DevNullStream, agentConns, source, source, 2)
+		if err != nil {
+			t.Errorf("unexpected err %#v", err)
+		}
+
+		if maxRunning > 2 {
+			t.Errorf("got %d mirrors rsynced in parallel want at most 2", maxRunning)
+		}
+	})
+
+	t.Run("rsyncs every mirror on a host in parallel when jobs is 0", func(t *testing.T) {
+		defer testutils.MustRemoveAll(t, rsyncedMirrors)
+
+		source := hub.MustCreateCluster(t, greenplum.SegConfigs{
+			{DbID: 1, ContentID: -1, Hostname: "coordinator", DataDir: "/data/qddir/seg-1", Role: greenplum.PrimaryRole},
+			{DbID: 2, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg1", Role: greenplum.PrimaryRole},
+			{DbID: 3, ContentID: 0, Hostname: "sdw2", DataDir: "/data/dbfast_mirror1/seg1", Role: greenplum.MirrorRole},
+			{DbID: 4, ContentID: 1, Hostname: "sdw1", DataDir: "/data/dbfast2/seg2", Role: greenplum.PrimaryRole},
+			{DbID: 5, ContentID: 1, Hostname: "sdw2", DataDir: "/data/dbfast_mirror2/seg2", Role: greenplum.MirrorRole},
+			{DbID: 6, ContentID: 2, Hostname: "sdw1", DataDir: "/data/dbfast3/seg3", Role: greenplum.PrimaryRole},
+			{DbID: 7, ContentID: 2, Hostname: "sdw2", DataDir: "/data/dbfast_mirror3/seg3", Role: greenplum.MirrorRole},
+		})
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		// Each rsync waits for the others such that the mirrors must be
+		// rsynced at the same time to succeed.
+		var started sync.WaitGroup
+		started.Add(3)
+
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().RsyncDataDirectories(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, req *idl.RsyncRequest, opts ...grpc.CallOption) (*idl.RsyncReply, error) {
+				started.Done()
+
+				waited := make(chan struct{})
+				go func() {
+					started.Wait()
+					close(waited)
+				}()
+
+				select {
+				case <-waited:
+					return &idl.RsyncReply{}, nil
+				case <-time.After(5 * time.Second):
+					return nil, errors.New("mirrors were not rsynced in parallel")
+				}
+			}).Times(3)
+
+		agentConns := []*idl.Connection{{AgentClient: sdw1, Hostname: "sdw1"}}
+
+		err := hub.RsyncMirrorDataDirsOnSegments(step.DevNullStream, agentConns, source, source, 0)
+		if err != nil {
+			t.Errorf("unexpected err %#v", err)
+		}
+	})
+
+	t.Run("records the rsynced mirrors such that a retry only rsyncs the failed mirrors", func(t *testing.T) {
+		defer testutils.MustRemoveAll(t, rsyncedMirrors)
+
+		ctrl := gomock.NewController(t)
+		defer ctrl.Finish()
+
+		expected := errors.New("connection reset")
+		sdw1 := mock_idl.NewMockAgentClient(ctrl)
+		sdw1.EXPECT().RsyncDataDirectories(gomock.Any(), gomock.Any()).Return(&idl.RsyncReply{}, nil).Times(1)
+
+		sdw2 := mock_idl.NewMockAgentClient(ctrl)
+		gomock.InOrder(
+			sdw2.EXPECT().RsyncDataDirectories(gomock.Any(), gomock.Any()).Return(nil, expected).Times(3),
+			sdw2.EXPECT().RsyncDataDirectories(gomock.Any(), gomock.Any()).Return(&idl.RsyncReply{}, nil),
+		)
+
+		agentConns := []*idl.Connection{
+			{AgentClient: sdw1, Hostname: "sdw1"},
+			{AgentClient: sdw2, Hostname: "sdw2"},
+		}
+
+		err := hub.RsyncMirrorDataDirsOnSegments(step.DevNullStream, agentConns, intermediate, source, 4)
+		if !errors.Is(err, expected) {
+			t.Fatalf("got error %#v want %#v", err, expected)
+		}
+
+		contents := testutils.MustReadFile(t, rsyncedMirrors)
+		expectedContents := "{\n  \"SchemaVersion\": 1,\n  \"ContentIDs\": [\n    0\n  ]\n}"
+		if contents != expectedContents {
+			t.Errorf("got %q want %q", contents, expectedContents)
+		}
+
+		streams := &step.BufferedStreams{}
+		err = hub.RsyncMirrorDataDirsOnSegments(streams, agentConns, intermediate, source, 4)
+		if err != nil {
+			t.Fatalf("unexpected err %#v", err)
+		}
+
+		for _, msg := range []string{
+			`Skipping mirror content 0 on host "sdw2" which was already rsynced`,
+			`Rsynced mirror content 1 to host "sdw1"`,
+		} {
+			if !strings.Contains(streams.StdoutBuf.String(), msg) {
+				t.Errorf("expected stdout %q to contain %q", streams.StdoutBuf.String(), msg)
+			}
+		}
+	})
+
+	t.Run("errors when the rsynced mirrors are from a newer gpupgrade", func(t *testing.T) {
+		defer testutils.MustRemoveAll(t, rsyncedMirrors)
+
+		testutils.MustWriteToFile(t, rsyncedMirrors, `{"SchemaVersion": 100, "ContentIDs": [0]}`)
+
+		err := hub.RsyncMirrorDataDirsOnSegments(step.DevNullStream, nil, intermediate, source, 4)
+		if !errors.Is(err, schema.ErrDowngrade) {
+			t.Errorf("got error %#v want %#v", err, schema.ErrDowngrade)
+		}
 	})
 }
 
@@ -15,7 +15,7 @@ import (
 	"github.com/greenplum-db/gpupgrade/idl"
 )
 
-func UpgradePrimaries(agentConns []*idl.Connection, agentHostToBackupDir backupdir.AgentHostsToBackupDir, pgUpgradeVerbose bool, skipPgUpgradeChecks bool, pgUpgradeJobs uint, source *greenplum.Cluster, intermediate *greenplum.Cluster, action idl.PgOptions_Action, mode idl.Mode, pgUpgradeTimestamp string) error {
+func UpgradePrimaries(agentConns []*idl.Connection, agentHostToBackupDir backupdir.AgentHostsToBackupDir, pgUpgradeVerbose bool, skipPgUpgradeChecks bool, pgUpgradeJobs map[string]uint, source *greenplum.Cluster, intermediate *greenplum.Cluster, action idl.PgOptions_Action, mode idl.Mode, pgUpgradeTimestamp string) error {
 	request := func(conn *idl.Connection) error {
 		intermediatePrimaries := intermediate.SelectSegments(func(seg *greenplum.SegConfig) bool {
 			return seg.IsOnHost(conn.Hostname) && seg.IsPrimary() && !seg.IsCoordinator()
@@ -29,7 +29,7 @@ func UpgradePrimaries(agentConns []*idl.Connection, agentHostToBackupDir backupd
 				BackupDir:           agentHostToBackupDir[conn.Hostname],
 				PgUpgradeVerbose:    pgUpgradeVerbose,
 				SkipPgUpgradeChecks: skipPgUpgradeChecks,
-				PgUpgradeJobs:       strconv.Itoa(int(pgUpgradeJobs)),
+				PgUpgradeJobs:       strconv.Itoa(int(pgUpgradeJobs[conn.Hostname])),
 				Action:              action,
 				Role:                intermediatePrimary.Role,
 				ContentID:           int32(intermediatePrimary.ContentID),
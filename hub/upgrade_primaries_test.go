@@ -183,7 +183,7 @@ func TestUpgradePrimaries(t *testing.T) {
 			{AgentClient: sdw2, Hostname: "sdw2"},
 		}
 
-		err := hub.UpgradePrimaries(agentConns, backupDirs.AgentHostsToBackupDir, true, true, 1, source, intermediate, idl.PgOptions_check, idl.Mode_copy, pgUpgradeTimestamp)
+		err := hub.UpgradePrimaries(agentConns, backupDirs.AgentHostsToBackupDir, true, true, map[string]uint{"sdw1": 1, "sdw2": 1}, source, intermediate, idl.PgOptions_check, idl.Mode_copy, pgUpgradeTimestamp)
 		if err != nil {
 			t.Errorf("unexpected err %#v", err)
 		}
@@ -229,7 +229,7 @@ func TestUpgradePrimaries(t *testing.T) {
 				{AgentClient: sdw2, Hostname: "sdw2"},
 			}
 
-			err := hub.UpgradePrimaries(agentConns, backupDirs.AgentHostsToBackupDir, false, false, 1, source, intermediate, c.Action, idl.Mode_link, pgUpgradeTimestamp)
+			err := hub.UpgradePrimaries(agentConns, backupDirs.AgentHostsToBackupDir, false, false, map[string]uint{"sdw1": 1, "sdw2": 1}, source, intermediate, c.Action, idl.Mode_link, pgUpgradeTimestamp)
 			var errs errorlist.Errors
 			if !errors.As(err, &errs) {
 				t.Fatalf("error %#v does not contain type %T", err, errs)
@@ -0,0 +1,88 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/report"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+// writeReport generates the upgrade report in the log archive directory. The
+// cluster is the resulting cluster of the upgrade, which is the source cluster
+// when reverting. Failing to write the report is only logged such that the
+// upgrade is not failed after the cluster has been finalized or reverted.
+func (s *Server) writeReport(outcome string, cluster *greenplum.Cluster, logArchiveDir string, archivedPaths []report.ArchivedPath) {
+	if err := s.writeReportToLogArchive(outcome, cluster, logArchiveDir, archivedPaths); err != nil {
+		log.Printf("failed to write upgrade report: %v", err)
+	}
+}
+
+func (s *Server) writeReportToLogArchive(outcome string, cluster *greenplum.Cluster, logArchiveDir string, archivedPaths []report.ArchivedPath) error {
+	usage, err := DiskUsage(s.agentConns, cluster)
+	if err != nil {
+		return xerrors.Errorf("disk usage: %w", err)
+	}
+
+	r, err := report.New(s.Config, outcome, cluster, utils.GetStateDir(), logArchiveDir, usage, archivedPaths)
+	if err != nil {
+		return err
+	}
+
+	paths, err := report.Write(logArchiveDir, r)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("wrote upgrade report to %s", strings.Join(paths, ", "))
+	return nil
+}
+
+// ArchivedDataDirectories returns the paths the source data directories were
+// archived to when finalizing. Each source data directory is renamed to the
+// data directory of its intermediate counterpart with the old suffix.
+func ArchivedDataDirectories(source *greenplum.Cluster, intermediate *greenplum.Cluster) []report.ArchivedPath {
+	segments := source.SelectSegments(func(seg *greenplum.SegConfig) bool {
+		return true
+	})
+	sort.Sort(segments)
+
+	var paths []report.ArchivedPath
+	for _, seg := range segments {
+		counterpart, ok := intermediate.Primaries[seg.ContentID]
+		if seg.Role == greenplum.MirrorRole {
+			counterpart, ok = intermediate.Mirrors[seg.ContentID]
+		}
+
+		if !ok {
+			continue
+		}
+
+		description := fmt.Sprintf("Source %s data directory for content %d on %s", segmentDescription(seg), seg.ContentID, seg.Hostname)
+		paths = append(paths, report.ArchivedPath{Description: description, Path: counterpart.DataDir + upgrade.OldSuffix})
+	}
+
+	return paths
+}
+
+func segmentDescription(seg greenplum.SegConfig) string {
+	switch {
+	case seg.IsCoordinator():
+		return "master"
+	case seg.IsStandby():
+		return "standby"
+	case seg.IsMirror():
+		return "mirror"
+	default:
+		return "primary"
+	}
+}
@@ -0,0 +1,41 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package hub_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/hub"
+	"github.com/greenplum-db/gpupgrade/report"
+)
+
+func TestArchivedDataDirectories(t *testing.T) {
+	source := hub.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "mdw", DataDir: "/data/qddir/seg-1", Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: -1, Hostname: "smdw", DataDir: "/data/standby", Role: greenplum.MirrorRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg0", Role: greenplum.PrimaryRole},
+		{DbID: 4, ContentID: 0, Hostname: "sdw2", DataDir: "/data/dbfast_mirror1/seg0", Role: greenplum.MirrorRole},
+	})
+
+	intermediate := hub.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "mdw", DataDir: "/data/qddir/seg.AAAAAAAAAAA.-1", Role: greenplum.PrimaryRole},
+		{DbID: 2, ContentID: -1, Hostname: "smdw", DataDir: "/data/standby.AAAAAAAAAAA", Role: greenplum.MirrorRole},
+		{DbID: 3, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg.AAAAAAAAAAA.0", Role: greenplum.PrimaryRole},
+		{DbID: 4, ContentID: 0, Hostname: "sdw2", DataDir: "/data/dbfast_mirror1/seg.AAAAAAAAAAA.0", Role: greenplum.MirrorRole},
+	})
+
+	paths := hub.ArchivedDataDirectories(source, intermediate)
+
+	expected := []report.ArchivedPath{
+		{Description: "Source master data directory for content -1 on mdw", Path: "/data/qddir/seg.AAAAAAAAAAA.-1.old"},
+		{Description: "Source standby data directory for content -1 on smdw", Path: "/data/standby.AAAAAAAAAAA.old"},
+		{Description: "Source primary data directory for content 0 on sdw1", Path: "/data/dbfast1/seg.AAAAAAAAAAA.0.old"},
+		{Description: "Source mirror data directory for content 0 on sdw2", Path: "/data/dbfast_mirror1/seg.AAAAAAAAAAA.0.old"},
+	}
+	if !reflect.DeepEqual(paths, expected) {
+		t.Errorf("got %v want %v", paths, expected)
+	}
+}
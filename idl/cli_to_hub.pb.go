@@ -32,6 +32,7 @@ const (
 	Step_finalize     Step = 3
 	Step_revert       Step = 4
 	Step_stats        Step = 5 // used for data migration script phase
+	Step_add_mirrors  Step = 6
 )
 
 // Enum value maps for Step.
@@ -43,6 +44,7 @@ var (
 		3: "finalize",
 		4: "revert",
 		5: "stats",
+		6: "add_mirrors",
 	}
 	Step_value = map[string]int32{
 		"unknown_step": 0,
@@ -51,6 +53,7 @@ var (
 		"finalize":     3,
 		"revert":       4,
 		"stats":        5,
+		"add_mirrors":  6,
 	}
 )
 
@@ -134,6 +137,11 @@ const (
 	Substep_verify_gpupgrade_is_installed_across_all_hosts                Substep = 47
 	Substep_initialize_wait_for_cluster_to_be_ready                       Substep = 48
 	Substep_wait_for_cluster_to_be_ready_before_upgrade_master            Substep = 49
+	Substep_snapshot_source_cluster                                       Substep = 50
+	Substep_delete_source_cluster_snapshot                                Substep = 51
+	Substep_verify_master_backup                                          Substep = 52
+	Substep_check_clone_support                                           Substep = 53
+	Substep_record_disk_usage                                             Substep = 54
 )
 
 // Enum value maps for Substep.
@@ -189,6 +197,11 @@ var (
 		47: "verify_gpupgrade_is_installed_across_all_hosts",
 		48: "initialize_wait_for_cluster_to_be_ready",
 		49: "wait_for_cluster_to_be_ready_before_upgrade_master",
+		50: "snapshot_source_cluster",
+		51: "delete_source_cluster_snapshot",
+		52: "verify_master_backup",
+		53: "check_clone_support",
+		54: "record_disk_usage",
 	}
 	Substep_value = map[string]int32{
 		"unknown_substep":                0,
@@ -241,6 +254,11 @@ var (
 		"verify_gpupgrade_is_installed_across_all_hosts":                47,
 		"initialize_wait_for_cluster_to_be_ready":                       48,
 		"wait_for_cluster_to_be_ready_before_upgrade_master":            49,
+		"snapshot_source_cluster":                                       50,
+		"delete_source_cluster_snapshot":                                51,
+		"verify_master_backup":                                          52,
+		"check_clone_support":                                           53,
+		"record_disk_usage":                                             54,
 	}
 )
 
@@ -375,7 +393,7 @@ func (x Chunk_Type) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use Chunk_Type.Descriptor instead.
 func (Chunk_Type) EnumDescriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{12, 0}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{15, 0}
 }
 
 type InitializeRequest struct {
@@ -385,6 +403,9 @@ type InitializeRequest struct {
 
 	DiskFreeRatio    float64 `protobuf:"fixed64,1,opt,name=diskFreeRatio,proto3" json:"diskFreeRatio,omitempty"`
 	ParentBackupDirs string  `protobuf:"bytes,2,opt,name=parentBackupDirs,proto3" json:"parentBackupDirs,omitempty"`
+	// The estimated size of a full copy of the primaries on each host. It is
+	// checked in copy mode and for the rsync snapshot provider in link mode.
+	CopyModeDiskBytesByHost map[string]int64 `protobuf:"bytes,3,rep,name=copyModeDiskBytesByHost,proto3" json:"copyModeDiskBytesByHost,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *InitializeRequest) Reset() {
@@ -433,6 +454,13 @@ func (x *InitializeRequest) GetParentBackupDirs() string {
 	return ""
 }
 
+func (x *InitializeRequest) GetCopyModeDiskBytesByHost() map[string]int64 {
+	if x != nil {
+		return x.CopyModeDiskBytesByHost
+	}
+	return nil
+}
+
 type InitializeCreateClusterRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -563,6 +591,8 @@ type FinalizeRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	DeferMirrors bool `protobuf:"varint,1,opt,name=deferMirrors,proto3" json:"deferMirrors,omitempty"`
 }
 
 func (x *FinalizeRequest) Reset() {
@@ -597,10 +627,19 @@ func (*FinalizeRequest) Descriptor() ([]byte, []int) {
 	return file_cli_to_hub_proto_rawDescGZIP(), []int{3}
 }
 
+func (x *FinalizeRequest) GetDeferMirrors() bool {
+	if x != nil {
+		return x.DeferMirrors
+	}
+	return false
+}
+
 type RevertRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Partial bool `protobuf:"varint,1,opt,name=partial,proto3" json:"partial,omitempty"`
 }
 
 func (x *RevertRequest) Reset() {
@@ -635,6 +674,145 @@ func (*RevertRequest) Descriptor() ([]byte, []int) {
 	return file_cli_to_hub_proto_rawDescGZIP(), []int{4}
 }
 
+func (x *RevertRequest) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+type RevertPlanRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Partial bool `protobuf:"varint,1,opt,name=partial,proto3" json:"partial,omitempty"`
+}
+
+func (x *RevertPlanRequest) Reset() {
+	*x = RevertPlanRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_to_hub_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertPlanRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertPlanRequest) ProtoMessage() {}
+
+func (x *RevertPlanRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_to_hub_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertPlanRequest.ProtoReflect.Descriptor instead.
+func (*RevertPlanRequest) Descriptor() ([]byte, []int) {
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RevertPlanRequest) GetPartial() bool {
+	if x != nil {
+		return x.Partial
+	}
+	return false
+}
+
+type RevertPlanReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions []*RevertAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+}
+
+func (x *RevertPlanReply) Reset() {
+	*x = RevertPlanReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_to_hub_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevertPlanReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevertPlanReply) ProtoMessage() {}
+
+func (x *RevertPlanReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_to_hub_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevertPlanReply.ProtoReflect.Descriptor instead.
+func (*RevertPlanReply) Descriptor() ([]byte, []int) {
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RevertPlanReply) GetActions() []*RevertAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type AddMirrorsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *AddMirrorsRequest) Reset() {
+	*x = AddMirrorsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_to_hub_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddMirrorsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddMirrorsRequest) ProtoMessage() {}
+
+func (x *AddMirrorsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_to_hub_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddMirrorsRequest.ProtoReflect.Descriptor instead.
+func (*AddMirrorsRequest) Descriptor() ([]byte, []int) {
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{7}
+}
+
 type RestartAgentsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -644,7 +822,7 @@ type RestartAgentsRequest struct {
 func (x *RestartAgentsRequest) Reset() {
 	*x = RestartAgentsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[5]
+		mi := &file_cli_to_hub_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -657,7 +835,7 @@ func (x *RestartAgentsRequest) String() string {
 func (*RestartAgentsRequest) ProtoMessage() {}
 
 func (x *RestartAgentsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[5]
+	mi := &file_cli_to_hub_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -670,7 +848,7 @@ func (x *RestartAgentsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartAgentsRequest.ProtoReflect.Descriptor instead.
 func (*RestartAgentsRequest) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{5}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{8}
 }
 
 type RestartAgentsReply struct {
@@ -684,7 +862,7 @@ type RestartAgentsReply struct {
 func (x *RestartAgentsReply) Reset() {
 	*x = RestartAgentsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[6]
+		mi := &file_cli_to_hub_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -697,7 +875,7 @@ func (x *RestartAgentsReply) String() string {
 func (*RestartAgentsReply) ProtoMessage() {}
 
 func (x *RestartAgentsReply) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[6]
+	mi := &file_cli_to_hub_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -710,7 +888,7 @@ func (x *RestartAgentsReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RestartAgentsReply.ProtoReflect.Descriptor instead.
 func (*RestartAgentsReply) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{6}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *RestartAgentsReply) GetAgentHosts() []string {
@@ -729,7 +907,7 @@ type StopServicesRequest struct {
 func (x *StopServicesRequest) Reset() {
 	*x = StopServicesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[7]
+		mi := &file_cli_to_hub_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -742,7 +920,7 @@ func (x *StopServicesRequest) String() string {
 func (*StopServicesRequest) ProtoMessage() {}
 
 func (x *StopServicesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[7]
+	mi := &file_cli_to_hub_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -755,7 +933,7 @@ func (x *StopServicesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopServicesRequest.ProtoReflect.Descriptor instead.
 func (*StopServicesRequest) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{7}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{10}
 }
 
 type StopServicesReply struct {
@@ -767,7 +945,7 @@ type StopServicesReply struct {
 func (x *StopServicesReply) Reset() {
 	*x = StopServicesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[8]
+		mi := &file_cli_to_hub_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -780,7 +958,7 @@ func (x *StopServicesReply) String() string {
 func (*StopServicesReply) ProtoMessage() {}
 
 func (x *StopServicesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[8]
+	mi := &file_cli_to_hub_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -793,7 +971,7 @@ func (x *StopServicesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopServicesReply.ProtoReflect.Descriptor instead.
 func (*StopServicesReply) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{8}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{11}
 }
 
 type SubstepStatus struct {
@@ -808,7 +986,7 @@ type SubstepStatus struct {
 func (x *SubstepStatus) Reset() {
 	*x = SubstepStatus{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[9]
+		mi := &file_cli_to_hub_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -821,7 +999,7 @@ func (x *SubstepStatus) String() string {
 func (*SubstepStatus) ProtoMessage() {}
 
 func (x *SubstepStatus) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[9]
+	mi := &file_cli_to_hub_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +1012,7 @@ func (x *SubstepStatus) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SubstepStatus.ProtoReflect.Descriptor instead.
 func (*SubstepStatus) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{9}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *SubstepStatus) GetStep() Substep {
@@ -860,7 +1038,7 @@ type PrepareInitClusterRequest struct {
 func (x *PrepareInitClusterRequest) Reset() {
 	*x = PrepareInitClusterRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[10]
+		mi := &file_cli_to_hub_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -873,7 +1051,7 @@ func (x *PrepareInitClusterRequest) String() string {
 func (*PrepareInitClusterRequest) ProtoMessage() {}
 
 func (x *PrepareInitClusterRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[10]
+	mi := &file_cli_to_hub_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -886,7 +1064,7 @@ func (x *PrepareInitClusterRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareInitClusterRequest.ProtoReflect.Descriptor instead.
 func (*PrepareInitClusterRequest) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{10}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{13}
 }
 
 type PrepareInitClusterReply struct {
@@ -898,7 +1076,7 @@ type PrepareInitClusterReply struct {
 func (x *PrepareInitClusterReply) Reset() {
 	*x = PrepareInitClusterReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[11]
+		mi := &file_cli_to_hub_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -911,7 +1089,7 @@ func (x *PrepareInitClusterReply) String() string {
 func (*PrepareInitClusterReply) ProtoMessage() {}
 
 func (x *PrepareInitClusterReply) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[11]
+	mi := &file_cli_to_hub_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -924,7 +1102,7 @@ func (x *PrepareInitClusterReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use PrepareInitClusterReply.ProtoReflect.Descriptor instead.
 func (*PrepareInitClusterReply) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{11}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{14}
 }
 
 type Chunk struct {
@@ -939,7 +1117,7 @@ type Chunk struct {
 func (x *Chunk) Reset() {
 	*x = Chunk{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[12]
+		mi := &file_cli_to_hub_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -952,7 +1130,7 @@ func (x *Chunk) String() string {
 func (*Chunk) ProtoMessage() {}
 
 func (x *Chunk) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[12]
+	mi := &file_cli_to_hub_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -965,7 +1143,7 @@ func (x *Chunk) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Chunk.ProtoReflect.Descriptor instead.
 func (*Chunk) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{12}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *Chunk) GetBuffer() []byte {
@@ -998,7 +1176,7 @@ type Message struct {
 func (x *Message) Reset() {
 	*x = Message{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[13]
+		mi := &file_cli_to_hub_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1011,7 +1189,7 @@ func (x *Message) String() string {
 func (*Message) ProtoMessage() {}
 
 func (x *Message) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[13]
+	mi := &file_cli_to_hub_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1024,7 +1202,7 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Message.ProtoReflect.Descriptor instead.
 func (*Message) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{13}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{16}
 }
 
 func (m *Message) GetContents() isMessage_Contents {
@@ -1094,7 +1272,7 @@ type Response struct {
 func (x *Response) Reset() {
 	*x = Response{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[14]
+		mi := &file_cli_to_hub_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1107,7 +1285,7 @@ func (x *Response) String() string {
 func (*Response) ProtoMessage() {}
 
 func (x *Response) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[14]
+	mi := &file_cli_to_hub_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1120,7 +1298,7 @@ func (x *Response) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use Response.ProtoReflect.Descriptor instead.
 func (*Response) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{14}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{17}
 }
 
 func (m *Response) GetContents() isResponse_Contents {
@@ -1197,7 +1375,7 @@ type InitializeResponse struct {
 func (x *InitializeResponse) Reset() {
 	*x = InitializeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[15]
+		mi := &file_cli_to_hub_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1210,7 +1388,7 @@ func (x *InitializeResponse) String() string {
 func (*InitializeResponse) ProtoMessage() {}
 
 func (x *InitializeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[15]
+	mi := &file_cli_to_hub_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1223,7 +1401,7 @@ func (x *InitializeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use InitializeResponse.ProtoReflect.Descriptor instead.
 func (*InitializeResponse) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{15}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *InitializeResponse) GetHasAllMirrorsAndStandby() bool {
@@ -1244,7 +1422,7 @@ type ExecuteResponse struct {
 func (x *ExecuteResponse) Reset() {
 	*x = ExecuteResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[16]
+		mi := &file_cli_to_hub_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1257,7 +1435,7 @@ func (x *ExecuteResponse) String() string {
 func (*ExecuteResponse) ProtoMessage() {}
 
 func (x *ExecuteResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[16]
+	mi := &file_cli_to_hub_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1270,7 +1448,7 @@ func (x *ExecuteResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ExecuteResponse.ProtoReflect.Descriptor instead.
 func (*ExecuteResponse) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{16}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ExecuteResponse) GetIntermediate() []byte {
@@ -1289,12 +1467,13 @@ type FinalizeResponse struct {
 	LogArchiveDirectory                    string `protobuf:"bytes,2,opt,name=LogArchiveDirectory,proto3" json:"LogArchiveDirectory,omitempty"`
 	ArchivedSourceCoordinatorDataDirectory string `protobuf:"bytes,3,opt,name=ArchivedSourceCoordinatorDataDirectory,proto3" json:"ArchivedSourceCoordinatorDataDirectory,omitempty"`
 	UpgradeID                              string `protobuf:"bytes,4,opt,name=UpgradeID,proto3" json:"UpgradeID,omitempty"`
+	MirrorsDeferred                        bool   `protobuf:"varint,5,opt,name=MirrorsDeferred,proto3" json:"MirrorsDeferred,omitempty"`
 }
 
 func (x *FinalizeResponse) Reset() {
 	*x = FinalizeResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[17]
+		mi := &file_cli_to_hub_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1307,7 +1486,7 @@ func (x *FinalizeResponse) String() string {
 func (*FinalizeResponse) ProtoMessage() {}
 
 func (x *FinalizeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[17]
+	mi := &file_cli_to_hub_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,7 +1499,7 @@ func (x *FinalizeResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FinalizeResponse.ProtoReflect.Descriptor instead.
 func (*FinalizeResponse) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{17}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *FinalizeResponse) GetTarget() []byte {
@@ -1351,6 +1530,13 @@ func (x *FinalizeResponse) GetUpgradeID() string {
 	return ""
 }
 
+func (x *FinalizeResponse) GetMirrorsDeferred() bool {
+	if x != nil {
+		return x.MirrorsDeferred
+	}
+	return false
+}
+
 type RevertResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1363,7 +1549,7 @@ type RevertResponse struct {
 func (x *RevertResponse) Reset() {
 	*x = RevertResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[18]
+		mi := &file_cli_to_hub_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1376,7 +1562,7 @@ func (x *RevertResponse) String() string {
 func (*RevertResponse) ProtoMessage() {}
 
 func (x *RevertResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[18]
+	mi := &file_cli_to_hub_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1389,7 +1575,7 @@ func (x *RevertResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RevertResponse.ProtoReflect.Descriptor instead.
 func (*RevertResponse) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{18}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *RevertResponse) GetSource() []byte {
@@ -1417,7 +1603,7 @@ type GetConfigRequest struct {
 func (x *GetConfigRequest) Reset() {
 	*x = GetConfigRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[19]
+		mi := &file_cli_to_hub_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1430,7 +1616,7 @@ func (x *GetConfigRequest) String() string {
 func (*GetConfigRequest) ProtoMessage() {}
 
 func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[19]
+	mi := &file_cli_to_hub_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1443,7 +1629,7 @@ func (x *GetConfigRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigRequest.ProtoReflect.Descriptor instead.
 func (*GetConfigRequest) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{19}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *GetConfigRequest) GetName() string {
@@ -1464,7 +1650,7 @@ type GetConfigReply struct {
 func (x *GetConfigReply) Reset() {
 	*x = GetConfigReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[20]
+		mi := &file_cli_to_hub_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1477,7 +1663,7 @@ func (x *GetConfigReply) String() string {
 func (*GetConfigReply) ProtoMessage() {}
 
 func (x *GetConfigReply) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[20]
+	mi := &file_cli_to_hub_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1490,7 +1676,7 @@ func (x *GetConfigReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetConfigReply.ProtoReflect.Descriptor instead.
 func (*GetConfigReply) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{20}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *GetConfigReply) GetValue() string {
@@ -1500,6 +1686,99 @@ func (x *GetConfigReply) GetValue() string {
 	return ""
 }
 
+type SetConfigRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Name  string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value string `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *SetConfigRequest) Reset() {
+	*x = SetConfigRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_to_hub_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigRequest) ProtoMessage() {}
+
+func (x *SetConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_to_hub_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigRequest.ProtoReflect.Descriptor instead.
+func (*SetConfigRequest) Descriptor() ([]byte, []int) {
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetConfigRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetConfigRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+type SetConfigReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetConfigReply) Reset() {
+	*x = SetConfigReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cli_to_hub_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetConfigReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetConfigReply) ProtoMessage() {}
+
+func (x *SetConfigReply) ProtoReflect() protoreflect.Message {
+	mi := &file_cli_to_hub_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetConfigReply.ProtoReflect.Descriptor instead.
+func (*SetConfigReply) Descriptor() ([]byte, []int) {
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{25}
+}
+
 // Used to set the gRPC status details that the CLI converts to a NextActions
 // error type to be displayed to the user.
 type NextActions struct {
@@ -1513,7 +1792,7 @@ type NextActions struct {
 func (x *NextActions) Reset() {
 	*x = NextActions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cli_to_hub_proto_msgTypes[21]
+		mi := &file_cli_to_hub_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1526,7 +1805,7 @@ func (x *NextActions) String() string {
 func (*NextActions) ProtoMessage() {}
 
 func (x *NextActions) ProtoReflect() protoreflect.Message {
-	mi := &file_cli_to_hub_proto_msgTypes[21]
+	mi := &file_cli_to_hub_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1539,7 +1818,7 @@ func (x *NextActions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NextActions.ProtoReflect.Descriptor instead.
 func (*NextActions) Descriptor() ([]byte, []int) {
-	return file_cli_to_hub_proto_rawDescGZIP(), []int{21}
+	return file_cli_to_hub_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *NextActions) GetNextActions() string {
@@ -1553,269 +1832,322 @@ var File_cli_to_hub_proto protoreflect.FileDescriptor
 
 var file_cli_to_hub_proto_rawDesc = []byte{
 	0x0a, 0x10, 0x63, 0x6c, 0x69, 0x5f, 0x74, 0x6f, 0x5f, 0x68, 0x75, 0x62, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x12, 0x03, 0x69, 0x64, 0x6c, 0x22, 0x65, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d,
-	0x64, 0x69, 0x73, 0x6b, 0x46, 0x72, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x6b, 0x46, 0x72, 0x65, 0x65, 0x52, 0x61, 0x74,
-	0x69, 0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x63, 0x6b,
-	0x75, 0x70, 0x44, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61,
-	0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x73, 0x22, 0xae,
-	0x01, 0x0a, 0x1e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x2e, 0x0a, 0x12, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c, 0x69, 0x62, 0x72,
-	0x61, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64,
+	0x74, 0x6f, 0x12, 0x03, 0x69, 0x64, 0x6c, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xa0, 0x02, 0x0a, 0x11, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64,
+	0x69, 0x73, 0x6b, 0x46, 0x72, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x6b, 0x46, 0x72, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69,
+	0x6f, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x44, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x72,
+	0x65, 0x6e, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x73, 0x12, 0x6d, 0x0a,
+	0x17, 0x63, 0x6f, 0x70, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x79, 0x74,
+	0x65, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x33,
+	0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x70, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x44,
+	0x69, 0x73, 0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x17, 0x63, 0x6f, 0x70, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x69, 0x73,
+	0x6b, 0x42, 0x79, 0x74, 0x65, 0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x1a, 0x4a, 0x0a, 0x1c,
+	0x43, 0x6f, 0x70, 0x79, 0x4d, 0x6f, 0x64, 0x65, 0x44, 0x69, 0x73, 0x6b, 0x42, 0x79, 0x74, 0x65,
+	0x73, 0x42, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03,
+	0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14,
+	0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x05, 0x76,
+	0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0xae, 0x01, 0x0a, 0x1e, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x12, 0x64,
 	0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74,
-	0x68, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x65,
-	0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x67, 0x55,
-	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x30, 0x0a,
-	0x13, 0x73, 0x6b, 0x69, 0x70, 0x50, 0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68,
-	0x65, 0x63, 0x6b, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73, 0x6b, 0x69, 0x70,
-	0x50, 0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22,
-	0x9a, 0x01, 0x0a, 0x0e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56,
-	0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x67,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x30,
-	0x0a, 0x13, 0x73, 0x6b, 0x69, 0x70, 0x50, 0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73, 0x6b, 0x69,
-	0x70, 0x50, 0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73,
-	0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x44, 0x69, 0x72, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x72, 0x65,
-	0x6e, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x73, 0x22, 0x11, 0x0a, 0x0f,
-	0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x0f, 0x0a, 0x0d, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0x16, 0x0a, 0x14, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74,
-	0x61, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1e,
-	0x0a, 0x0a, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0a, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x15,
-	0x0a, 0x13, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x56, 0x0a, 0x0d, 0x53, 0x75,
-	0x62, 0x73, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x73,
-	0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
-	0x53, 0x75, 0x62, 0x73, 0x74, 0x65, 0x70, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x23, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e,
-	0x69, 0x64, 0x6c, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x22, 0x1b, 0x0a, 0x19, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x69,
-	0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22,
-	0x19, 0x0a, 0x17, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6c,
-	0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x71, 0x0a, 0x05, 0x43, 0x68,
-	0x75, 0x6e, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x74,
-	0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
-	0x43, 0x68, 0x75, 0x6e, 0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x22, 0x2b, 0x0a, 0x04, 0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x75, 0x6e, 0x6b, 0x6e,
-	0x6f, 0x77, 0x6e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x10,
-	0x01, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x10, 0x02, 0x22, 0x94, 0x01,
-	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x63, 0x68, 0x75,
-	0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43,
-	0x68, 0x75, 0x6e, 0x6b, 0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x2c, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x69, 0x64, 0x6c, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e,
-	0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74,
-	0x65, 0x6e, 0x74, 0x73, 0x22, 0xa7, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x49, 0x0a, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
-	0x69, 0x64, 0x6c, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0f,
-	0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x45, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x65,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43,
-	0x0a, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x46,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48,
-	0x00, 0x52, 0x10, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x64,
-	0x6c, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x48, 0x00, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4e,
-	0x0a, 0x12, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x17, 0x48, 0x61, 0x73, 0x41, 0x6c, 0x6c, 0x4d, 0x69,
-	0x72, 0x72, 0x6f, 0x72, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x48, 0x61, 0x73, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x41, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x22, 0x35,
-	0x0a, 0x0f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x74, 0x65, 0x22, 0xd2, 0x01, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67,
-	0x65, 0x74, 0x12, 0x30, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x13, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x12, 0x56, 0x0a, 0x26, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f,
-	0x72, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x26, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x44,
-	0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x22, 0x5a, 0x0a, 0x0e, 0x52, 0x65,
-	0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x26, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x26,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x2f, 0x0a, 0x0b, 0x4e, 0x65, 0x78, 0x74, 0x41, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x5a, 0x0a, 0x04, 0x53, 0x74, 0x65, 0x70, 0x12,
-	0x10, 0x0a, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x10,
-	0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x10,
-	0x01, 0x12, 0x0b, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x10, 0x02, 0x12, 0x0c,
-	0x0a, 0x08, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06,
-	0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x10, 0x04, 0x12, 0x09, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74,
-	0x73, 0x10, 0x05, 0x2a, 0xb4, 0x0c, 0x0a, 0x07, 0x53, 0x75, 0x62, 0x73, 0x74, 0x65, 0x70, 0x12,
-	0x13, 0x0a, 0x0f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x74,
-	0x65, 0x70, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x73, 0x61, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f,
-	0x68, 0x75, 0x62, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61,
-	0x67, 0x65, 0x6e, 0x74, 0x73, 0x10, 0x03, 0x12, 0x15, 0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b,
-	0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x10, 0x04, 0x12, 0x14,
-	0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x64, 0x69, 0x73, 0x6b, 0x5f, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
-	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0x06,
-	0x12, 0x17, 0x0a, 0x13, 0x69, 0x6e, 0x69, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x07, 0x12, 0x1b, 0x0a, 0x17, 0x73, 0x68, 0x75,
-	0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x10, 0x08, 0x12, 0x18, 0x0a, 0x14, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x10, 0x09,
-	0x12, 0x11, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x10, 0x0a, 0x12, 0x1b, 0x0a, 0x17, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x0b,
-	0x12, 0x12, 0x0a, 0x0e, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x74,
-	0x65, 0x72, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x63, 0x6f, 0x70, 0x79, 0x5f, 0x6d, 0x61, 0x73,
-	0x74, 0x65, 0x72, 0x10, 0x0d, 0x12, 0x15, 0x0a, 0x11, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x10, 0x0e, 0x12, 0x18, 0x0a, 0x14,
-	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x10, 0x0f, 0x12, 0x19, 0x0a, 0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x10,
-	0x10, 0x12, 0x1b, 0x0a, 0x17, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x10, 0x11, 0x12, 0x1c,
-	0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
-	0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65, 0x73, 0x10, 0x12, 0x12, 0x13, 0x0a, 0x0f,
-	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x10,
-	0x13, 0x12, 0x13, 0x0a, 0x0f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6d, 0x69, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x10, 0x14, 0x12, 0x16, 0x0a, 0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x10, 0x15, 0x12, 0x22,
-	0x0a, 0x1e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f,
-	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x64, 0x69, 0x72, 0x73,
-	0x10, 0x16, 0x12, 0x1c, 0x0a, 0x18, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x67,
-	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x64, 0x69, 0x72, 0x73, 0x10, 0x17,
-	0x12, 0x17, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x70, 0x5f, 0x68, 0x75, 0x62, 0x5f, 0x61, 0x6e, 0x64,
-	0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x10, 0x18, 0x12, 0x1a, 0x0a, 0x16, 0x64, 0x65, 0x6c,
-	0x65, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
-	0x64, 0x69, 0x72, 0x10, 0x19, 0x12, 0x1b, 0x0a, 0x17, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x10, 0x1a, 0x12, 0x1a, 0x0a, 0x16, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x1b, 0x12, 0x18,
-	0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x1c, 0x12, 0x15, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x5f, 0x70, 0x67, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x10, 0x1d, 0x12,
-	0x1d, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x73, 0x65, 0x67, 0x5f, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x1e, 0x12, 0x0f,
-	0x0a, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x1f, 0x12,
-	0x41, 0x0a, 0x3d, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x75, 0x73,
-	0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f,
-	0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69, 0x72,
-	0x72, 0x6f, 0x72, 0x73, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79,
-	0x10, 0x20, 0x12, 0x37, 0x0a, 0x33, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x61,
-	0x64, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x10, 0x21, 0x12, 0x32, 0x0a, 0x2e, 0x73,
-	0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x6c,
-	0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x6f, 0x6e, 0x5f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x22, 0x12,
-	0x2e, 0x0a, 0x2a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x23, 0x12,
-	0x2e, 0x0a, 0x2a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f,
-	0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x74,
-	0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x24, 0x12,
-	0x23, 0x0a, 0x1f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61,
-	0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x73, 0x10, 0x25, 0x12, 0x28, 0x0a, 0x24, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x10, 0x26, 0x12, 0x2d,
-	0x0a, 0x29, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x10, 0x27, 0x12, 0x2b, 0x0a,
-	0x27, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x10, 0x28, 0x12, 0x29, 0x0a, 0x25, 0x65, 0x78,
-	0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x64, 0x79, 0x6e, 0x61, 0x6d, 0x69, 0x63,
+	0x4c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x50, 0x61, 0x74, 0x68, 0x12, 0x2a, 0x0a, 0x10, 0x70,
+	0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
+	0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x6b, 0x69, 0x70, 0x50,
+	0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73, 0x6b, 0x69, 0x70, 0x50, 0x67, 0x55, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x22, 0x9a, 0x01, 0x0a, 0x0e, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x10,
+	0x70, 0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x70, 0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x56, 0x65, 0x72, 0x62, 0x6f, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x73, 0x6b, 0x69, 0x70,
+	0x50, 0x67, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73, 0x6b, 0x69, 0x70, 0x50, 0x67, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x44, 0x69, 0x72, 0x73, 0x22, 0x35, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69,
+	0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x64, 0x65, 0x66,
+	0x65, 0x72, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x64, 0x65, 0x66, 0x65, 0x72, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x22, 0x29, 0x0a,
+	0x0d, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18,
+	0x0a, 0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x2d, 0x0a, 0x11, 0x52, 0x65, 0x76, 0x65,
+	0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x70, 0x61, 0x72, 0x74, 0x69, 0x61, 0x6c, 0x22, 0x3e, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x65, 0x72,
+	0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x64,
+	0x6c, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x13, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x4d, 0x69,
+	0x72, 0x72, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x16, 0x0a, 0x14,
+	0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x34, 0x0a, 0x12, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41,
+	0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x73, 0x22, 0x15, 0x0a, 0x13, 0x53, 0x74,
+	0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x56, 0x0a, 0x0d, 0x53, 0x75, 0x62, 0x73, 0x74, 0x65,
+	0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x20, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x75, 0x62, 0x73,
+	0x74, 0x65, 0x70, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x23, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0b, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x1b,
+	0x0a, 0x19, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x49, 0x6e, 0x69, 0x74, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65,
+	0x72, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x71, 0x0a, 0x05, 0x43, 0x68, 0x75, 0x6e, 0x6b, 0x12,
+	0x16, 0x0a, 0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x06, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x12, 0x23, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x0f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x68, 0x75, 0x6e,
+	0x6b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x22, 0x2b, 0x0a, 0x04,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x0b, 0x0a, 0x07, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x10,
+	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x73, 0x74, 0x64, 0x6f, 0x75, 0x74, 0x10, 0x01, 0x12, 0x0a, 0x0a,
+	0x06, 0x73, 0x74, 0x64, 0x65, 0x72, 0x72, 0x10, 0x02, 0x22, 0x94, 0x01, 0x0a, 0x07, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x22, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0a, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x68, 0x75, 0x6e, 0x6b,
+	0x48, 0x00, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x12, 0x2c, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x74, 0x65, 0x70, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52,
+	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2b, 0x0a, 0x08, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0d, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x08, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73,
+	0x22, 0xa7, 0x02, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
+	0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x48, 0x00, 0x52, 0x12, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0f, 0x65, 0x78, 0x65, 0x63,
+	0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x14, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0f, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x43, 0x0a, 0x10, 0x66, 0x69,
+	0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x10, 0x66,
+	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x3d, 0x0a, 0x0e, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65,
+	0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x48, 0x00, 0x52, 0x0e,
+	0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x0a,
+	0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x73, 0x22, 0x4e, 0x0a, 0x12, 0x49, 0x6e,
+	0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x38, 0x0a, 0x17, 0x48, 0x61, 0x73, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x41, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x17, 0x48, 0x61, 0x73, 0x41, 0x6c, 0x6c, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73,
+	0x41, 0x6e, 0x64, 0x53, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x22, 0x35, 0x0a, 0x0f, 0x45, 0x78,
+	0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a,
+	0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0c, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x74,
+	0x65, 0x22, 0xfc, 0x01, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x30,
+	0x0a, 0x13, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x4c, 0x6f, 0x67,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x12, 0x56, 0x0a, 0x26, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x26, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x55, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x49, 0x44, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x49, 0x44, 0x12, 0x28, 0x0a, 0x0f, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0f, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x44, 0x65, 0x66, 0x65, 0x72, 0x72, 0x65, 0x64,
+	0x22, 0x5a, 0x0a, 0x0e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x4c, 0x6f,
+	0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x4c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x22, 0x26, 0x0a, 0x10,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x22, 0x26, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x3c, 0x0a, 0x10,
+	0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x10, 0x0a, 0x0e, 0x53, 0x65,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2f, 0x0a, 0x0b,
+	0x4e, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x6e,
+	0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x6e, 0x65, 0x78, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2a, 0x6b, 0x0a,
+	0x04, 0x53, 0x74, 0x65, 0x70, 0x12, 0x10, 0x0a, 0x0c, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e,
+	0x5f, 0x73, 0x74, 0x65, 0x70, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x65, 0x78, 0x65, 0x63, 0x75,
+	0x74, 0x65, 0x10, 0x02, 0x12, 0x0c, 0x0a, 0x08, 0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65,
+	0x10, 0x03, 0x12, 0x0a, 0x0a, 0x06, 0x72, 0x65, 0x76, 0x65, 0x72, 0x74, 0x10, 0x04, 0x12, 0x09,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x73, 0x10, 0x05, 0x12, 0x0f, 0x0a, 0x0b, 0x61, 0x64, 0x64,
+	0x5f, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x10, 0x06, 0x2a, 0xbf, 0x0d, 0x0a, 0x07, 0x53,
+	0x75, 0x62, 0x73, 0x74, 0x65, 0x70, 0x12, 0x13, 0x0a, 0x0f, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x5f, 0x73, 0x75, 0x62, 0x73, 0x74, 0x65, 0x70, 0x10, 0x00, 0x12, 0x20, 0x0a, 0x1c, 0x73,
+	0x61, 0x76, 0x69, 0x6e, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0x01, 0x12, 0x0d, 0x0a,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x75, 0x62, 0x10, 0x02, 0x12, 0x10, 0x0a, 0x0c,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x10, 0x03, 0x12, 0x15,
+	0x0a, 0x11, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+	0x65, 0x6e, 0x74, 0x10, 0x04, 0x12, 0x14, 0x0a, 0x10, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x64,
+	0x69, 0x73, 0x6b, 0x5f, 0x73, 0x70, 0x61, 0x63, 0x65, 0x10, 0x05, 0x12, 0x1a, 0x0a, 0x16, 0x67,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x10, 0x06, 0x12, 0x17, 0x0a, 0x13, 0x69, 0x6e, 0x69, 0x74, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x07,
+	0x12, 0x1b, 0x0a, 0x17, 0x73, 0x68, 0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x08, 0x12, 0x18, 0x0a,
+	0x14, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x6d,
+	0x61, 0x73, 0x74, 0x65, 0x72, 0x10, 0x09, 0x12, 0x11, 0x0a, 0x0d, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x10, 0x0a, 0x12, 0x1b, 0x0a, 0x17, 0x73, 0x68,
+	0x75, 0x74, 0x64, 0x6f, 0x77, 0x6e, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c,
+	0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x0b, 0x12, 0x12, 0x0a, 0x0e, 0x75, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x10, 0x0c, 0x12, 0x0f, 0x0a, 0x0b, 0x63,
+	0x6f, 0x70, 0x79, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x10, 0x0d, 0x12, 0x15, 0x0a, 0x11,
+	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x69, 0x65,
+	0x73, 0x10, 0x0e, 0x12, 0x18, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x0f, 0x12, 0x19, 0x0a,
+	0x15, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63,
+	0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67, 0x10, 0x10, 0x12, 0x1b, 0x0a, 0x17, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x10, 0x11, 0x12, 0x1c, 0x0a, 0x18, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x5f, 0x66, 0x69, 0x6c, 0x65,
+	0x73, 0x10, 0x12, 0x12, 0x13, 0x0a, 0x0f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x73,
+	0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x10, 0x13, 0x12, 0x13, 0x0a, 0x0f, 0x75, 0x70, 0x67, 0x72,
+	0x61, 0x64, 0x65, 0x5f, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x10, 0x14, 0x12, 0x16, 0x0a,
+	0x12, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61,
+	0x63, 0x65, 0x73, 0x10, 0x15, 0x12, 0x22, 0x0a, 0x1e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x64,
+	0x61, 0x74, 0x61, 0x64, 0x69, 0x72, 0x73, 0x10, 0x16, 0x12, 0x1c, 0x0a, 0x18, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x5f, 0x73, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x64, 0x69, 0x72, 0x73, 0x10, 0x17, 0x12, 0x17, 0x0a, 0x13, 0x73, 0x74, 0x6f, 0x70, 0x5f,
+	0x68, 0x75, 0x62, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x10, 0x18,
+	0x12, 0x1a, 0x0a, 0x16, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65,
+	0x72, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x64, 0x69, 0x72, 0x10, 0x19, 0x12, 0x1b, 0x0a, 0x17,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x5f, 0x6c, 0x6f, 0x67, 0x5f, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x10, 0x1a, 0x12, 0x1a, 0x0a, 0x16, 0x72, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x10, 0x1b, 0x12, 0x18, 0x0a, 0x14, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x1c, 0x12,
+	0x15, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x5f, 0x70, 0x67, 0x63, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x10, 0x1d, 0x12, 0x1d, 0x0a, 0x19, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
+	0x72, 0x73, 0x65, 0x67, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x10, 0x1e, 0x12, 0x0f, 0x0a, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x10, 0x1f, 0x12, 0x41, 0x0a, 0x3d, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66,
+	0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x65,
+	0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64,
+	0x69, 0x6e, 0x67, 0x5f, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x5f, 0x61, 0x6e, 0x64, 0x5f,
+	0x73, 0x74, 0x61, 0x6e, 0x64, 0x62, 0x79, 0x10, 0x20, 0x12, 0x37, 0x0a, 0x33, 0x77, 0x61, 0x69,
+	0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f,
+	0x5f, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x63, 0x61, 0x74, 0x61, 0x6c, 0x6f, 0x67,
+	0x10, 0x21, 0x12, 0x32, 0x0a, 0x2e, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x79,
+	0x6e, 0x61, 0x6d, 0x69, 0x63, 0x5f, 0x6c, 0x69, 0x62, 0x72, 0x61, 0x72, 0x79, 0x5f, 0x70, 0x61,
+	0x74, 0x68, 0x5f, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x10, 0x22, 0x12, 0x2e, 0x0a, 0x2a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x10, 0x23, 0x12, 0x2e, 0x0a, 0x2a, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f,
+	0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x5f, 0x6f, 0x6e, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75,
+	0x73, 0x74, 0x65, 0x72, 0x10, 0x24, 0x12, 0x23, 0x0a, 0x1f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x10, 0x25, 0x12, 0x28, 0x0a, 0x24, 0x65,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x73, 0x5f, 0x64, 0x61, 0x74,
 	0x61, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69,
-	0x70, 0x74, 0x73, 0x10, 0x29, 0x12, 0x15, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f,
-	0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x64, 0x69, 0x72, 0x73, 0x10, 0x2a, 0x12, 0x14, 0x0a, 0x10,
-	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x64, 0x69, 0x72,
-	0x10, 0x2b, 0x12, 0x1a, 0x0a, 0x16, 0x61, 0x6e, 0x61, 0x6c, 0x79, 0x7a, 0x65, 0x5f, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x2c, 0x12, 0x27,
-	0x0a, 0x23, 0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x5f, 0x67, 0x70, 0x75, 0x70, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x75,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x2d, 0x12, 0x18, 0x0a, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x79, 0x5f, 0x67, 0x70, 0x64, 0x62, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x10,
-	0x2e, 0x12, 0x32, 0x0a, 0x2e, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x67, 0x70, 0x75, 0x70,
-	0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x73, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c,
-	0x65, 0x64, 0x5f, 0x61, 0x63, 0x72, 0x6f, 0x73, 0x73, 0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x68, 0x6f,
-	0x73, 0x74, 0x73, 0x10, 0x2f, 0x12, 0x2b, 0x0a, 0x27, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
-	0x69, 0x7a, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79,
-	0x10, 0x30, 0x12, 0x36, 0x0a, 0x32, 0x77, 0x61, 0x69, 0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x61,
-	0x64, 0x79, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64,
-	0x65, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x10, 0x31, 0x2a, 0x5a, 0x0a, 0x06, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x72, 0x75, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
-	0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10, 0x03, 0x12,
-	0x0b, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04,
-	0x71, 0x75, 0x69, 0x74, 0x10, 0x05, 0x32, 0xf0, 0x03, 0x0a, 0x08, 0x43, 0x6c, 0x69, 0x54, 0x6f,
-	0x48, 0x75, 0x62, 0x12, 0x36, 0x0a, 0x0a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x12, 0x16, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
-	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x50, 0x0a, 0x17, 0x49,
+	0x70, 0x74, 0x73, 0x10, 0x26, 0x12, 0x2d, 0x0a, 0x29, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
+	0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61,
+	0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x73, 0x10, 0x27, 0x12, 0x2b, 0x0a, 0x27, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f,
+	0x66, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x69,
+	0x67, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x10,
+	0x28, 0x12, 0x29, 0x0a, 0x25, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6d, 0x69, 0x67, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x73, 0x10, 0x29, 0x12, 0x15, 0x0a, 0x11,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x64, 0x69, 0x72,
+	0x73, 0x10, 0x2a, 0x12, 0x14, 0x0a, 0x10, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x62, 0x61,
+	0x63, 0x6b, 0x75, 0x70, 0x64, 0x69, 0x72, 0x10, 0x2b, 0x12, 0x1a, 0x0a, 0x16, 0x61, 0x6e, 0x61,
+	0x6c, 0x79, 0x7a, 0x65, 0x5f, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6c, 0x75, 0x73,
+	0x74, 0x65, 0x72, 0x10, 0x2c, 0x12, 0x27, 0x0a, 0x23, 0x65, 0x6e, 0x73, 0x75, 0x72, 0x65, 0x5f,
+	0x67, 0x70, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x73,
+	0x5f, 0x61, 0x72, 0x65, 0x5f, 0x72, 0x75, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x2d, 0x12, 0x18,
+	0x0a, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x67, 0x70, 0x64, 0x62, 0x5f, 0x76, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x10, 0x2e, 0x12, 0x32, 0x0a, 0x2e, 0x76, 0x65, 0x72, 0x69,
+	0x66, 0x79, 0x5f, 0x67, 0x70, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x69, 0x73, 0x5f,
+	0x69, 0x6e, 0x73, 0x74, 0x61, 0x6c, 0x6c, 0x65, 0x64, 0x5f, 0x61, 0x63, 0x72, 0x6f, 0x73, 0x73,
+	0x5f, 0x61, 0x6c, 0x6c, 0x5f, 0x68, 0x6f, 0x73, 0x74, 0x73, 0x10, 0x2f, 0x12, 0x2b, 0x0a, 0x27,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x5f, 0x77, 0x61, 0x69, 0x74, 0x5f,
+	0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f, 0x5f, 0x62,
+	0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x10, 0x30, 0x12, 0x36, 0x0a, 0x32, 0x77, 0x61, 0x69,
+	0x74, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x74, 0x6f,
+	0x5f, 0x62, 0x65, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x79, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
+	0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x6d, 0x61, 0x73, 0x74, 0x65, 0x72, 0x10,
+	0x31, 0x12, 0x1b, 0x0a, 0x17, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x5f, 0x73, 0x6f,
+	0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x10, 0x32, 0x12, 0x22,
+	0x0a, 0x1e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x5f, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f,
+	0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74,
+	0x10, 0x33, 0x12, 0x18, 0x0a, 0x14, 0x76, 0x65, 0x72, 0x69, 0x66, 0x79, 0x5f, 0x6d, 0x61, 0x73,
+	0x74, 0x65, 0x72, 0x5f, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x10, 0x34, 0x12, 0x17, 0x0a, 0x13,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x5f, 0x63, 0x6c, 0x6f, 0x6e, 0x65, 0x5f, 0x73, 0x75, 0x70, 0x70,
+	0x6f, 0x72, 0x74, 0x10, 0x35, 0x12, 0x15, 0x0a, 0x11, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f,
+	0x64, 0x69, 0x73, 0x6b, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x10, 0x36, 0x2a, 0x5a, 0x0a, 0x06,
+	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12, 0x0a, 0x0e, 0x75, 0x6e, 0x6b, 0x6e, 0x6f, 0x77,
+	0x6e, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x72, 0x75,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x63, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x66, 0x61, 0x69, 0x6c, 0x65, 0x64, 0x10,
+	0x03, 0x12, 0x0b, 0x0a, 0x07, 0x73, 0x6b, 0x69, 0x70, 0x70, 0x65, 0x64, 0x10, 0x04, 0x12, 0x08,
+	0x0a, 0x04, 0x71, 0x75, 0x69, 0x74, 0x10, 0x05, 0x32, 0xa1, 0x05, 0x0a, 0x08, 0x43, 0x6c, 0x69,
+	0x54, 0x6f, 0x48, 0x75, 0x62, 0x12, 0x36, 0x0a, 0x0a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x12, 0x16, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x69, 0x64,
+	0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x50, 0x0a,
+	0x17, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x49,
 	0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x23, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x49, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x43, 0x6c, 0x75,
-	0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x69, 0x64,
-	0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x30, 0x0a,
-	0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x45,
-	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
+	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e,
 	0x69, 0x64, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12,
-	0x32, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x2e, 0x69, 0x64,
-	0x6c, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x12, 0x12, 0x2e,
-	0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x0c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x12, 0x15, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x47, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x45,
-	0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12,
-	0x19, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x67, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x69, 0x64, 0x6c,
-	0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x70,
-	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x65, 0x65, 0x6e, 0x70, 0x6c, 0x75,
-	0x6d, 0x2d, 0x64, 0x62, 0x2f, 0x67, 0x70, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x2f, 0x69,
-	0x64, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x30, 0x0a, 0x07, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x12, 0x13, 0x2e, 0x69, 0x64, 0x6c,
+	0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x0c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x32, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x12, 0x14, 0x2e,
+	0x69, 0x64, 0x6c, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x2e, 0x0a, 0x06, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x12,
+	0x12, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x3c, 0x0a, 0x0a, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x50,
+	0x6c, 0x61, 0x6e, 0x12, 0x16, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x14, 0x2e, 0x69, 0x64,
+	0x6c, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x36, 0x0a, 0x0a, 0x41, 0x64, 0x64, 0x4d, 0x69, 0x72, 0x72, 0x6f, 0x72,
+	0x73, 0x12, 0x16, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x4d, 0x69, 0x72, 0x72, 0x6f,
+	0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0c, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x39, 0x0a, 0x09, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x15, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x47,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x13, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x15, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69, 0x64, 0x6c,
+	0x2e, 0x53, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
+	0x00, 0x12, 0x45, 0x0a, 0x0d, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x19, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e,
+	0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x61, 0x72, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x0c, 0x53, 0x74, 0x6f, 0x70,
+	0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12, 0x18, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53,
+	0x74, 0x6f, 0x70, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x27, 0x5a, 0x25,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x65, 0x65, 0x6e,
+	0x70, 0x6c, 0x75, 0x6d, 0x2d, 0x64, 0x62, 0x2f, 0x67, 0x70, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x2f, 0x69, 0x64, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1831,7 +2163,7 @@ func file_cli_to_hub_proto_rawDescGZIP() []byte {
 }
 
 var file_cli_to_hub_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_cli_to_hub_proto_msgTypes = make([]protoimpl.MessageInfo, 22)
+var file_cli_to_hub_proto_msgTypes = make([]protoimpl.MessageInfo, 28)
 var file_cli_to_hub_proto_goTypes = []interface{}{
 	(Step)(0),                              // 0: idl.Step
 	(Substep)(0),                           // 1: idl.Substep
@@ -1842,56 +2174,71 @@ var file_cli_to_hub_proto_goTypes = []interface{}{
 	(*ExecuteRequest)(nil),                 // 6: idl.ExecuteRequest
 	(*FinalizeRequest)(nil),                // 7: idl.FinalizeRequest
 	(*RevertRequest)(nil),                  // 8: idl.RevertRequest
-	(*RestartAgentsRequest)(nil),           // 9: idl.RestartAgentsRequest
-	(*RestartAgentsReply)(nil),             // 10: idl.RestartAgentsReply
-	(*StopServicesRequest)(nil),            // 11: idl.StopServicesRequest
-	(*StopServicesReply)(nil),              // 12: idl.StopServicesReply
-	(*SubstepStatus)(nil),                  // 13: idl.SubstepStatus
-	(*PrepareInitClusterRequest)(nil),      // 14: idl.PrepareInitClusterRequest
-	(*PrepareInitClusterReply)(nil),        // 15: idl.PrepareInitClusterReply
-	(*Chunk)(nil),                          // 16: idl.Chunk
-	(*Message)(nil),                        // 17: idl.Message
-	(*Response)(nil),                       // 18: idl.Response
-	(*InitializeResponse)(nil),             // 19: idl.InitializeResponse
-	(*ExecuteResponse)(nil),                // 20: idl.ExecuteResponse
-	(*FinalizeResponse)(nil),               // 21: idl.FinalizeResponse
-	(*RevertResponse)(nil),                 // 22: idl.RevertResponse
-	(*GetConfigRequest)(nil),               // 23: idl.GetConfigRequest
-	(*GetConfigReply)(nil),                 // 24: idl.GetConfigReply
-	(*NextActions)(nil),                    // 25: idl.NextActions
+	(*RevertPlanRequest)(nil),              // 9: idl.RevertPlanRequest
+	(*RevertPlanReply)(nil),                // 10: idl.RevertPlanReply
+	(*AddMirrorsRequest)(nil),              // 11: idl.AddMirrorsRequest
+	(*RestartAgentsRequest)(nil),           // 12: idl.RestartAgentsRequest
+	(*RestartAgentsReply)(nil),             // 13: idl.RestartAgentsReply
+	(*StopServicesRequest)(nil),            // 14: idl.StopServicesRequest
+	(*StopServicesReply)(nil),              // 15: idl.StopServicesReply
+	(*SubstepStatus)(nil),                  // 16: idl.SubstepStatus
+	(*PrepareInitClusterRequest)(nil),      // 17: idl.PrepareInitClusterRequest
+	(*PrepareInitClusterReply)(nil),        // 18: idl.PrepareInitClusterReply
+	(*Chunk)(nil),                          // 19: idl.Chunk
+	(*Message)(nil),                        // 20: idl.Message
+	(*Response)(nil),                       // 21: idl.Response
+	(*InitializeResponse)(nil),             // 22: idl.InitializeResponse
+	(*ExecuteResponse)(nil),                // 23: idl.ExecuteResponse
+	(*FinalizeResponse)(nil),               // 24: idl.FinalizeResponse
+	(*RevertResponse)(nil),                 // 25: idl.RevertResponse
+	(*GetConfigRequest)(nil),               // 26: idl.GetConfigRequest
+	(*GetConfigReply)(nil),                 // 27: idl.GetConfigReply
+	(*SetConfigRequest)(nil),               // 28: idl.SetConfigRequest
+	(*SetConfigReply)(nil),                 // 29: idl.SetConfigReply
+	(*NextActions)(nil),                    // 30: idl.NextActions
+	nil,                                    // 31: idl.InitializeRequest.CopyModeDiskBytesByHostEntry
+	(*RevertAction)(nil),                   // 32: idl.RevertAction
 }
 var file_cli_to_hub_proto_depIdxs = []int32{
-	1,  // 0: idl.SubstepStatus.step:type_name -> idl.Substep
-	2,  // 1: idl.SubstepStatus.status:type_name -> idl.Status
-	3,  // 2: idl.Chunk.type:type_name -> idl.Chunk.Type
-	16, // 3: idl.Message.chunk:type_name -> idl.Chunk
-	13, // 4: idl.Message.status:type_name -> idl.SubstepStatus
-	18, // 5: idl.Message.response:type_name -> idl.Response
-	19, // 6: idl.Response.initializeResponse:type_name -> idl.InitializeResponse
-	20, // 7: idl.Response.executeResponse:type_name -> idl.ExecuteResponse
-	21, // 8: idl.Response.finalizeResponse:type_name -> idl.FinalizeResponse
-	22, // 9: idl.Response.revertResponse:type_name -> idl.RevertResponse
-	4,  // 10: idl.CliToHub.Initialize:input_type -> idl.InitializeRequest
-	5,  // 11: idl.CliToHub.InitializeCreateCluster:input_type -> idl.InitializeCreateClusterRequest
-	6,  // 12: idl.CliToHub.Execute:input_type -> idl.ExecuteRequest
-	7,  // 13: idl.CliToHub.Finalize:input_type -> idl.FinalizeRequest
-	8,  // 14: idl.CliToHub.Revert:input_type -> idl.RevertRequest
-	23, // 15: idl.CliToHub.GetConfig:input_type -> idl.GetConfigRequest
-	9,  // 16: idl.CliToHub.RestartAgents:input_type -> idl.RestartAgentsRequest
-	11, // 17: idl.CliToHub.StopServices:input_type -> idl.StopServicesRequest
-	17, // 18: idl.CliToHub.Initialize:output_type -> idl.Message
-	17, // 19: idl.CliToHub.InitializeCreateCluster:output_type -> idl.Message
-	17, // 20: idl.CliToHub.Execute:output_type -> idl.Message
-	17, // 21: idl.CliToHub.Finalize:output_type -> idl.Message
-	17, // 22: idl.CliToHub.Revert:output_type -> idl.Message
-	24, // 23: idl.CliToHub.GetConfig:output_type -> idl.GetConfigReply
-	10, // 24: idl.CliToHub.RestartAgents:output_type -> idl.RestartAgentsReply
-	12, // 25: idl.CliToHub.StopServices:output_type -> idl.StopServicesReply
-	18, // [18:26] is the sub-list for method output_type
-	10, // [10:18] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	31, // 0: idl.InitializeRequest.copyModeDiskBytesByHost:type_name -> idl.InitializeRequest.CopyModeDiskBytesByHostEntry
+	32, // 1: idl.RevertPlanReply.actions:type_name -> idl.RevertAction
+	1,  // 2: idl.SubstepStatus.step:type_name -> idl.Substep
+	2,  // 3: idl.SubstepStatus.status:type_name -> idl.Status
+	3,  // 4: idl.Chunk.type:type_name -> idl.Chunk.Type
+	19, // 5: idl.Message.chunk:type_name -> idl.Chunk
+	16, // 6: idl.Message.status:type_name -> idl.SubstepStatus
+	21, // 7: idl.Message.response:type_name -> idl.Response
+	22, // 8: idl.Response.initializeResponse:type_name -> idl.InitializeResponse
+	23, // 9: idl.Response.executeResponse:type_name -> idl.ExecuteResponse
+	24, // 10: idl.Response.finalizeResponse:type_name -> idl.FinalizeResponse
+	25, // 11: idl.Response.revertResponse:type_name -> idl.RevertResponse
+	4,  // 12: idl.CliToHub.Initialize:input_type -> idl.InitializeRequest
+	5,  // 13: idl.CliToHub.InitializeCreateCluster:input_type -> idl.InitializeCreateClusterRequest
+	6,  // 14: idl.CliToHub.Execute:input_type -> idl.ExecuteRequest
+	7,  // 15: idl.CliToHub.Finalize:input_type -> idl.FinalizeRequest
+	8,  // 16: idl.CliToHub.Revert:input_type -> idl.RevertRequest
+	9,  // 17: idl.CliToHub.RevertPlan:input_type -> idl.RevertPlanRequest
+	11, // 18: idl.CliToHub.AddMirrors:input_type -> idl.AddMirrorsRequest
+	26, // 19: idl.CliToHub.GetConfig:input_type -> idl.GetConfigRequest
+	28, // 20: idl.CliToHub.SetConfig:input_type -> idl.SetConfigRequest
+	12, // 21: idl.CliToHub.RestartAgents:input_type -> idl.RestartAgentsRequest
+	14, // 22: idl.CliToHub.StopServices:input_type -> idl.StopServicesRequest
+	20, // 23: idl.CliToHub.Initialize:output_type -> idl.Message
+	20, // 24: idl.CliToHub.InitializeCreateCluster:output_type -> idl.Message
+	20, // 25: idl.CliToHub.Execute:output_type -> idl.Message
+	20, // 26: idl.CliToHub.Finalize:output_type -> idl.Message
+	20, // 27: idl.CliToHub.Revert:output_type -> idl.Message
+	10, // 28: idl.CliToHub.RevertPlan:output_type -> idl.RevertPlanReply
+	20, // 29: idl.CliToHub.AddMirrors:output_type -> idl.Message
+	27, // 30: idl.CliToHub.GetConfig:output_type -> idl.GetConfigReply
+	29, // 31: idl.CliToHub.SetConfig:output_type -> idl.SetConfigReply
+	13, // 32: idl.CliToHub.RestartAgents:output_type -> idl.RestartAgentsReply
+	15, // 33: idl.CliToHub.StopServices:output_type -> idl.StopServicesReply
+	23, // [23:34] is the sub-list for method output_type
+	12, // [12:23] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_cli_to_hub_proto_init() }
@@ -1899,6 +2246,7 @@ func file_cli_to_hub_proto_init() {
 	if File_cli_to_hub_proto != nil {
 		return
 	}
+	file_common_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_cli_to_hub_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InitializeRequest); i {
@@ -1961,7 +2309,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartAgentsRequest); i {
+			switch v := v.(*RevertPlanRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1973,7 +2321,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestartAgentsReply); i {
+			switch v := v.(*RevertPlanReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1985,7 +2333,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopServicesRequest); i {
+			switch v := v.(*AddMirrorsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1997,7 +2345,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopServicesReply); i {
+			switch v := v.(*RestartAgentsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2009,7 +2357,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubstepStatus); i {
+			switch v := v.(*RestartAgentsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2021,7 +2369,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrepareInitClusterRequest); i {
+			switch v := v.(*StopServicesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2033,7 +2381,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PrepareInitClusterReply); i {
+			switch v := v.(*StopServicesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2045,7 +2393,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Chunk); i {
+			switch v := v.(*SubstepStatus); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2057,7 +2405,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+			switch v := v.(*PrepareInitClusterRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2069,7 +2417,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Response); i {
+			switch v := v.(*PrepareInitClusterReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2081,7 +2429,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*InitializeResponse); i {
+			switch v := v.(*Chunk); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2093,7 +2441,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ExecuteResponse); i {
+			switch v := v.(*Message); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2105,7 +2453,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FinalizeResponse); i {
+			switch v := v.(*Response); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2117,7 +2465,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RevertResponse); i {
+			switch v := v.(*InitializeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2129,7 +2477,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigRequest); i {
+			switch v := v.(*ExecuteResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2141,7 +2489,7 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetConfigReply); i {
+			switch v := v.(*FinalizeResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2153,6 +2501,66 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 		file_cli_to_hub_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RevertResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_to_hub_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_to_hub_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetConfigReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_to_hub_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConfigRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_to_hub_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetConfigReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cli_to_hub_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NextActions); i {
 			case 0:
 				return &v.state
@@ -2165,12 +2573,12 @@ func file_cli_to_hub_proto_init() {
 			}
 		}
 	}
-	file_cli_to_hub_proto_msgTypes[13].OneofWrappers = []interface{}{
+	file_cli_to_hub_proto_msgTypes[16].OneofWrappers = []interface{}{
 		(*Message_Chunk)(nil),
 		(*Message_Status)(nil),
 		(*Message_Response)(nil),
 	}
-	file_cli_to_hub_proto_msgTypes[14].OneofWrappers = []interface{}{
+	file_cli_to_hub_proto_msgTypes[17].OneofWrappers = []interface{}{
 		(*Response_InitializeResponse)(nil),
 		(*Response_ExecuteResponse)(nil),
 		(*Response_FinalizeResponse)(nil),
@@ -2182,7 +2590,7 @@ func file_cli_to_hub_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cli_to_hub_proto_rawDesc,
 			NumEnums:      4,
-			NumMessages:   22,
+			NumMessages:   28,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
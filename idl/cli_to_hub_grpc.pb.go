@@ -27,7 +27,10 @@ const (
 	CliToHub_Execute_FullMethodName                 = "/idl.CliToHub/Execute"
 	CliToHub_Finalize_FullMethodName                = "/idl.CliToHub/Finalize"
 	CliToHub_Revert_FullMethodName                  = "/idl.CliToHub/Revert"
+	CliToHub_RevertPlan_FullMethodName              = "/idl.CliToHub/RevertPlan"
+	CliToHub_AddMirrors_FullMethodName              = "/idl.CliToHub/AddMirrors"
 	CliToHub_GetConfig_FullMethodName               = "/idl.CliToHub/GetConfig"
+	CliToHub_SetConfig_FullMethodName               = "/idl.CliToHub/SetConfig"
 	CliToHub_RestartAgents_FullMethodName           = "/idl.CliToHub/RestartAgents"
 	CliToHub_StopServices_FullMethodName            = "/idl.CliToHub/StopServices"
 )
@@ -41,7 +44,10 @@ type CliToHubClient interface {
 	Execute(ctx context.Context, in *ExecuteRequest, opts ...grpc.CallOption) (CliToHub_ExecuteClient, error)
 	Finalize(ctx context.Context, in *FinalizeRequest, opts ...grpc.CallOption) (CliToHub_FinalizeClient, error)
 	Revert(ctx context.Context, in *RevertRequest, opts ...grpc.CallOption) (CliToHub_RevertClient, error)
+	RevertPlan(ctx context.Context, in *RevertPlanRequest, opts ...grpc.CallOption) (*RevertPlanReply, error)
+	AddMirrors(ctx context.Context, in *AddMirrorsRequest, opts ...grpc.CallOption) (CliToHub_AddMirrorsClient, error)
 	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigReply, error)
+	SetConfig(ctx context.Context, in *SetConfigRequest, opts ...grpc.CallOption) (*SetConfigReply, error)
 	RestartAgents(ctx context.Context, in *RestartAgentsRequest, opts ...grpc.CallOption) (*RestartAgentsReply, error)
 	StopServices(ctx context.Context, in *StopServicesRequest, opts ...grpc.CallOption) (*StopServicesReply, error)
 }
@@ -214,6 +220,47 @@ func (x *cliToHubRevertClient) Recv() (*Message, error) {
 	return m, nil
 }
 
+func (c *cliToHubClient) RevertPlan(ctx context.Context, in *RevertPlanRequest, opts ...grpc.CallOption) (*RevertPlanReply, error) {
+	out := new(RevertPlanReply)
+	err := c.cc.Invoke(ctx, CliToHub_RevertPlan_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cliToHubClient) AddMirrors(ctx context.Context, in *AddMirrorsRequest, opts ...grpc.CallOption) (CliToHub_AddMirrorsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &CliToHub_ServiceDesc.Streams[5], CliToHub_AddMirrors_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &cliToHubAddMirrorsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type CliToHub_AddMirrorsClient interface {
+	Recv() (*Message, error)
+	grpc.ClientStream
+}
+
+type cliToHubAddMirrorsClient struct {
+	grpc.ClientStream
+}
+
+func (x *cliToHubAddMirrorsClient) Recv() (*Message, error) {
+	m := new(Message)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *cliToHubClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigReply, error) {
 	out := new(GetConfigReply)
 	err := c.cc.Invoke(ctx, CliToHub_GetConfig_FullMethodName, in, out, opts...)
@@ -223,6 +270,15 @@ func (c *cliToHubClient) GetConfig(ctx context.Context, in *GetConfigRequest, op
 	return out, nil
 }
 
+func (c *cliToHubClient) SetConfig(ctx context.Context, in *SetConfigRequest, opts ...grpc.CallOption) (*SetConfigReply, error) {
+	out := new(SetConfigReply)
+	err := c.cc.Invoke(ctx, CliToHub_SetConfig_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cliToHubClient) RestartAgents(ctx context.Context, in *RestartAgentsRequest, opts ...grpc.CallOption) (*RestartAgentsReply, error) {
 	out := new(RestartAgentsReply)
 	err := c.cc.Invoke(ctx, CliToHub_RestartAgents_FullMethodName, in, out, opts...)
@@ -250,7 +306,10 @@ type CliToHubServer interface {
 	Execute(*ExecuteRequest, CliToHub_ExecuteServer) error
 	Finalize(*FinalizeRequest, CliToHub_FinalizeServer) error
 	Revert(*RevertRequest, CliToHub_RevertServer) error
+	RevertPlan(context.Context, *RevertPlanRequest) (*RevertPlanReply, error)
+	AddMirrors(*AddMirrorsRequest, CliToHub_AddMirrorsServer) error
 	GetConfig(context.Context, *GetConfigRequest) (*GetConfigReply, error)
+	SetConfig(context.Context, *SetConfigRequest) (*SetConfigReply, error)
 	RestartAgents(context.Context, *RestartAgentsRequest) (*RestartAgentsReply, error)
 	StopServices(context.Context, *StopServicesRequest) (*StopServicesReply, error)
 }
@@ -274,9 +333,18 @@ func (UnimplementedCliToHubServer) Finalize(*FinalizeRequest, CliToHub_FinalizeS
 func (UnimplementedCliToHubServer) Revert(*RevertRequest, CliToHub_RevertServer) error {
 	return status.Errorf(codes.Unimplemented, "method Revert not implemented")
 }
+func (UnimplementedCliToHubServer) RevertPlan(context.Context, *RevertPlanRequest) (*RevertPlanReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevertPlan not implemented")
+}
+func (UnimplementedCliToHubServer) AddMirrors(*AddMirrorsRequest, CliToHub_AddMirrorsServer) error {
+	return status.Errorf(codes.Unimplemented, "method AddMirrors not implemented")
+}
 func (UnimplementedCliToHubServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
 }
+func (UnimplementedCliToHubServer) SetConfig(context.Context, *SetConfigRequest) (*SetConfigReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetConfig not implemented")
+}
 func (UnimplementedCliToHubServer) RestartAgents(context.Context, *RestartAgentsRequest) (*RestartAgentsReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RestartAgents not implemented")
 }
@@ -400,6 +468,45 @@ func (x *cliToHubRevertServer) Send(m *Message) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _CliToHub_RevertPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevertPlanRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliToHubServer).RevertPlan(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CliToHub_RevertPlan_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliToHubServer).RevertPlan(ctx, req.(*RevertPlanRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CliToHub_AddMirrors_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(AddMirrorsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CliToHubServer).AddMirrors(m, &cliToHubAddMirrorsServer{stream})
+}
+
+type CliToHub_AddMirrorsServer interface {
+	Send(*Message) error
+	grpc.ServerStream
+}
+
+type cliToHubAddMirrorsServer struct {
+	grpc.ServerStream
+}
+
+func (x *cliToHubAddMirrorsServer) Send(m *Message) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _CliToHub_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetConfigRequest)
 	if err := dec(in); err != nil {
@@ -418,6 +525,24 @@ func _CliToHub_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _CliToHub_SetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CliToHubServer).SetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: CliToHub_SetConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CliToHubServer).SetConfig(ctx, req.(*SetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _CliToHub_RestartAgents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RestartAgentsRequest)
 	if err := dec(in); err != nil {
@@ -461,10 +586,18 @@ var CliToHub_ServiceDesc = grpc.ServiceDesc{
 	ServiceName: "idl.CliToHub",
 	HandlerType: (*CliToHubServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "RevertPlan",
+			Handler:    _CliToHub_RevertPlan_Handler,
+		},
 		{
 			MethodName: "GetConfig",
 			Handler:    _CliToHub_GetConfig_Handler,
 		},
+		{
+			MethodName: "SetConfig",
+			Handler:    _CliToHub_SetConfig_Handler,
+		},
 		{
 			MethodName: "RestartAgents",
 			Handler:    _CliToHub_RestartAgents_Handler,
@@ -500,6 +633,11 @@ var CliToHub_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _CliToHub_Revert_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "AddMirrors",
+			Handler:       _CliToHub_AddMirrors_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "cli_to_hub.proto",
 }
@@ -121,6 +121,58 @@ func (PgOptions_Action) EnumDescriptor() ([]byte, []int) {
 	return file_hub_to_agent_proto_rawDescGZIP(), []int{0, 1}
 }
 
+type SnapshotDirectoriesRequest_Action int32
+
+const (
+	SnapshotDirectoriesRequest_unknown_action SnapshotDirectoriesRequest_Action = 0 // http://androiddevblog.com/protocol-buffers-pitfall-adding-enum-values/
+	SnapshotDirectoriesRequest_snapshot       SnapshotDirectoriesRequest_Action = 1
+	SnapshotDirectoriesRequest_restore        SnapshotDirectoriesRequest_Action = 2
+	SnapshotDirectoriesRequest_delete         SnapshotDirectoriesRequest_Action = 3
+)
+
+// Enum value maps for SnapshotDirectoriesRequest_Action.
+var (
+	SnapshotDirectoriesRequest_Action_name = map[int32]string{
+		0: "unknown_action",
+		1: "snapshot",
+		2: "restore",
+		3: "delete",
+	}
+	SnapshotDirectoriesRequest_Action_value = map[string]int32{
+		"unknown_action": 0,
+		"snapshot":       1,
+		"restore":        2,
+		"delete":         3,
+	}
+)
+
+func (x SnapshotDirectoriesRequest_Action) Enum() *SnapshotDirectoriesRequest_Action {
+	p := new(SnapshotDirectoriesRequest_Action)
+	*p = x
+	return p
+}
+
+func (x SnapshotDirectoriesRequest_Action) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (SnapshotDirectoriesRequest_Action) Descriptor() protoreflect.EnumDescriptor {
+	return file_hub_to_agent_proto_enumTypes[2].Descriptor()
+}
+
+func (SnapshotDirectoriesRequest_Action) Type() protoreflect.EnumType {
+	return &file_hub_to_agent_proto_enumTypes[2]
+}
+
+func (x SnapshotDirectoriesRequest_Action) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use SnapshotDirectoriesRequest_Action.Descriptor instead.
+func (SnapshotDirectoriesRequest_Action) EnumDescriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{46, 0}
+}
+
 type PgOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -807,6 +859,99 @@ func (*DeleteBackupDirectoryReply) Descriptor() ([]byte, []int) {
 	return file_hub_to_agent_proto_rawDescGZIP(), []int{11}
 }
 
+type MoveBackupDirectoryRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *MoveBackupDirectoryRequest) Reset() {
+	*x = MoveBackupDirectoryRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveBackupDirectoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveBackupDirectoryRequest) ProtoMessage() {}
+
+func (x *MoveBackupDirectoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveBackupDirectoryRequest.ProtoReflect.Descriptor instead.
+func (*MoveBackupDirectoryRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveBackupDirectoryRequest) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *MoveBackupDirectoryRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type MoveBackupDirectoryReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *MoveBackupDirectoryReply) Reset() {
+	*x = MoveBackupDirectoryReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveBackupDirectoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveBackupDirectoryReply) ProtoMessage() {}
+
+func (x *MoveBackupDirectoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveBackupDirectoryReply.ProtoReflect.Descriptor instead.
+func (*MoveBackupDirectoryReply) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{13}
+}
+
 type DeleteTablespaceRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -818,7 +963,7 @@ type DeleteTablespaceRequest struct {
 func (x *DeleteTablespaceRequest) Reset() {
 	*x = DeleteTablespaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[12]
+		mi := &file_hub_to_agent_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -831,7 +976,7 @@ func (x *DeleteTablespaceRequest) String() string {
 func (*DeleteTablespaceRequest) ProtoMessage() {}
 
 func (x *DeleteTablespaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[12]
+	mi := &file_hub_to_agent_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -844,7 +989,7 @@ func (x *DeleteTablespaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTablespaceRequest.ProtoReflect.Descriptor instead.
 func (*DeleteTablespaceRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{12}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteTablespaceRequest) GetDirs() []string {
@@ -863,7 +1008,7 @@ type DeleteTablespaceReply struct {
 func (x *DeleteTablespaceReply) Reset() {
 	*x = DeleteTablespaceReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[13]
+		mi := &file_hub_to_agent_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -876,7 +1021,7 @@ func (x *DeleteTablespaceReply) String() string {
 func (*DeleteTablespaceReply) ProtoMessage() {}
 
 func (x *DeleteTablespaceReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[13]
+	mi := &file_hub_to_agent_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -889,7 +1034,7 @@ func (x *DeleteTablespaceReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteTablespaceReply.ProtoReflect.Descriptor instead.
 func (*DeleteTablespaceReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{13}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{15}
 }
 
 type ArchiveLogDirectoryRequest struct {
@@ -903,7 +1048,7 @@ type ArchiveLogDirectoryRequest struct {
 func (x *ArchiveLogDirectoryRequest) Reset() {
 	*x = ArchiveLogDirectoryRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[14]
+		mi := &file_hub_to_agent_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -916,7 +1061,7 @@ func (x *ArchiveLogDirectoryRequest) String() string {
 func (*ArchiveLogDirectoryRequest) ProtoMessage() {}
 
 func (x *ArchiveLogDirectoryRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[14]
+	mi := &file_hub_to_agent_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -929,7 +1074,7 @@ func (x *ArchiveLogDirectoryRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveLogDirectoryRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveLogDirectoryRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{14}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *ArchiveLogDirectoryRequest) GetLogArchiveDir() string {
@@ -948,7 +1093,7 @@ type ArchiveLogDirectoryReply struct {
 func (x *ArchiveLogDirectoryReply) Reset() {
 	*x = ArchiveLogDirectoryReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[15]
+		mi := &file_hub_to_agent_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -961,7 +1106,7 @@ func (x *ArchiveLogDirectoryReply) String() string {
 func (*ArchiveLogDirectoryReply) ProtoMessage() {}
 
 func (x *ArchiveLogDirectoryReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[15]
+	mi := &file_hub_to_agent_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -974,7 +1119,7 @@ func (x *ArchiveLogDirectoryReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveLogDirectoryReply.ProtoReflect.Descriptor instead.
 func (*ArchiveLogDirectoryReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{15}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{17}
 }
 
 type RenameDirectories struct {
@@ -982,14 +1127,15 @@ type RenameDirectories struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Source string `protobuf:"bytes,1,opt,name=Source,proto3" json:"Source,omitempty"`
-	Target string `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
+	Source      string `protobuf:"bytes,1,opt,name=Source,proto3" json:"Source,omitempty"`
+	Target      string `protobuf:"bytes,2,opt,name=Target,proto3" json:"Target,omitempty"`
+	ArchiveOnly bool   `protobuf:"varint,3,opt,name=ArchiveOnly,proto3" json:"ArchiveOnly,omitempty"`
 }
 
 func (x *RenameDirectories) Reset() {
 	*x = RenameDirectories{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[16]
+		mi := &file_hub_to_agent_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1002,7 +1148,7 @@ func (x *RenameDirectories) String() string {
 func (*RenameDirectories) ProtoMessage() {}
 
 func (x *RenameDirectories) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[16]
+	mi := &file_hub_to_agent_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1015,7 +1161,7 @@ func (x *RenameDirectories) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameDirectories.ProtoReflect.Descriptor instead.
 func (*RenameDirectories) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{16}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *RenameDirectories) GetSource() string {
@@ -1032,6 +1178,13 @@ func (x *RenameDirectories) GetTarget() string {
 	return ""
 }
 
+func (x *RenameDirectories) GetArchiveOnly() bool {
+	if x != nil {
+		return x.ArchiveOnly
+	}
+	return false
+}
+
 type RenameDirectoriesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1043,7 +1196,7 @@ type RenameDirectoriesRequest struct {
 func (x *RenameDirectoriesRequest) Reset() {
 	*x = RenameDirectoriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[17]
+		mi := &file_hub_to_agent_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1056,7 +1209,7 @@ func (x *RenameDirectoriesRequest) String() string {
 func (*RenameDirectoriesRequest) ProtoMessage() {}
 
 func (x *RenameDirectoriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[17]
+	mi := &file_hub_to_agent_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1069,7 +1222,7 @@ func (x *RenameDirectoriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameDirectoriesRequest.ProtoReflect.Descriptor instead.
 func (*RenameDirectoriesRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{17}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RenameDirectoriesRequest) GetDirs() []*RenameDirectories {
@@ -1088,7 +1241,7 @@ type RenameDirectoriesReply struct {
 func (x *RenameDirectoriesReply) Reset() {
 	*x = RenameDirectoriesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[18]
+		mi := &file_hub_to_agent_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1101,7 +1254,7 @@ func (x *RenameDirectoriesReply) String() string {
 func (*RenameDirectoriesReply) ProtoMessage() {}
 
 func (x *RenameDirectoriesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[18]
+	mi := &file_hub_to_agent_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1114,7 +1267,7 @@ func (x *RenameDirectoriesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameDirectoriesReply.ProtoReflect.Descriptor instead.
 func (*RenameDirectoriesReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{18}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{20}
 }
 
 type StopAgentRequest struct {
@@ -1126,7 +1279,7 @@ type StopAgentRequest struct {
 func (x *StopAgentRequest) Reset() {
 	*x = StopAgentRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[19]
+		mi := &file_hub_to_agent_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1139,7 +1292,7 @@ func (x *StopAgentRequest) String() string {
 func (*StopAgentRequest) ProtoMessage() {}
 
 func (x *StopAgentRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[19]
+	mi := &file_hub_to_agent_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1152,7 +1305,7 @@ func (x *StopAgentRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopAgentRequest.ProtoReflect.Descriptor instead.
 func (*StopAgentRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{19}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{21}
 }
 
 type StopAgentReply struct {
@@ -1164,7 +1317,7 @@ type StopAgentReply struct {
 func (x *StopAgentReply) Reset() {
 	*x = StopAgentReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[20]
+		mi := &file_hub_to_agent_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1177,7 +1330,7 @@ func (x *StopAgentReply) String() string {
 func (*StopAgentReply) ProtoMessage() {}
 
 func (x *StopAgentReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[20]
+	mi := &file_hub_to_agent_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1190,7 +1343,7 @@ func (x *StopAgentReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use StopAgentReply.ProtoReflect.Descriptor instead.
 func (*StopAgentReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{20}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{22}
 }
 
 type CheckSegmentDiskSpaceRequest struct {
@@ -1205,7 +1358,7 @@ type CheckSegmentDiskSpaceRequest struct {
 func (x *CheckSegmentDiskSpaceRequest) Reset() {
 	*x = CheckSegmentDiskSpaceRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[21]
+		mi := &file_hub_to_agent_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1218,7 +1371,7 @@ func (x *CheckSegmentDiskSpaceRequest) String() string {
 func (*CheckSegmentDiskSpaceRequest) ProtoMessage() {}
 
 func (x *CheckSegmentDiskSpaceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[21]
+	mi := &file_hub_to_agent_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +1384,7 @@ func (x *CheckSegmentDiskSpaceRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckSegmentDiskSpaceRequest.ProtoReflect.Descriptor instead.
 func (*CheckSegmentDiskSpaceRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{21}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{23}
 }
 
 func (x *CheckSegmentDiskSpaceRequest) GetDiskFreeRatio() float64 {
@@ -1259,7 +1412,7 @@ type CheckDiskSpaceReply struct {
 func (x *CheckDiskSpaceReply) Reset() {
 	*x = CheckDiskSpaceReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[22]
+		mi := &file_hub_to_agent_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1272,7 +1425,7 @@ func (x *CheckDiskSpaceReply) String() string {
 func (*CheckDiskSpaceReply) ProtoMessage() {}
 
 func (x *CheckDiskSpaceReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[22]
+	mi := &file_hub_to_agent_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1285,7 +1438,7 @@ func (x *CheckDiskSpaceReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CheckDiskSpaceReply.ProtoReflect.Descriptor instead.
 func (*CheckDiskSpaceReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{22}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *CheckDiskSpaceReply) GetUsages() []*CheckDiskSpaceReply_DiskUsage {
@@ -1295,31 +1448,31 @@ func (x *CheckDiskSpaceReply) GetUsages() []*CheckDiskSpaceReply_DiskUsage {
 	return nil
 }
 
-type RsyncRequest struct {
+type GetDiskUsageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Options []*RsyncRequest_RsyncOptions `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
+	Dirs []string `protobuf:"bytes,1,rep,name=dirs,proto3" json:"dirs,omitempty"`
 }
 
-func (x *RsyncRequest) Reset() {
-	*x = RsyncRequest{}
+func (x *GetDiskUsageRequest) Reset() {
+	*x = GetDiskUsageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[23]
+		mi := &file_hub_to_agent_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsyncRequest) String() string {
+func (x *GetDiskUsageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsyncRequest) ProtoMessage() {}
+func (*GetDiskUsageRequest) ProtoMessage() {}
 
-func (x *RsyncRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[23]
+func (x *GetDiskUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1330,41 +1483,46 @@ func (x *RsyncRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsyncRequest.ProtoReflect.Descriptor instead.
-func (*RsyncRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use GetDiskUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetDiskUsageRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RsyncRequest) GetOptions() []*RsyncRequest_RsyncOptions {
+func (x *GetDiskUsageRequest) GetDirs() []string {
 	if x != nil {
-		return x.Options
+		return x.Dirs
 	}
 	return nil
 }
 
-type RsyncReply struct {
+// GetDiskUsageReply has the usage in kilobytes of each filesystem holding the
+// requested dirs. The total excludes space reserved for the superuser such
+// that it is the sum of the used and available space.
+type GetDiskUsageReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Usages []*GetDiskUsageReply_FilesystemUsage `protobuf:"bytes,1,rep,name=usages,proto3" json:"usages,omitempty"`
 }
 
-func (x *RsyncReply) Reset() {
-	*x = RsyncReply{}
+func (x *GetDiskUsageReply) Reset() {
+	*x = GetDiskUsageReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[24]
+		mi := &file_hub_to_agent_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsyncReply) String() string {
+func (x *GetDiskUsageReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsyncReply) ProtoMessage() {}
+func (*GetDiskUsageReply) ProtoMessage() {}
 
-func (x *RsyncReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[24]
+func (x *GetDiskUsageReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1375,36 +1533,43 @@ func (x *RsyncReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsyncReply.ProtoReflect.Descriptor instead.
-func (*RsyncReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetDiskUsageReply.ProtoReflect.Descriptor instead.
+func (*GetDiskUsageReply) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{26}
 }
 
-type RestorePgControlRequest struct {
+func (x *GetDiskUsageReply) GetUsages() []*GetDiskUsageReply_FilesystemUsage {
+	if x != nil {
+		return x.Usages
+	}
+	return nil
+}
+
+type RsyncRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Datadirs []string `protobuf:"bytes,1,rep,name=datadirs,proto3" json:"datadirs,omitempty"`
+	Options []*RsyncRequest_RsyncOptions `protobuf:"bytes,1,rep,name=options,proto3" json:"options,omitempty"`
 }
 
-func (x *RestorePgControlRequest) Reset() {
-	*x = RestorePgControlRequest{}
+func (x *RsyncRequest) Reset() {
+	*x = RsyncRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[25]
+		mi := &file_hub_to_agent_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RestorePgControlRequest) String() string {
+func (x *RsyncRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestorePgControlRequest) ProtoMessage() {}
+func (*RsyncRequest) ProtoMessage() {}
 
-func (x *RestorePgControlRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[25]
+func (x *RsyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1415,41 +1580,41 @@ func (x *RestorePgControlRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestorePgControlRequest.ProtoReflect.Descriptor instead.
-func (*RestorePgControlRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use RsyncRequest.ProtoReflect.Descriptor instead.
+func (*RsyncRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RestorePgControlRequest) GetDatadirs() []string {
+func (x *RsyncRequest) GetOptions() []*RsyncRequest_RsyncOptions {
 	if x != nil {
-		return x.Datadirs
+		return x.Options
 	}
 	return nil
 }
 
-type RestorePgControlReply struct {
+type RsyncReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *RestorePgControlReply) Reset() {
-	*x = RestorePgControlReply{}
+func (x *RsyncReply) Reset() {
+	*x = RsyncReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[26]
+		mi := &file_hub_to_agent_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RestorePgControlReply) String() string {
+func (x *RsyncReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RestorePgControlReply) ProtoMessage() {}
+func (*RsyncReply) ProtoMessage() {}
 
-func (x *RestorePgControlReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[26]
+func (x *RsyncReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1460,9 +1625,94 @@ func (x *RestorePgControlReply) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RestorePgControlReply.ProtoReflect.Descriptor instead.
+// Deprecated: Use RsyncReply.ProtoReflect.Descriptor instead.
+func (*RsyncReply) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{28}
+}
+
+type RestorePgControlRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Datadirs []string `protobuf:"bytes,1,rep,name=datadirs,proto3" json:"datadirs,omitempty"`
+}
+
+func (x *RestorePgControlRequest) Reset() {
+	*x = RestorePgControlRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestorePgControlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePgControlRequest) ProtoMessage() {}
+
+func (x *RestorePgControlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePgControlRequest.ProtoReflect.Descriptor instead.
+func (*RestorePgControlRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RestorePgControlRequest) GetDatadirs() []string {
+	if x != nil {
+		return x.Datadirs
+	}
+	return nil
+}
+
+type RestorePgControlReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestorePgControlReply) Reset() {
+	*x = RestorePgControlReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestorePgControlReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestorePgControlReply) ProtoMessage() {}
+
+func (x *RestorePgControlReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestorePgControlReply.ProtoReflect.Descriptor instead.
 func (*RestorePgControlReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{26}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{30}
 }
 
 type UpdateFileConfOptions struct {
@@ -1478,7 +1728,7 @@ type UpdateFileConfOptions struct {
 func (x *UpdateFileConfOptions) Reset() {
 	*x = UpdateFileConfOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[27]
+		mi := &file_hub_to_agent_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1491,7 +1741,7 @@ func (x *UpdateFileConfOptions) String() string {
 func (*UpdateFileConfOptions) ProtoMessage() {}
 
 func (x *UpdateFileConfOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[27]
+	mi := &file_hub_to_agent_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1504,7 +1754,7 @@ func (x *UpdateFileConfOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateFileConfOptions.ProtoReflect.Descriptor instead.
 func (*UpdateFileConfOptions) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{27}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *UpdateFileConfOptions) GetPath() string {
@@ -1539,7 +1789,7 @@ type UpdateConfigurationRequest struct {
 func (x *UpdateConfigurationRequest) Reset() {
 	*x = UpdateConfigurationRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[28]
+		mi := &file_hub_to_agent_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1552,7 +1802,7 @@ func (x *UpdateConfigurationRequest) String() string {
 func (*UpdateConfigurationRequest) ProtoMessage() {}
 
 func (x *UpdateConfigurationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[28]
+	mi := &file_hub_to_agent_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1565,7 +1815,7 @@ func (x *UpdateConfigurationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConfigurationRequest.ProtoReflect.Descriptor instead.
 func (*UpdateConfigurationRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{28}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *UpdateConfigurationRequest) GetOptions() []*UpdateFileConfOptions {
@@ -1584,7 +1834,7 @@ type UpdateConfigurationReply struct {
 func (x *UpdateConfigurationReply) Reset() {
 	*x = UpdateConfigurationReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[29]
+		mi := &file_hub_to_agent_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1597,7 +1847,7 @@ func (x *UpdateConfigurationReply) String() string {
 func (*UpdateConfigurationReply) ProtoMessage() {}
 
 func (x *UpdateConfigurationReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[29]
+	mi := &file_hub_to_agent_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1610,7 +1860,7 @@ func (x *UpdateConfigurationReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateConfigurationReply.ProtoReflect.Descriptor instead.
 func (*UpdateConfigurationReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{29}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{33}
 }
 
 type RenameTablespacesRequest struct {
@@ -1624,7 +1874,7 @@ type RenameTablespacesRequest struct {
 func (x *RenameTablespacesRequest) Reset() {
 	*x = RenameTablespacesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[30]
+		mi := &file_hub_to_agent_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1637,7 +1887,7 @@ func (x *RenameTablespacesRequest) String() string {
 func (*RenameTablespacesRequest) ProtoMessage() {}
 
 func (x *RenameTablespacesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[30]
+	mi := &file_hub_to_agent_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1650,7 +1900,7 @@ func (x *RenameTablespacesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameTablespacesRequest.ProtoReflect.Descriptor instead.
 func (*RenameTablespacesRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{30}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *RenameTablespacesRequest) GetRenamePairs() []*RenameTablespacesRequest_RenamePair {
@@ -1669,7 +1919,7 @@ type RenameTablespacesReply struct {
 func (x *RenameTablespacesReply) Reset() {
 	*x = RenameTablespacesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[31]
+		mi := &file_hub_to_agent_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1682,7 +1932,7 @@ func (x *RenameTablespacesReply) String() string {
 func (*RenameTablespacesReply) ProtoMessage() {}
 
 func (x *RenameTablespacesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[31]
+	mi := &file_hub_to_agent_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1695,7 +1945,7 @@ func (x *RenameTablespacesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RenameTablespacesReply.ProtoReflect.Descriptor instead.
 func (*RenameTablespacesReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{31}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{35}
 }
 
 type CreateRecoveryConfRequest struct {
@@ -1709,7 +1959,7 @@ type CreateRecoveryConfRequest struct {
 func (x *CreateRecoveryConfRequest) Reset() {
 	*x = CreateRecoveryConfRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[32]
+		mi := &file_hub_to_agent_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1722,7 +1972,7 @@ func (x *CreateRecoveryConfRequest) String() string {
 func (*CreateRecoveryConfRequest) ProtoMessage() {}
 
 func (x *CreateRecoveryConfRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[32]
+	mi := &file_hub_to_agent_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1735,7 +1985,7 @@ func (x *CreateRecoveryConfRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRecoveryConfRequest.ProtoReflect.Descriptor instead.
 func (*CreateRecoveryConfRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{32}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *CreateRecoveryConfRequest) GetConnections() []*CreateRecoveryConfRequest_Connection {
@@ -1754,7 +2004,7 @@ type CreateRecoveryConfReply struct {
 func (x *CreateRecoveryConfReply) Reset() {
 	*x = CreateRecoveryConfReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[33]
+		mi := &file_hub_to_agent_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1767,7 +2017,7 @@ func (x *CreateRecoveryConfReply) String() string {
 func (*CreateRecoveryConfReply) ProtoMessage() {}
 
 func (x *CreateRecoveryConfReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[33]
+	mi := &file_hub_to_agent_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1780,7 +2030,7 @@ func (x *CreateRecoveryConfReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CreateRecoveryConfReply.ProtoReflect.Descriptor instead.
 func (*CreateRecoveryConfReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{33}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{37}
 }
 
 type AddReplicationEntriesRequest struct {
@@ -1794,7 +2044,7 @@ type AddReplicationEntriesRequest struct {
 func (x *AddReplicationEntriesRequest) Reset() {
 	*x = AddReplicationEntriesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[34]
+		mi := &file_hub_to_agent_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1807,7 +2057,7 @@ func (x *AddReplicationEntriesRequest) String() string {
 func (*AddReplicationEntriesRequest) ProtoMessage() {}
 
 func (x *AddReplicationEntriesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[34]
+	mi := &file_hub_to_agent_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1820,7 +2070,7 @@ func (x *AddReplicationEntriesRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddReplicationEntriesRequest.ProtoReflect.Descriptor instead.
 func (*AddReplicationEntriesRequest) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{34}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *AddReplicationEntriesRequest) GetEntries() []*AddReplicationEntriesRequest_Entry {
@@ -1839,7 +2089,7 @@ type AddReplicationEntriesReply struct {
 func (x *AddReplicationEntriesReply) Reset() {
 	*x = AddReplicationEntriesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[35]
+		mi := &file_hub_to_agent_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -1852,7 +2102,7 @@ func (x *AddReplicationEntriesReply) String() string {
 func (*AddReplicationEntriesReply) ProtoMessage() {}
 
 func (x *AddReplicationEntriesReply) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[35]
+	mi := &file_hub_to_agent_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1865,37 +2115,34 @@ func (x *AddReplicationEntriesReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddReplicationEntriesReply.ProtoReflect.Descriptor instead.
 func (*AddReplicationEntriesReply) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{35}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{39}
 }
 
-type CheckDiskSpaceReply_DiskUsage struct {
+type VerifyRevertActionsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Fs        string `protobuf:"bytes,1,opt,name=fs,proto3" json:"fs,omitempty"`
-	Host      string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
-	Available uint64 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
-	Required  uint64 `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	Actions []*RevertAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
 }
 
-func (x *CheckDiskSpaceReply_DiskUsage) Reset() {
-	*x = CheckDiskSpaceReply_DiskUsage{}
+func (x *VerifyRevertActionsRequest) Reset() {
+	*x = VerifyRevertActionsRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[37]
+		mi := &file_hub_to_agent_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CheckDiskSpaceReply_DiskUsage) String() string {
+func (x *VerifyRevertActionsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckDiskSpaceReply_DiskUsage) ProtoMessage() {}
+func (*VerifyRevertActionsRequest) ProtoMessage() {}
 
-func (x *CheckDiskSpaceReply_DiskUsage) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[37]
+func (x *VerifyRevertActionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1906,68 +2153,43 @@ func (x *CheckDiskSpaceReply_DiskUsage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckDiskSpaceReply_DiskUsage.ProtoReflect.Descriptor instead.
-func (*CheckDiskSpaceReply_DiskUsage) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{22, 0}
-}
-
-func (x *CheckDiskSpaceReply_DiskUsage) GetFs() string {
-	if x != nil {
-		return x.Fs
-	}
-	return ""
-}
-
-func (x *CheckDiskSpaceReply_DiskUsage) GetHost() string {
-	if x != nil {
-		return x.Host
-	}
-	return ""
-}
-
-func (x *CheckDiskSpaceReply_DiskUsage) GetAvailable() uint64 {
-	if x != nil {
-		return x.Available
-	}
-	return 0
+// Deprecated: Use VerifyRevertActionsRequest.ProtoReflect.Descriptor instead.
+func (*VerifyRevertActionsRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *CheckDiskSpaceReply_DiskUsage) GetRequired() uint64 {
+func (x *VerifyRevertActionsRequest) GetActions() []*RevertAction {
 	if x != nil {
-		return x.Required
+		return x.Actions
 	}
-	return 0
+	return nil
 }
 
-type RsyncRequest_RsyncOptions struct {
+type VerifyRevertActionsReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sources         []string `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
-	DestinationHost string   `protobuf:"bytes,2,opt,name=destinationHost,proto3" json:"destinationHost,omitempty"`
-	Destination     string   `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
-	Options         []string `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
-	ExcludedFiles   []string `protobuf:"bytes,5,rep,name=excludedFiles,proto3" json:"excludedFiles,omitempty"`
+	Actions []*RevertAction `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
 }
 
-func (x *RsyncRequest_RsyncOptions) Reset() {
-	*x = RsyncRequest_RsyncOptions{}
+func (x *VerifyRevertActionsReply) Reset() {
+	*x = VerifyRevertActionsReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[38]
+		mi := &file_hub_to_agent_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RsyncRequest_RsyncOptions) String() string {
+func (x *VerifyRevertActionsReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RsyncRequest_RsyncOptions) ProtoMessage() {}
+func (*VerifyRevertActionsReply) ProtoMessage() {}
 
-func (x *RsyncRequest_RsyncOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[38]
+func (x *VerifyRevertActionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1978,72 +2200,90 @@ func (x *RsyncRequest_RsyncOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RsyncRequest_RsyncOptions.ProtoReflect.Descriptor instead.
-func (*RsyncRequest_RsyncOptions) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{23, 0}
+// Deprecated: Use VerifyRevertActionsReply.ProtoReflect.Descriptor instead.
+func (*VerifyRevertActionsReply) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *RsyncRequest_RsyncOptions) GetSources() []string {
+func (x *VerifyRevertActionsReply) GetActions() []*RevertAction {
 	if x != nil {
-		return x.Sources
+		return x.Actions
 	}
 	return nil
 }
 
-func (x *RsyncRequest_RsyncOptions) GetDestinationHost() string {
-	if x != nil {
-		return x.DestinationHost
-	}
-	return ""
+type FindLinkedDataDirectoriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Datadirs []string `protobuf:"bytes,1,rep,name=datadirs,proto3" json:"datadirs,omitempty"`
 }
 
-func (x *RsyncRequest_RsyncOptions) GetDestination() string {
-	if x != nil {
-		return x.Destination
+func (x *FindLinkedDataDirectoriesRequest) Reset() {
+	*x = FindLinkedDataDirectoriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *RsyncRequest_RsyncOptions) GetOptions() []string {
-	if x != nil {
-		return x.Options
+func (x *FindLinkedDataDirectoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FindLinkedDataDirectoriesRequest) ProtoMessage() {}
+
+func (x *FindLinkedDataDirectoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RsyncRequest_RsyncOptions) GetExcludedFiles() []string {
+// Deprecated: Use FindLinkedDataDirectoriesRequest.ProtoReflect.Descriptor instead.
+func (*FindLinkedDataDirectoriesRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *FindLinkedDataDirectoriesRequest) GetDatadirs() []string {
 	if x != nil {
-		return x.ExcludedFiles
+		return x.Datadirs
 	}
 	return nil
 }
 
-type RenameTablespacesRequest_RenamePair struct {
+type FindLinkedDataDirectoriesReply struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
-	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+	Datadirs []string `protobuf:"bytes,1,rep,name=datadirs,proto3" json:"datadirs,omitempty"`
 }
 
-func (x *RenameTablespacesRequest_RenamePair) Reset() {
-	*x = RenameTablespacesRequest_RenamePair{}
+func (x *FindLinkedDataDirectoriesReply) Reset() {
+	*x = FindLinkedDataDirectoriesReply{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[39]
+		mi := &file_hub_to_agent_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RenameTablespacesRequest_RenamePair) String() string {
+func (x *FindLinkedDataDirectoriesReply) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RenameTablespacesRequest_RenamePair) ProtoMessage() {}
+func (*FindLinkedDataDirectoriesReply) ProtoMessage() {}
 
-func (x *RenameTablespacesRequest_RenamePair) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[39]
+func (x *FindLinkedDataDirectoriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2054,23 +2294,1044 @@ func (x *RenameTablespacesRequest_RenamePair) ProtoReflect() protoreflect.Messag
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RenameTablespacesRequest_RenamePair.ProtoReflect.Descriptor instead.
-func (*RenameTablespacesRequest_RenamePair) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{30, 0}
+// Deprecated: Use FindLinkedDataDirectoriesReply.ProtoReflect.Descriptor instead.
+func (*FindLinkedDataDirectoriesReply) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *RenameTablespacesRequest_RenamePair) GetSource() string {
+func (x *FindLinkedDataDirectoriesReply) GetDatadirs() []string {
 	if x != nil {
-		return x.Source
+		return x.Datadirs
 	}
-	return ""
+	return nil
 }
 
-func (x *RenameTablespacesRequest_RenamePair) GetDestination() string {
-	if x != nil {
-		return x.Destination
-	}
-	return ""
+type SnapshotSettings struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Provider        string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	SnapshotCommand string `protobuf:"bytes,2,opt,name=snapshotCommand,proto3" json:"snapshotCommand,omitempty"`
+	RestoreCommand  string `protobuf:"bytes,3,opt,name=restoreCommand,proto3" json:"restoreCommand,omitempty"`
+	DeleteCommand   string `protobuf:"bytes,4,opt,name=deleteCommand,proto3" json:"deleteCommand,omitempty"`
+}
+
+func (x *SnapshotSettings) Reset() {
+	*x = SnapshotSettings{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotSettings) ProtoMessage() {}
+
+func (x *SnapshotSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotSettings.ProtoReflect.Descriptor instead.
+func (*SnapshotSettings) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *SnapshotSettings) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
+func (x *SnapshotSettings) GetSnapshotCommand() string {
+	if x != nil {
+		return x.SnapshotCommand
+	}
+	return ""
+}
+
+func (x *SnapshotSettings) GetRestoreCommand() string {
+	if x != nil {
+		return x.RestoreCommand
+	}
+	return ""
+}
+
+func (x *SnapshotSettings) GetDeleteCommand() string {
+	if x != nil {
+		return x.DeleteCommand
+	}
+	return ""
+}
+
+type SnapshotDirectory struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (x *SnapshotDirectory) Reset() {
+	*x = SnapshotDirectory{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotDirectory) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotDirectory) ProtoMessage() {}
+
+func (x *SnapshotDirectory) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotDirectory.ProtoReflect.Descriptor instead.
+func (*SnapshotDirectory) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SnapshotDirectory) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *SnapshotDirectory) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type SnapshotDirectoriesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Action      SnapshotDirectoriesRequest_Action `protobuf:"varint,1,opt,name=action,proto3,enum=idl.SnapshotDirectoriesRequest_Action" json:"action,omitempty"`
+	Settings    *SnapshotSettings                 `protobuf:"bytes,2,opt,name=settings,proto3" json:"settings,omitempty"`
+	BackupDir   string                            `protobuf:"bytes,3,opt,name=backupDir,proto3" json:"backupDir,omitempty"`
+	Directories []*SnapshotDirectory              `protobuf:"bytes,4,rep,name=directories,proto3" json:"directories,omitempty"`
+}
+
+func (x *SnapshotDirectoriesRequest) Reset() {
+	*x = SnapshotDirectoriesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotDirectoriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotDirectoriesRequest) ProtoMessage() {}
+
+func (x *SnapshotDirectoriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotDirectoriesRequest.ProtoReflect.Descriptor instead.
+func (*SnapshotDirectoriesRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SnapshotDirectoriesRequest) GetAction() SnapshotDirectoriesRequest_Action {
+	if x != nil {
+		return x.Action
+	}
+	return SnapshotDirectoriesRequest_unknown_action
+}
+
+func (x *SnapshotDirectoriesRequest) GetSettings() *SnapshotSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+func (x *SnapshotDirectoriesRequest) GetBackupDir() string {
+	if x != nil {
+		return x.BackupDir
+	}
+	return ""
+}
+
+func (x *SnapshotDirectoriesRequest) GetDirectories() []*SnapshotDirectory {
+	if x != nil {
+		return x.Directories
+	}
+	return nil
+}
+
+type SnapshotDirectoriesReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SnapshotDirectoriesReply) Reset() {
+	*x = SnapshotDirectoriesReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SnapshotDirectoriesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SnapshotDirectoriesReply) ProtoMessage() {}
+
+func (x *SnapshotDirectoriesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SnapshotDirectoriesReply.ProtoReflect.Descriptor instead.
+func (*SnapshotDirectoriesReply) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{47}
+}
+
+// ManifestEntry describes a file or symbolic link of a directory by its path
+// relative to the directory.
+type ManifestEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Size     int64  `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	Checksum string `protobuf:"bytes,3,opt,name=checksum,proto3" json:"checksum,omitempty"`
+	Link     string `protobuf:"bytes,4,opt,name=link,proto3" json:"link,omitempty"`
+}
+
+func (x *ManifestEntry) Reset() {
+	*x = ManifestEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ManifestEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ManifestEntry) ProtoMessage() {}
+
+func (x *ManifestEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ManifestEntry.ProtoReflect.Descriptor instead.
+func (*ManifestEntry) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *ManifestEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ManifestEntry) GetSize() int64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *ManifestEntry) GetChecksum() string {
+	if x != nil {
+		return x.Checksum
+	}
+	return ""
+}
+
+func (x *ManifestEntry) GetLink() string {
+	if x != nil {
+		return x.Link
+	}
+	return ""
+}
+
+// VerifyCoordinatorBackupRequest is streamed in batches of manifest entries
+// since the manifest of a large master exceeds the maximum message size. The
+// backup directory is set on the first request.
+type VerifyCoordinatorBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackupDir     string           `protobuf:"bytes,1,opt,name=backupDir,proto3" json:"backupDir,omitempty"`
+	DataDirectory []*ManifestEntry `protobuf:"bytes,2,rep,name=dataDirectory,proto3" json:"dataDirectory,omitempty"`
+	Tablespaces   []*ManifestEntry `protobuf:"bytes,3,rep,name=tablespaces,proto3" json:"tablespaces,omitempty"`
+}
+
+func (x *VerifyCoordinatorBackupRequest) Reset() {
+	*x = VerifyCoordinatorBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCoordinatorBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCoordinatorBackupRequest) ProtoMessage() {}
+
+func (x *VerifyCoordinatorBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCoordinatorBackupRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCoordinatorBackupRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *VerifyCoordinatorBackupRequest) GetBackupDir() string {
+	if x != nil {
+		return x.BackupDir
+	}
+	return ""
+}
+
+func (x *VerifyCoordinatorBackupRequest) GetDataDirectory() []*ManifestEntry {
+	if x != nil {
+		return x.DataDirectory
+	}
+	return nil
+}
+
+func (x *VerifyCoordinatorBackupRequest) GetTablespaces() []*ManifestEntry {
+	if x != nil {
+		return x.Tablespaces
+	}
+	return nil
+}
+
+type VerifyCoordinatorBackupReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VerifyCoordinatorBackupReply) Reset() {
+	*x = VerifyCoordinatorBackupReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCoordinatorBackupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCoordinatorBackupReply) ProtoMessage() {}
+
+func (x *VerifyCoordinatorBackupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCoordinatorBackupReply.ProtoReflect.Descriptor instead.
+func (*VerifyCoordinatorBackupReply) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{50}
+}
+
+// RelayCoordinatorBackupRequest copies the coordinator backup in the backup
+// directory of the agent to the backup directory of each target host.
+type RelayCoordinatorBackupRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BackupDir string                                  `protobuf:"bytes,1,opt,name=backupDir,proto3" json:"backupDir,omitempty"`
+	Targets   []*RelayCoordinatorBackupRequest_Target `protobuf:"bytes,2,rep,name=targets,proto3" json:"targets,omitempty"`
+}
+
+func (x *RelayCoordinatorBackupRequest) Reset() {
+	*x = RelayCoordinatorBackupRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayCoordinatorBackupRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayCoordinatorBackupRequest) ProtoMessage() {}
+
+func (x *RelayCoordinatorBackupRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayCoordinatorBackupRequest.ProtoReflect.Descriptor instead.
+func (*RelayCoordinatorBackupRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *RelayCoordinatorBackupRequest) GetBackupDir() string {
+	if x != nil {
+		return x.BackupDir
+	}
+	return ""
+}
+
+func (x *RelayCoordinatorBackupRequest) GetTargets() []*RelayCoordinatorBackupRequest_Target {
+	if x != nil {
+		return x.Targets
+	}
+	return nil
+}
+
+type RelayCoordinatorBackupReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RelayCoordinatorBackupReply) Reset() {
+	*x = RelayCoordinatorBackupReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayCoordinatorBackupReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayCoordinatorBackupReply) ProtoMessage() {}
+
+func (x *RelayCoordinatorBackupReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayCoordinatorBackupReply.ProtoReflect.Descriptor instead.
+func (*RelayCoordinatorBackupReply) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{52}
+}
+
+// CloneDirectories is a source directory whose files are cloned into the
+// target directory by pg_upgrade in clone mode.
+type CloneDirectories struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (x *CloneDirectories) Reset() {
+	*x = CloneDirectories{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[53]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CloneDirectories) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloneDirectories) ProtoMessage() {}
+
+func (x *CloneDirectories) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[53]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloneDirectories.ProtoReflect.Descriptor instead.
+func (*CloneDirectories) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CloneDirectories) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *CloneDirectories) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+type CheckCloneSupportRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Directories []*CloneDirectories `protobuf:"bytes,1,rep,name=directories,proto3" json:"directories,omitempty"`
+}
+
+func (x *CheckCloneSupportRequest) Reset() {
+	*x = CheckCloneSupportRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[54]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckCloneSupportRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCloneSupportRequest) ProtoMessage() {}
+
+func (x *CheckCloneSupportRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[54]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCloneSupportRequest.ProtoReflect.Descriptor instead.
+func (*CheckCloneSupportRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *CheckCloneSupportRequest) GetDirectories() []*CloneDirectories {
+	if x != nil {
+		return x.Directories
+	}
+	return nil
+}
+
+type CheckCloneSupportReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *CheckCloneSupportReply) Reset() {
+	*x = CheckCloneSupportReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[55]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckCloneSupportReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckCloneSupportReply) ProtoMessage() {}
+
+func (x *CheckCloneSupportReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[55]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckCloneSupportReply.ProtoReflect.Descriptor instead.
+func (*CheckCloneSupportReply) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{55}
+}
+
+type GetHostInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *GetHostInfoRequest) Reset() {
+	*x = GetHostInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHostInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostInfoRequest) ProtoMessage() {}
+
+func (x *GetHostInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetHostInfoRequest) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{56}
+}
+
+// GetHostInfoReply describes the resources of the agent host used to size
+// the pg_upgrade jobs of its primaries.
+type GetHostInfoReply struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CpuCount int32 `protobuf:"varint,1,opt,name=cpuCount,proto3" json:"cpuCount,omitempty"`
+}
+
+func (x *GetHostInfoReply) Reset() {
+	*x = GetHostInfoReply{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetHostInfoReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostInfoReply) ProtoMessage() {}
+
+func (x *GetHostInfoReply) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostInfoReply.ProtoReflect.Descriptor instead.
+func (*GetHostInfoReply) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetHostInfoReply) GetCpuCount() int32 {
+	if x != nil {
+		return x.CpuCount
+	}
+	return 0
+}
+
+type CheckDiskSpaceReply_DiskUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fs        string `protobuf:"bytes,1,opt,name=fs,proto3" json:"fs,omitempty"`
+	Host      string `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Available uint64 `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+	Required  uint64 `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+}
+
+func (x *CheckDiskSpaceReply_DiskUsage) Reset() {
+	*x = CheckDiskSpaceReply_DiskUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CheckDiskSpaceReply_DiskUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDiskSpaceReply_DiskUsage) ProtoMessage() {}
+
+func (x *CheckDiskSpaceReply_DiskUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDiskSpaceReply_DiskUsage.ProtoReflect.Descriptor instead.
+func (*CheckDiskSpaceReply_DiskUsage) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{24, 0}
+}
+
+func (x *CheckDiskSpaceReply_DiskUsage) GetFs() string {
+	if x != nil {
+		return x.Fs
+	}
+	return ""
+}
+
+func (x *CheckDiskSpaceReply_DiskUsage) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *CheckDiskSpaceReply_DiskUsage) GetAvailable() uint64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *CheckDiskSpaceReply_DiskUsage) GetRequired() uint64 {
+	if x != nil {
+		return x.Required
+	}
+	return 0
+}
+
+type GetDiskUsageReply_FilesystemUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Fs        string   `protobuf:"bytes,1,opt,name=fs,proto3" json:"fs,omitempty"`
+	Host      string   `protobuf:"bytes,2,opt,name=host,proto3" json:"host,omitempty"`
+	Used      uint64   `protobuf:"varint,3,opt,name=used,proto3" json:"used,omitempty"`
+	Available uint64   `protobuf:"varint,4,opt,name=available,proto3" json:"available,omitempty"`
+	Total     uint64   `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Dirs      []string `protobuf:"bytes,6,rep,name=dirs,proto3" json:"dirs,omitempty"`
+}
+
+func (x *GetDiskUsageReply_FilesystemUsage) Reset() {
+	*x = GetDiskUsageReply_FilesystemUsage{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiskUsageReply_FilesystemUsage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiskUsageReply_FilesystemUsage) ProtoMessage() {}
+
+func (x *GetDiskUsageReply_FilesystemUsage) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiskUsageReply_FilesystemUsage.ProtoReflect.Descriptor instead.
+func (*GetDiskUsageReply_FilesystemUsage) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{26, 0}
+}
+
+func (x *GetDiskUsageReply_FilesystemUsage) GetFs() string {
+	if x != nil {
+		return x.Fs
+	}
+	return ""
+}
+
+func (x *GetDiskUsageReply_FilesystemUsage) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *GetDiskUsageReply_FilesystemUsage) GetUsed() uint64 {
+	if x != nil {
+		return x.Used
+	}
+	return 0
+}
+
+func (x *GetDiskUsageReply_FilesystemUsage) GetAvailable() uint64 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *GetDiskUsageReply_FilesystemUsage) GetTotal() uint64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetDiskUsageReply_FilesystemUsage) GetDirs() []string {
+	if x != nil {
+		return x.Dirs
+	}
+	return nil
+}
+
+type RsyncRequest_RsyncOptions struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Sources         []string `protobuf:"bytes,1,rep,name=sources,proto3" json:"sources,omitempty"`
+	DestinationHost string   `protobuf:"bytes,2,opt,name=destinationHost,proto3" json:"destinationHost,omitempty"`
+	Destination     string   `protobuf:"bytes,3,opt,name=destination,proto3" json:"destination,omitempty"`
+	Options         []string `protobuf:"bytes,4,rep,name=options,proto3" json:"options,omitempty"`
+	ExcludedFiles   []string `protobuf:"bytes,5,rep,name=excludedFiles,proto3" json:"excludedFiles,omitempty"`
+}
+
+func (x *RsyncRequest_RsyncOptions) Reset() {
+	*x = RsyncRequest_RsyncOptions{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RsyncRequest_RsyncOptions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RsyncRequest_RsyncOptions) ProtoMessage() {}
+
+func (x *RsyncRequest_RsyncOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RsyncRequest_RsyncOptions.ProtoReflect.Descriptor instead.
+func (*RsyncRequest_RsyncOptions) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{27, 0}
+}
+
+func (x *RsyncRequest_RsyncOptions) GetSources() []string {
+	if x != nil {
+		return x.Sources
+	}
+	return nil
+}
+
+func (x *RsyncRequest_RsyncOptions) GetDestinationHost() string {
+	if x != nil {
+		return x.DestinationHost
+	}
+	return ""
+}
+
+func (x *RsyncRequest_RsyncOptions) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
+}
+
+func (x *RsyncRequest_RsyncOptions) GetOptions() []string {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+func (x *RsyncRequest_RsyncOptions) GetExcludedFiles() []string {
+	if x != nil {
+		return x.ExcludedFiles
+	}
+	return nil
+}
+
+type RenameTablespacesRequest_RenamePair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Source      string `protobuf:"bytes,1,opt,name=source,proto3" json:"source,omitempty"`
+	Destination string `protobuf:"bytes,2,opt,name=destination,proto3" json:"destination,omitempty"`
+}
+
+func (x *RenameTablespacesRequest_RenamePair) Reset() {
+	*x = RenameTablespacesRequest_RenamePair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RenameTablespacesRequest_RenamePair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RenameTablespacesRequest_RenamePair) ProtoMessage() {}
+
+func (x *RenameTablespacesRequest_RenamePair) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RenameTablespacesRequest_RenamePair.ProtoReflect.Descriptor instead.
+func (*RenameTablespacesRequest_RenamePair) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{34, 0}
+}
+
+func (x *RenameTablespacesRequest_RenamePair) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+func (x *RenameTablespacesRequest_RenamePair) GetDestination() string {
+	if x != nil {
+		return x.Destination
+	}
+	return ""
 }
 
 type CreateRecoveryConfRequest_Connection struct {
@@ -2087,7 +3348,7 @@ type CreateRecoveryConfRequest_Connection struct {
 func (x *CreateRecoveryConfRequest_Connection) Reset() {
 	*x = CreateRecoveryConfRequest_Connection{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[40]
+		mi := &file_hub_to_agent_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2100,7 +3361,7 @@ func (x *CreateRecoveryConfRequest_Connection) String() string {
 func (*CreateRecoveryConfRequest_Connection) ProtoMessage() {}
 
 func (x *CreateRecoveryConfRequest_Connection) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[40]
+	mi := &file_hub_to_agent_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2113,7 +3374,7 @@ func (x *CreateRecoveryConfRequest_Connection) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use CreateRecoveryConfRequest_Connection.ProtoReflect.Descriptor instead.
 func (*CreateRecoveryConfRequest_Connection) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{32, 0}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{36, 0}
 }
 
 func (x *CreateRecoveryConfRequest_Connection) GetMirrorDataDir() string {
@@ -2157,7 +3418,7 @@ type AddReplicationEntriesRequest_Entry struct {
 func (x *AddReplicationEntriesRequest_Entry) Reset() {
 	*x = AddReplicationEntriesRequest_Entry{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_hub_to_agent_proto_msgTypes[41]
+		mi := &file_hub_to_agent_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -2170,7 +3431,7 @@ func (x *AddReplicationEntriesRequest_Entry) String() string {
 func (*AddReplicationEntriesRequest_Entry) ProtoMessage() {}
 
 func (x *AddReplicationEntriesRequest_Entry) ProtoReflect() protoreflect.Message {
-	mi := &file_hub_to_agent_proto_msgTypes[41]
+	mi := &file_hub_to_agent_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2183,7 +3444,7 @@ func (x *AddReplicationEntriesRequest_Entry) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use AddReplicationEntriesRequest_Entry.ProtoReflect.Descriptor instead.
 func (*AddReplicationEntriesRequest_Entry) Descriptor() ([]byte, []int) {
-	return file_hub_to_agent_proto_rawDescGZIP(), []int{34, 0}
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{38, 0}
 }
 
 func (x *AddReplicationEntriesRequest_Entry) GetDataDir() string {
@@ -2207,6 +3468,61 @@ func (x *AddReplicationEntriesRequest_Entry) GetHostAddrs() []string {
 	return nil
 }
 
+type RelayCoordinatorBackupRequest_Target struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Host      string `protobuf:"bytes,1,opt,name=host,proto3" json:"host,omitempty"`
+	BackupDir string `protobuf:"bytes,2,opt,name=backupDir,proto3" json:"backupDir,omitempty"`
+}
+
+func (x *RelayCoordinatorBackupRequest_Target) Reset() {
+	*x = RelayCoordinatorBackupRequest_Target{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_hub_to_agent_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RelayCoordinatorBackupRequest_Target) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayCoordinatorBackupRequest_Target) ProtoMessage() {}
+
+func (x *RelayCoordinatorBackupRequest_Target) ProtoReflect() protoreflect.Message {
+	mi := &file_hub_to_agent_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayCoordinatorBackupRequest_Target.ProtoReflect.Descriptor instead.
+func (*RelayCoordinatorBackupRequest_Target) Descriptor() ([]byte, []int) {
+	return file_hub_to_agent_proto_rawDescGZIP(), []int{51, 0}
+}
+
+func (x *RelayCoordinatorBackupRequest_Target) GetHost() string {
+	if x != nil {
+		return x.Host
+	}
+	return ""
+}
+
+func (x *RelayCoordinatorBackupRequest_Target) GetBackupDir() string {
+	if x != nil {
+		return x.BackupDir
+	}
+	return ""
+}
+
 var File_hub_to_agent_proto protoreflect.FileDescriptor
 
 var file_hub_to_agent_proto_rawDesc = []byte{
@@ -2309,219 +3625,396 @@ var file_hub_to_agent_proto_rawDesc = []byte{
 	0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70,
 	0x44, 0x69, 0x72, 0x22, 0x1c, 0x0a, 0x1a, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63,
 	0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x2d, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x72, 0x73,
-	0x22, 0x17, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x42, 0x0a, 0x1a, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x6c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x22, 0x1a, 0x0a,
-	0x18, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x43, 0x0a, 0x11, 0x52, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16,
-	0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
-	0x53, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0x46,
-	0x0a, 0x18, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x44, 0x69,
-	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52,
-	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x04, 0x44, 0x69, 0x72, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
-	0x22, 0x12, 0x0a, 0x10, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x10, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x67, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x58, 0x0a, 0x1c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53,
-	0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x6b, 0x46, 0x72,
-	0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64,
-	0x69, 0x73, 0x6b, 0x46, 0x72, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x12, 0x0a, 0x04,
-	0x64, 0x69, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x72, 0x73,
-	0x22, 0xbc, 0x01, 0x0a, 0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x75, 0x73, 0x61, 0x67,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x2e, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75, 0x73,
-	0x61, 0x67, 0x65, 0x73, 0x1a, 0x69, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67,
-	0x65, 0x12, 0x0e, 0x0a, 0x02, 0x66, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x66,
-	0x73, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62,
-	0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
-	0x62, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22,
-	0xff, 0x01, 0x0a, 0x0c, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x38, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xb4, 0x01, 0x0a, 0x0c, 0x52,
-	0x73, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x73,
-	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73, 0x6f,
-	0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
-	0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65,
-	0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65,
-	0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x35, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x67, 0x43, 0x6f, 0x6e, 0x74,
-	0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61,
-	0x74, 0x61, 0x64, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61,
-	0x74, 0x61, 0x64, 0x69, 0x72, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x50, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x67, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e,
-	0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70,
-	0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61, 0x63,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65, 0x70,
-	0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1a, 0x0a, 0x18,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xae, 0x01, 0x0a, 0x18, 0x52, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0b, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50,
-	0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x64, 0x6c,
-	0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65,
-	0x50, 0x61, 0x69, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x69, 0x72,
-	0x73, 0x1a, 0x46, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x69, 0x72, 0x12,
+	0x79, 0x22, 0x4c, 0x0a, 0x1a, 0x4d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
 	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69,
-	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x6e,
-	0x61, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0xf5, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x8a,
-	0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24, 0x0a,
-	0x0d, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61,
-	0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61,
-	0x72, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x72,
-	0x69, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69,
-	0x6d, 0x61, 0x72, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b,
-	0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e,
-	0x66, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xb6, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x52, 0x65,
-	0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x41,
-	0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x53, 0x0a, 0x05, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a,
-	0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x73, 0x65,
-	0x72, 0x12, 0x1c, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18, 0x03,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x22,
-	0x1c, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x32, 0x9f, 0x0b,
-	0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x5d, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
-	0x12, 0x21, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
-	0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44,
-	0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12, 0x21, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43,
-	0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x67, 0x6d, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53,
-	0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x64,
-	0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x55, 0x70, 0x67, 0x72, 0x61,
-	0x64, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x69, 0x64,
-	0x6c, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
-	0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x69,
-	0x64, 0x6c, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x64,
-	0x6c, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x74,
-	0x6f, 0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x15, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x74,
-	0x6f, 0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13,
-	0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44,
-	0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21,
-	0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61,
-	0x74, 0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70,
-	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61,
-	0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e,
-	0x69, 0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63,
-	0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x20, 0x2e, 0x69, 0x64,
-	0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e,
-	0x69, 0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
-	0x59, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c,
-	0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65,
-	0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69,
-	0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x13, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x79, 0x12, 0x1f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c,
-	0x6f, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x4c, 0x6f, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c,
-	0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x14, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x44, 0x61, 0x74, 0x61,
-	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x69, 0x64,
-	0x6c, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
-	0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22,
-	0x00, 0x12, 0x42, 0x0a, 0x1a, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73,
-	0x70, 0x61, 0x63, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
-	0x11, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65,
-	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x50, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72,
-	0x6f, 0x6c, 0x12, 0x1c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x50, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x67,
-	0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57,
-	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x55, 0x70, 0x64,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22,
+	0x1a, 0x0a, 0x18, 0x4d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x2d, 0x0a, 0x17, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x72, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x44, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x42, 0x0a, 0x1a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x6f,
+	0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x6f, 0x67, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x44,
+	0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x6f, 0x67, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x44, 0x69, 0x72, 0x22, 0x1a, 0x0a, 0x18, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x4c, 0x6f, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x65, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x53, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+	0x12, 0x16, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x22, 0x46, 0x0a, 0x18, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x04, 0x44, 0x69, 0x72, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x04, 0x44, 0x69,
+	0x72, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x12, 0x0a, 0x10,
+	0x53, 0x74, 0x6f, 0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0x10, 0x0a, 0x0e, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x58, 0x0a, 0x1c, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x6b, 0x46, 0x72, 0x65, 0x65, 0x52, 0x61,
+	0x74, 0x69, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x6b, 0x46,
+	0x72, 0x65, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x72, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x72, 0x73, 0x22, 0xbc, 0x01, 0x0a,
+	0x13, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x3a, 0x0a, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b,
+	0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x2e, 0x44,
+	0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x1a, 0x69, 0x0a, 0x09, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x66, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x66, 0x73, 0x12, 0x12, 0x0a,
+	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
+	0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22, 0x29, 0x0a, 0x13, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x64, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x04, 0x64, 0x69, 0x72, 0x73, 0x22, 0xe7, 0x01, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x06,
+	0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69,
+	0x64, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x06, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x1a, 0x91, 0x01, 0x0a,
+	0x0f, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x0e, 0x0a, 0x02, 0x66, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x66, 0x73,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x04, 0x75, 0x73, 0x65, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x61, 0x76, 0x61,
+	0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x12, 0x0a, 0x04,
+	0x64, 0x69, 0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x64, 0x69, 0x72, 0x73,
+	0x22, 0xff, 0x01, 0x0a, 0x0c, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x38, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0xb4, 0x01, 0x0a, 0x0c,
+	0x52, 0x73, 0x79, 0x6e, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18, 0x0a, 0x07,
+	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x07, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0f, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x48, 0x6f, 0x73, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0d,
+	0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x46, 0x69, 0x6c, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x78, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x64, 0x46, 0x69, 0x6c,
+	0x65, 0x73, 0x22, 0x0c, 0x0a, 0x0a, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x35, 0x0a, 0x17, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x50, 0x67, 0x43, 0x6f, 0x6e,
+	0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x64, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x61, 0x74, 0x61, 0x64, 0x69, 0x72, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x67, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f,
+	0x6e, 0x66, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a,
+	0x07, 0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
+	0x70, 0x61, 0x74, 0x74, 0x65, 0x72, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x61,
+	0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x72, 0x65,
+	0x70, 0x6c, 0x61, 0x63, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x22, 0x52, 0x0a, 0x1a, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d,
-	0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x69,
-	0x64, 0x6c, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70,
-	0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x34, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x1a, 0x0a,
+	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xae, 0x01, 0x0a, 0x18, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0b, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65,
+	0x50, 0x61, 0x69, 0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x69, 0x64,
 	0x6c, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61,
-	0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54, 0x0a, 0x12, 0x43, 0x72,
+	0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d,
+	0x65, 0x50, 0x61, 0x69, 0x72, 0x52, 0x0b, 0x72, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x69,
+	0x72, 0x73, 0x1a, 0x46, 0x0a, 0x0a, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x50, 0x61, 0x69, 0x72,
+	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x74,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x74, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x18, 0x0a, 0x16, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x22, 0xf5, 0x01, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x4b, 0x0a, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x72,
 	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66,
-	0x12, 0x1e, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
-	0x12, 0x5d, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a,
+	0x8a, 0x01, 0x0a, 0x0a, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x0d, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x69, 0x72, 0x72, 0x6f, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x44, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72, 0x69, 0x6d,
+	0x61, 0x72, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x70,
+	0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x48, 0x6f, 0x73, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x72,
+	0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
+	0x0b, 0x70, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x79, 0x50, 0x6f, 0x72, 0x74, 0x22, 0x19, 0x0a, 0x17,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f,
+	0x6e, 0x66, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xb6, 0x01, 0x0a, 0x1c, 0x41, 0x64, 0x64, 0x52,
+	0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x41, 0x0a, 0x07, 0x65, 0x6e, 0x74, 0x72,
+	0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
 	0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
-	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69,
-	0x64, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42,
-	0x27, 0x5a, 0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72,
-	0x65, 0x65, 0x6e, 0x70, 0x6c, 0x75, 0x6d, 0x2d, 0x64, 0x62, 0x2f, 0x67, 0x70, 0x75, 0x70, 0x67,
-	0x72, 0x61, 0x64, 0x65, 0x2f, 0x69, 0x64, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x1a, 0x53, 0x0a, 0x05, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x55, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x48, 0x6f, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x73,
+	0x22, 0x1c, 0x0a, 0x1a, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x49,
+	0x0a, 0x1a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x07,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x47, 0x0a, 0x18, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x2b, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0x3e, 0x0a, 0x20, 0x46, 0x69, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x64, 0x69,
+	0x72, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x64, 0x69,
+	0x72, 0x73, 0x22, 0x3c, 0x0a, 0x1e, 0x46, 0x69, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64,
+	0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x64, 0x69, 0x72, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x64, 0x69, 0x72, 0x73,
+	0x22, 0xa6, 0x01, 0x0a, 0x10, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x74,
+	0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65,
+	0x72, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x72,
+	0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x43, 0x6f, 0x6d, 0x6d,
+	0x61, 0x6e, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x43, 0x6f, 0x6d,
+	0x6d, 0x61, 0x6e, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x22, 0x3b, 0x0a, 0x11, 0x53, 0x6e, 0x61,
+	0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x12,
+	0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0xac, 0x02, 0x0a, 0x1a, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x6e, 0x61, 0x70,
+	0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x08, 0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x6e,
+	0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x53, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x52, 0x08,
+	0x73, 0x65, 0x74, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x62, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x44, 0x69, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x63,
+	0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x12, 0x38, 0x0a, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x64,
+	0x6c, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x22, 0x43, 0x0a, 0x06, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x0e, 0x75, 0x6e,
+	0x6b, 0x6e, 0x6f, 0x77, 0x6e, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x10, 0x00, 0x12, 0x0c,
+	0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07,
+	0x72, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x64, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x10, 0x03, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f,
+	0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x67, 0x0a, 0x0d, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74,
+	0x72, 0x79, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x04, 0x73, 0x69, 0x7a, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x65, 0x63, 0x6b, 0x73, 0x75, 0x6d, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6c, 0x69, 0x6e, 0x6b, 0x22, 0xae, 0x01, 0x0a, 0x1e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x12, 0x38, 0x0a, 0x0d, 0x64,
+	0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73,
+	0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0d, 0x64, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x34, 0x0a, 0x0b, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70,
+	0x61, 0x63, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x69, 0x64, 0x6c,
+	0x2e, 0x4d, 0x61, 0x6e, 0x69, 0x66, 0x65, 0x73, 0x74, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0b,
+	0x74, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x22, 0x1e, 0x0a, 0x1c, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0xbe, 0x01, 0x0a, 0x1d,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a,
+	0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x12, 0x43, 0x0a, 0x07, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69,
+	0x64, 0x6c, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x2e, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x52, 0x07, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x73,
+	0x1a, 0x3a, 0x0a, 0x06, 0x54, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f,
+	0x73, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x12, 0x1c,
+	0x0a, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x62, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x22, 0x1d, 0x0a, 0x1b,
+	0x52, 0x65, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x42, 0x0a, 0x10, 0x43,
+	0x6c, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22,
+	0x53, 0x0a, 0x18, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x75, 0x70,
+	0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x0b, 0x64,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x15, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x0b, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x69, 0x65, 0x73, 0x22, 0x18, 0x0a, 0x16, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6c, 0x6f,
+	0x6e, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x14,
+	0x0a, 0x12, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0x2e, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49,
+	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x70, 0x75, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x63, 0x70, 0x75, 0x43,
+	0x6f, 0x75, 0x6e, 0x74, 0x32, 0xb6, 0x11, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x12, 0x5d,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x64, 0x6c,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x4f, 0x0a,
+	0x0e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x12,
+	0x21, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x53, 0x65, 0x67, 0x6d, 0x65,
+	0x6e, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x18, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x44, 0x69,
+	0x73, 0x6b, 0x53, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42,
+	0x0a, 0x0c, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x12, 0x18,
+	0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x47,
+	0x65, 0x74, 0x44, 0x69, 0x73, 0x6b, 0x55, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x4e, 0x0a, 0x10, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x69,
+	0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x55, 0x70, 0x67,
+	0x72, 0x61, 0x64, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x51, 0x0a, 0x11, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1d, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65,
+	0x6e, 0x61, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x6e,
+	0x61, 0x6d, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x39, 0x0a, 0x09, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x12, 0x15, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x74, 0x6f, 0x70, 0x41, 0x67, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x13, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
+	0x53, 0x74, 0x6f, 0x70, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x5d, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
+	0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69,
+	0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12,
+	0x5d, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44,
+	0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x21, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x69, 0x64,
+	0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57,
+	0x0a, 0x13, 0x4d, 0x6f, 0x76, 0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x4d, 0x6f, 0x76, 0x65,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x4d, 0x6f, 0x76,
+	0x65, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x12,
+	0x20, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1e, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x1b, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x1c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54,
+	0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57,
+	0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x67, 0x44, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x1f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x4c, 0x6f, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x4c, 0x6f, 0x67, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x79,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3c, 0x0a, 0x14, 0x52, 0x73, 0x79, 0x6e, 0x63,
+	0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12,
+	0x11, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x42, 0x0a, 0x1a, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x69, 0x65, 0x73, 0x12, 0x11, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x73, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x73, 0x79,
+	0x6e, 0x63, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x19, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x50, 0x72, 0x69, 0x6d, 0x61, 0x72, 0x69, 0x65, 0x73, 0x50, 0x67, 0x43,
+	0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x12, 0x1c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x50, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x50, 0x67, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x6f, 0x6c, 0x52, 0x65, 0x70, 0x6c, 0x79,
+	0x22, 0x00, 0x12, 0x57, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x64, 0x6c,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x11, 0x52,
+	0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73,
+	0x12, 0x1d, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x62,
+	0x6c, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x54, 0x61, 0x62, 0x6c,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x54,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79,
+	0x43, 0x6f, 0x6e, 0x66, 0x12, 0x1e, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x79, 0x43, 0x6f, 0x6e, 0x66, 0x52, 0x65, 0x70,
+	0x6c, 0x79, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x15, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x21, 0x2e,
+	0x69, 0x64, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x1f, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x41, 0x64, 0x64, 0x52, 0x65, 0x70, 0x6c, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c,
+	0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x13, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x76,
+	0x65, 0x72, 0x74, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x69, 0x64, 0x6c,
+	0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x41, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x69, 0x64,
+	0x6c, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x52, 0x65, 0x76, 0x65, 0x72, 0x74, 0x41, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x69, 0x0a, 0x19,
+	0x46, 0x69, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x69, 0x64, 0x6c, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x23, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x6b, 0x65,
+	0x64, 0x44, 0x61, 0x74, 0x61, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73,
+	0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x57, 0x0a, 0x13, 0x53, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x1f,
+	0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x53, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x44, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00,
+	0x12, 0x65, 0x0a, 0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69,
+	0x6e, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x12, 0x23, 0x2e, 0x69, 0x64,
+	0x6c, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61,
+	0x74, 0x6f, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x70, 0x6c, 0x79, 0x22, 0x00, 0x28, 0x01, 0x12, 0x60, 0x0a, 0x16, 0x52, 0x65, 0x6c, 0x61, 0x79,
+	0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x75,
+	0x70, 0x12, 0x22, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x6c, 0x61, 0x79, 0x43, 0x6f, 0x6f,
+	0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x52, 0x65, 0x6c, 0x61,
+	0x79, 0x43, 0x6f, 0x6f, 0x72, 0x64, 0x69, 0x6e, 0x61, 0x74, 0x6f, 0x72, 0x42, 0x61, 0x63, 0x6b,
+	0x75, 0x70, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x11, 0x43, 0x68, 0x65,
+	0x63, 0x6b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1d,
+	0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53,
+	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e,
+	0x69, 0x64, 0x6c, 0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x53, 0x75,
+	0x70, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x12, 0x3f, 0x0a, 0x0b,
+	0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x17, 0x2e, 0x69, 0x64,
+	0x6c, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f, 0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x15, 0x2e, 0x69, 0x64, 0x6c, 0x2e, 0x47, 0x65, 0x74, 0x48, 0x6f,
+	0x73, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x70, 0x6c, 0x79, 0x22, 0x00, 0x42, 0x27, 0x5a,
+	0x25, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x72, 0x65, 0x65,
+	0x6e, 0x70, 0x6c, 0x75, 0x6d, 0x2d, 0x64, 0x62, 0x2f, 0x67, 0x70, 0x75, 0x70, 0x67, 0x72, 0x61,
+	0x64, 0x65, 0x2f, 0x69, 0x64, 0x6c, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -2536,109 +4029,163 @@ func file_hub_to_agent_proto_rawDescGZIP() []byte {
 	return file_hub_to_agent_proto_rawDescData
 }
 
-var file_hub_to_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_hub_to_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
+var file_hub_to_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
+var file_hub_to_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
 var file_hub_to_agent_proto_goTypes = []interface{}{
 	(PgOptions_PgUpgradeMode)(0),                 // 0: idl.PgOptions.PgUpgradeMode
 	(PgOptions_Action)(0),                        // 1: idl.PgOptions.Action
-	(*PgOptions)(nil),                            // 2: idl.PgOptions
-	(*TablespaceInfo)(nil),                       // 3: idl.TablespaceInfo
-	(*UpgradePrimariesRequest)(nil),              // 4: idl.UpgradePrimariesRequest
-	(*UpgradePrimariesReply)(nil),                // 5: idl.UpgradePrimariesReply
-	(*CreateBackupDirectoryRequest)(nil),         // 6: idl.CreateBackupDirectoryRequest
-	(*CreateBackupDirectoryReply)(nil),           // 7: idl.CreateBackupDirectoryReply
-	(*DeleteDataDirectoriesRequest)(nil),         // 8: idl.DeleteDataDirectoriesRequest
-	(*DeleteDataDirectoriesReply)(nil),           // 9: idl.DeleteDataDirectoriesReply
-	(*DeleteStateDirectoryRequest)(nil),          // 10: idl.DeleteStateDirectoryRequest
-	(*DeleteStateDirectoryReply)(nil),            // 11: idl.DeleteStateDirectoryReply
-	(*DeleteBackupDirectoryRequest)(nil),         // 12: idl.DeleteBackupDirectoryRequest
-	(*DeleteBackupDirectoryReply)(nil),           // 13: idl.DeleteBackupDirectoryReply
-	(*DeleteTablespaceRequest)(nil),              // 14: idl.DeleteTablespaceRequest
-	(*DeleteTablespaceReply)(nil),                // 15: idl.DeleteTablespaceReply
-	(*ArchiveLogDirectoryRequest)(nil),           // 16: idl.ArchiveLogDirectoryRequest
-	(*ArchiveLogDirectoryReply)(nil),             // 17: idl.ArchiveLogDirectoryReply
-	(*RenameDirectories)(nil),                    // 18: idl.RenameDirectories
-	(*RenameDirectoriesRequest)(nil),             // 19: idl.RenameDirectoriesRequest
-	(*RenameDirectoriesReply)(nil),               // 20: idl.RenameDirectoriesReply
-	(*StopAgentRequest)(nil),                     // 21: idl.StopAgentRequest
-	(*StopAgentReply)(nil),                       // 22: idl.StopAgentReply
-	(*CheckSegmentDiskSpaceRequest)(nil),         // 23: idl.CheckSegmentDiskSpaceRequest
-	(*CheckDiskSpaceReply)(nil),                  // 24: idl.CheckDiskSpaceReply
-	(*RsyncRequest)(nil),                         // 25: idl.RsyncRequest
-	(*RsyncReply)(nil),                           // 26: idl.RsyncReply
-	(*RestorePgControlRequest)(nil),              // 27: idl.RestorePgControlRequest
-	(*RestorePgControlReply)(nil),                // 28: idl.RestorePgControlReply
-	(*UpdateFileConfOptions)(nil),                // 29: idl.UpdateFileConfOptions
-	(*UpdateConfigurationRequest)(nil),           // 30: idl.UpdateConfigurationRequest
-	(*UpdateConfigurationReply)(nil),             // 31: idl.UpdateConfigurationReply
-	(*RenameTablespacesRequest)(nil),             // 32: idl.RenameTablespacesRequest
-	(*RenameTablespacesReply)(nil),               // 33: idl.RenameTablespacesReply
-	(*CreateRecoveryConfRequest)(nil),            // 34: idl.CreateRecoveryConfRequest
-	(*CreateRecoveryConfReply)(nil),              // 35: idl.CreateRecoveryConfReply
-	(*AddReplicationEntriesRequest)(nil),         // 36: idl.AddReplicationEntriesRequest
-	(*AddReplicationEntriesReply)(nil),           // 37: idl.AddReplicationEntriesReply
-	nil,                                          // 38: idl.PgOptions.TablespacesEntry
-	(*CheckDiskSpaceReply_DiskUsage)(nil),        // 39: idl.CheckDiskSpaceReply.DiskUsage
-	(*RsyncRequest_RsyncOptions)(nil),            // 40: idl.RsyncRequest.RsyncOptions
-	(*RenameTablespacesRequest_RenamePair)(nil),  // 41: idl.RenameTablespacesRequest.RenamePair
-	(*CreateRecoveryConfRequest_Connection)(nil), // 42: idl.CreateRecoveryConfRequest.Connection
-	(*AddReplicationEntriesRequest_Entry)(nil),   // 43: idl.AddReplicationEntriesRequest.Entry
-	(Mode)(0), // 44: idl.Mode
+	(SnapshotDirectoriesRequest_Action)(0),       // 2: idl.SnapshotDirectoriesRequest.Action
+	(*PgOptions)(nil),                            // 3: idl.PgOptions
+	(*TablespaceInfo)(nil),                       // 4: idl.TablespaceInfo
+	(*UpgradePrimariesRequest)(nil),              // 5: idl.UpgradePrimariesRequest
+	(*UpgradePrimariesReply)(nil),                // 6: idl.UpgradePrimariesReply
+	(*CreateBackupDirectoryRequest)(nil),         // 7: idl.CreateBackupDirectoryRequest
+	(*CreateBackupDirectoryReply)(nil),           // 8: idl.CreateBackupDirectoryReply
+	(*DeleteDataDirectoriesRequest)(nil),         // 9: idl.DeleteDataDirectoriesRequest
+	(*DeleteDataDirectoriesReply)(nil),           // 10: idl.DeleteDataDirectoriesReply
+	(*DeleteStateDirectoryRequest)(nil),          // 11: idl.DeleteStateDirectoryRequest
+	(*DeleteStateDirectoryReply)(nil),            // 12: idl.DeleteStateDirectoryReply
+	(*DeleteBackupDirectoryRequest)(nil),         // 13: idl.DeleteBackupDirectoryRequest
+	(*DeleteBackupDirectoryReply)(nil),           // 14: idl.DeleteBackupDirectoryReply
+	(*MoveBackupDirectoryRequest)(nil),           // 15: idl.MoveBackupDirectoryRequest
+	(*MoveBackupDirectoryReply)(nil),             // 16: idl.MoveBackupDirectoryReply
+	(*DeleteTablespaceRequest)(nil),              // 17: idl.DeleteTablespaceRequest
+	(*DeleteTablespaceReply)(nil),                // 18: idl.DeleteTablespaceReply
+	(*ArchiveLogDirectoryRequest)(nil),           // 19: idl.ArchiveLogDirectoryRequest
+	(*ArchiveLogDirectoryReply)(nil),             // 20: idl.ArchiveLogDirectoryReply
+	(*RenameDirectories)(nil),                    // 21: idl.RenameDirectories
+	(*RenameDirectoriesRequest)(nil),             // 22: idl.RenameDirectoriesRequest
+	(*RenameDirectoriesReply)(nil),               // 23: idl.RenameDirectoriesReply
+	(*StopAgentRequest)(nil),                     // 24: idl.StopAgentRequest
+	(*StopAgentReply)(nil),                       // 25: idl.StopAgentReply
+	(*CheckSegmentDiskSpaceRequest)(nil),         // 26: idl.CheckSegmentDiskSpaceRequest
+	(*CheckDiskSpaceReply)(nil),                  // 27: idl.CheckDiskSpaceReply
+	(*GetDiskUsageRequest)(nil),                  // 28: idl.GetDiskUsageRequest
+	(*GetDiskUsageReply)(nil),                    // 29: idl.GetDiskUsageReply
+	(*RsyncRequest)(nil),                         // 30: idl.RsyncRequest
+	(*RsyncReply)(nil),                           // 31: idl.RsyncReply
+	(*RestorePgControlRequest)(nil),              // 32: idl.RestorePgControlRequest
+	(*RestorePgControlReply)(nil),                // 33: idl.RestorePgControlReply
+	(*UpdateFileConfOptions)(nil),                // 34: idl.UpdateFileConfOptions
+	(*UpdateConfigurationRequest)(nil),           // 35: idl.UpdateConfigurationRequest
+	(*UpdateConfigurationReply)(nil),             // 36: idl.UpdateConfigurationReply
+	(*RenameTablespacesRequest)(nil),             // 37: idl.RenameTablespacesRequest
+	(*RenameTablespacesReply)(nil),               // 38: idl.RenameTablespacesReply
+	(*CreateRecoveryConfRequest)(nil),            // 39: idl.CreateRecoveryConfRequest
+	(*CreateRecoveryConfReply)(nil),              // 40: idl.CreateRecoveryConfReply
+	(*AddReplicationEntriesRequest)(nil),         // 41: idl.AddReplicationEntriesRequest
+	(*AddReplicationEntriesReply)(nil),           // 42: idl.AddReplicationEntriesReply
+	(*VerifyRevertActionsRequest)(nil),           // 43: idl.VerifyRevertActionsRequest
+	(*VerifyRevertActionsReply)(nil),             // 44: idl.VerifyRevertActionsReply
+	(*FindLinkedDataDirectoriesRequest)(nil),     // 45: idl.FindLinkedDataDirectoriesRequest
+	(*FindLinkedDataDirectoriesReply)(nil),       // 46: idl.FindLinkedDataDirectoriesReply
+	(*SnapshotSettings)(nil),                     // 47: idl.SnapshotSettings
+	(*SnapshotDirectory)(nil),                    // 48: idl.SnapshotDirectory
+	(*SnapshotDirectoriesRequest)(nil),           // 49: idl.SnapshotDirectoriesRequest
+	(*SnapshotDirectoriesReply)(nil),             // 50: idl.SnapshotDirectoriesReply
+	(*ManifestEntry)(nil),                        // 51: idl.ManifestEntry
+	(*VerifyCoordinatorBackupRequest)(nil),       // 52: idl.VerifyCoordinatorBackupRequest
+	(*VerifyCoordinatorBackupReply)(nil),         // 53: idl.VerifyCoordinatorBackupReply
+	(*RelayCoordinatorBackupRequest)(nil),        // 54: idl.RelayCoordinatorBackupRequest
+	(*RelayCoordinatorBackupReply)(nil),          // 55: idl.RelayCoordinatorBackupReply
+	(*CloneDirectories)(nil),                     // 56: idl.CloneDirectories
+	(*CheckCloneSupportRequest)(nil),             // 57: idl.CheckCloneSupportRequest
+	(*CheckCloneSupportReply)(nil),               // 58: idl.CheckCloneSupportReply
+	(*GetHostInfoRequest)(nil),                   // 59: idl.GetHostInfoRequest
+	(*GetHostInfoReply)(nil),                     // 60: idl.GetHostInfoReply
+	nil,                                          // 61: idl.PgOptions.TablespacesEntry
+	(*CheckDiskSpaceReply_DiskUsage)(nil),        // 62: idl.CheckDiskSpaceReply.DiskUsage
+	(*GetDiskUsageReply_FilesystemUsage)(nil),    // 63: idl.GetDiskUsageReply.FilesystemUsage
+	(*RsyncRequest_RsyncOptions)(nil),            // 64: idl.RsyncRequest.RsyncOptions
+	(*RenameTablespacesRequest_RenamePair)(nil),  // 65: idl.RenameTablespacesRequest.RenamePair
+	(*CreateRecoveryConfRequest_Connection)(nil), // 66: idl.CreateRecoveryConfRequest.Connection
+	(*AddReplicationEntriesRequest_Entry)(nil),   // 67: idl.AddReplicationEntriesRequest.Entry
+	(*RelayCoordinatorBackupRequest_Target)(nil), // 68: idl.RelayCoordinatorBackupRequest.Target
+	(Mode)(0),            // 69: idl.Mode
+	(*RevertAction)(nil), // 70: idl.RevertAction
 }
 var file_hub_to_agent_proto_depIdxs = []int32{
 	1,  // 0: idl.PgOptions.action:type_name -> idl.PgOptions.Action
 	0,  // 1: idl.PgOptions.pgUpgradeMode:type_name -> idl.PgOptions.PgUpgradeMode
-	44, // 2: idl.PgOptions.mode:type_name -> idl.Mode
-	38, // 3: idl.PgOptions.Tablespaces:type_name -> idl.PgOptions.TablespacesEntry
+	69, // 2: idl.PgOptions.mode:type_name -> idl.Mode
+	61, // 3: idl.PgOptions.Tablespaces:type_name -> idl.PgOptions.TablespacesEntry
 	1,  // 4: idl.UpgradePrimariesRequest.action:type_name -> idl.PgOptions.Action
-	2,  // 5: idl.UpgradePrimariesRequest.opts:type_name -> idl.PgOptions
-	18, // 6: idl.RenameDirectoriesRequest.Dirs:type_name -> idl.RenameDirectories
-	39, // 7: idl.CheckDiskSpaceReply.usages:type_name -> idl.CheckDiskSpaceReply.DiskUsage
-	40, // 8: idl.RsyncRequest.options:type_name -> idl.RsyncRequest.RsyncOptions
-	29, // 9: idl.UpdateConfigurationRequest.options:type_name -> idl.UpdateFileConfOptions
-	41, // 10: idl.RenameTablespacesRequest.renamePairs:type_name -> idl.RenameTablespacesRequest.RenamePair
-	42, // 11: idl.CreateRecoveryConfRequest.connections:type_name -> idl.CreateRecoveryConfRequest.Connection
-	43, // 12: idl.AddReplicationEntriesRequest.entries:type_name -> idl.AddReplicationEntriesRequest.Entry
-	3,  // 13: idl.PgOptions.TablespacesEntry.value:type_name -> idl.TablespaceInfo
-	6,  // 14: idl.Agent.CreateBackupDirectory:input_type -> idl.CreateBackupDirectoryRequest
-	23, // 15: idl.Agent.CheckDiskSpace:input_type -> idl.CheckSegmentDiskSpaceRequest
-	4,  // 16: idl.Agent.UpgradePrimaries:input_type -> idl.UpgradePrimariesRequest
-	19, // 17: idl.Agent.RenameDirectories:input_type -> idl.RenameDirectoriesRequest
-	21, // 18: idl.Agent.StopAgent:input_type -> idl.StopAgentRequest
-	8,  // 19: idl.Agent.DeleteDataDirectories:input_type -> idl.DeleteDataDirectoriesRequest
-	12, // 20: idl.Agent.DeleteBackupDirectory:input_type -> idl.DeleteBackupDirectoryRequest
-	10, // 21: idl.Agent.DeleteStateDirectory:input_type -> idl.DeleteStateDirectoryRequest
-	14, // 22: idl.Agent.DeleteTablespaceDirectories:input_type -> idl.DeleteTablespaceRequest
-	16, // 23: idl.Agent.ArchiveLogDirectory:input_type -> idl.ArchiveLogDirectoryRequest
-	25, // 24: idl.Agent.RsyncDataDirectories:input_type -> idl.RsyncRequest
-	25, // 25: idl.Agent.RsyncTablespaceDirectories:input_type -> idl.RsyncRequest
-	27, // 26: idl.Agent.RestorePrimariesPgControl:input_type -> idl.RestorePgControlRequest
-	30, // 27: idl.Agent.UpdateConfiguration:input_type -> idl.UpdateConfigurationRequest
-	32, // 28: idl.Agent.RenameTablespaces:input_type -> idl.RenameTablespacesRequest
-	34, // 29: idl.Agent.CreateRecoveryConf:input_type -> idl.CreateRecoveryConfRequest
-	36, // 30: idl.Agent.AddReplicationEntries:input_type -> idl.AddReplicationEntriesRequest
-	7,  // 31: idl.Agent.CreateBackupDirectory:output_type -> idl.CreateBackupDirectoryReply
-	24, // 32: idl.Agent.CheckDiskSpace:output_type -> idl.CheckDiskSpaceReply
-	5,  // 33: idl.Agent.UpgradePrimaries:output_type -> idl.UpgradePrimariesReply
-	20, // 34: idl.Agent.RenameDirectories:output_type -> idl.RenameDirectoriesReply
-	22, // 35: idl.Agent.StopAgent:output_type -> idl.StopAgentReply
-	9,  // 36: idl.Agent.DeleteDataDirectories:output_type -> idl.DeleteDataDirectoriesReply
-	13, // 37: idl.Agent.DeleteBackupDirectory:output_type -> idl.DeleteBackupDirectoryReply
-	11, // 38: idl.Agent.DeleteStateDirectory:output_type -> idl.DeleteStateDirectoryReply
-	15, // 39: idl.Agent.DeleteTablespaceDirectories:output_type -> idl.DeleteTablespaceReply
-	17, // 40: idl.Agent.ArchiveLogDirectory:output_type -> idl.ArchiveLogDirectoryReply
-	26, // 41: idl.Agent.RsyncDataDirectories:output_type -> idl.RsyncReply
-	26, // 42: idl.Agent.RsyncTablespaceDirectories:output_type -> idl.RsyncReply
-	28, // 43: idl.Agent.RestorePrimariesPgControl:output_type -> idl.RestorePgControlReply
-	31, // 44: idl.Agent.UpdateConfiguration:output_type -> idl.UpdateConfigurationReply
-	33, // 45: idl.Agent.RenameTablespaces:output_type -> idl.RenameTablespacesReply
-	35, // 46: idl.Agent.CreateRecoveryConf:output_type -> idl.CreateRecoveryConfReply
-	37, // 47: idl.Agent.AddReplicationEntries:output_type -> idl.AddReplicationEntriesReply
-	31, // [31:48] is the sub-list for method output_type
-	14, // [14:31] is the sub-list for method input_type
-	14, // [14:14] is the sub-list for extension type_name
-	14, // [14:14] is the sub-list for extension extendee
-	0,  // [0:14] is the sub-list for field type_name
+	3,  // 5: idl.UpgradePrimariesRequest.opts:type_name -> idl.PgOptions
+	21, // 6: idl.RenameDirectoriesRequest.Dirs:type_name -> idl.RenameDirectories
+	62, // 7: idl.CheckDiskSpaceReply.usages:type_name -> idl.CheckDiskSpaceReply.DiskUsage
+	63, // 8: idl.GetDiskUsageReply.usages:type_name -> idl.GetDiskUsageReply.FilesystemUsage
+	64, // 9: idl.RsyncRequest.options:type_name -> idl.RsyncRequest.RsyncOptions
+	34, // 10: idl.UpdateConfigurationRequest.options:type_name -> idl.UpdateFileConfOptions
+	65, // 11: idl.RenameTablespacesRequest.renamePairs:type_name -> idl.RenameTablespacesRequest.RenamePair
+	66, // 12: idl.CreateRecoveryConfRequest.connections:type_name -> idl.CreateRecoveryConfRequest.Connection
+	67, // 13: idl.AddReplicationEntriesRequest.entries:type_name -> idl.AddReplicationEntriesRequest.Entry
+	70, // 14: idl.VerifyRevertActionsRequest.actions:type_name -> idl.RevertAction
+	70, // 15: idl.VerifyRevertActionsReply.actions:type_name -> idl.RevertAction
+	2,  // 16: idl.SnapshotDirectoriesRequest.action:type_name -> idl.SnapshotDirectoriesRequest.Action
+	47, // 17: idl.SnapshotDirectoriesRequest.settings:type_name -> idl.SnapshotSettings
+	48, // 18: idl.SnapshotDirectoriesRequest.directories:type_name -> idl.SnapshotDirectory
+	51, // 19: idl.VerifyCoordinatorBackupRequest.dataDirectory:type_name -> idl.ManifestEntry
+	51, // 20: idl.VerifyCoordinatorBackupRequest.tablespaces:type_name -> idl.ManifestEntry
+	68, // 21: idl.RelayCoordinatorBackupRequest.targets:type_name -> idl.RelayCoordinatorBackupRequest.Target
+	56, // 22: idl.CheckCloneSupportRequest.directories:type_name -> idl.CloneDirectories
+	4,  // 23: idl.PgOptions.TablespacesEntry.value:type_name -> idl.TablespaceInfo
+	7,  // 24: idl.Agent.CreateBackupDirectory:input_type -> idl.CreateBackupDirectoryRequest
+	26, // 25: idl.Agent.CheckDiskSpace:input_type -> idl.CheckSegmentDiskSpaceRequest
+	28, // 26: idl.Agent.GetDiskUsage:input_type -> idl.GetDiskUsageRequest
+	5,  // 27: idl.Agent.UpgradePrimaries:input_type -> idl.UpgradePrimariesRequest
+	22, // 28: idl.Agent.RenameDirectories:input_type -> idl.RenameDirectoriesRequest
+	24, // 29: idl.Agent.StopAgent:input_type -> idl.StopAgentRequest
+	9,  // 30: idl.Agent.DeleteDataDirectories:input_type -> idl.DeleteDataDirectoriesRequest
+	13, // 31: idl.Agent.DeleteBackupDirectory:input_type -> idl.DeleteBackupDirectoryRequest
+	15, // 32: idl.Agent.MoveBackupDirectory:input_type -> idl.MoveBackupDirectoryRequest
+	11, // 33: idl.Agent.DeleteStateDirectory:input_type -> idl.DeleteStateDirectoryRequest
+	17, // 34: idl.Agent.DeleteTablespaceDirectories:input_type -> idl.DeleteTablespaceRequest
+	19, // 35: idl.Agent.ArchiveLogDirectory:input_type -> idl.ArchiveLogDirectoryRequest
+	30, // 36: idl.Agent.RsyncDataDirectories:input_type -> idl.RsyncRequest
+	30, // 37: idl.Agent.RsyncTablespaceDirectories:input_type -> idl.RsyncRequest
+	32, // 38: idl.Agent.RestorePrimariesPgControl:input_type -> idl.RestorePgControlRequest
+	35, // 39: idl.Agent.UpdateConfiguration:input_type -> idl.UpdateConfigurationRequest
+	37, // 40: idl.Agent.RenameTablespaces:input_type -> idl.RenameTablespacesRequest
+	39, // 41: idl.Agent.CreateRecoveryConf:input_type -> idl.CreateRecoveryConfRequest
+	41, // 42: idl.Agent.AddReplicationEntries:input_type -> idl.AddReplicationEntriesRequest
+	43, // 43: idl.Agent.VerifyRevertActions:input_type -> idl.VerifyRevertActionsRequest
+	45, // 44: idl.Agent.FindLinkedDataDirectories:input_type -> idl.FindLinkedDataDirectoriesRequest
+	49, // 45: idl.Agent.SnapshotDirectories:input_type -> idl.SnapshotDirectoriesRequest
+	52, // 46: idl.Agent.VerifyCoordinatorBackup:input_type -> idl.VerifyCoordinatorBackupRequest
+	54, // 47: idl.Agent.RelayCoordinatorBackup:input_type -> idl.RelayCoordinatorBackupRequest
+	57, // 48: idl.Agent.CheckCloneSupport:input_type -> idl.CheckCloneSupportRequest
+	59, // 49: idl.Agent.GetHostInfo:input_type -> idl.GetHostInfoRequest
+	8,  // 50: idl.Agent.CreateBackupDirectory:output_type -> idl.CreateBackupDirectoryReply
+	27, // 51: idl.Agent.CheckDiskSpace:output_type -> idl.CheckDiskSpaceReply
+	29, // 52: idl.Agent.GetDiskUsage:output_type -> idl.GetDiskUsageReply
+	6,  // 53: idl.Agent.UpgradePrimaries:output_type -> idl.UpgradePrimariesReply
+	23, // 54: idl.Agent.RenameDirectories:output_type -> idl.RenameDirectoriesReply
+	25, // 55: idl.Agent.StopAgent:output_type -> idl.StopAgentReply
+	10, // 56: idl.Agent.DeleteDataDirectories:output_type -> idl.DeleteDataDirectoriesReply
+	14, // 57: idl.Agent.DeleteBackupDirectory:output_type -> idl.DeleteBackupDirectoryReply
+	16, // 58: idl.Agent.MoveBackupDirectory:output_type -> idl.MoveBackupDirectoryReply
+	12, // 59: idl.Agent.DeleteStateDirectory:output_type -> idl.DeleteStateDirectoryReply
+	18, // 60: idl.Agent.DeleteTablespaceDirectories:output_type -> idl.DeleteTablespaceReply
+	20, // 61: idl.Agent.ArchiveLogDirectory:output_type -> idl.ArchiveLogDirectoryReply
+	31, // 62: idl.Agent.RsyncDataDirectories:output_type -> idl.RsyncReply
+	31, // 63: idl.Agent.RsyncTablespaceDirectories:output_type -> idl.RsyncReply
+	33, // 64: idl.Agent.RestorePrimariesPgControl:output_type -> idl.RestorePgControlReply
+	36, // 65: idl.Agent.UpdateConfiguration:output_type -> idl.UpdateConfigurationReply
+	38, // 66: idl.Agent.RenameTablespaces:output_type -> idl.RenameTablespacesReply
+	40, // 67: idl.Agent.CreateRecoveryConf:output_type -> idl.CreateRecoveryConfReply
+	42, // 68: idl.Agent.AddReplicationEntries:output_type -> idl.AddReplicationEntriesReply
+	44, // 69: idl.Agent.VerifyRevertActions:output_type -> idl.VerifyRevertActionsReply
+	46, // 70: idl.Agent.FindLinkedDataDirectories:output_type -> idl.FindLinkedDataDirectoriesReply
+	50, // 71: idl.Agent.SnapshotDirectories:output_type -> idl.SnapshotDirectoriesReply
+	53, // 72: idl.Agent.VerifyCoordinatorBackup:output_type -> idl.VerifyCoordinatorBackupReply
+	55, // 73: idl.Agent.RelayCoordinatorBackup:output_type -> idl.RelayCoordinatorBackupReply
+	58, // 74: idl.Agent.CheckCloneSupport:output_type -> idl.CheckCloneSupportReply
+	60, // 75: idl.Agent.GetHostInfo:output_type -> idl.GetHostInfoReply
+	50, // [50:76] is the sub-list for method output_type
+	24, // [24:50] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_hub_to_agent_proto_init() }
@@ -2660,8 +4207,152 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*TablespaceInfo); i {
+		file_hub_to_agent_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TablespaceInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradePrimariesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpgradePrimariesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBackupDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateBackupDirectoryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDataDirectoriesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteDataDirectoriesReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStateDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteStateDirectoryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBackupDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteBackupDirectoryReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveBackupDirectoryRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveBackupDirectoryReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2672,8 +4363,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradePrimariesRequest); i {
+		file_hub_to_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTablespaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2684,8 +4375,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpgradePrimariesReply); i {
+		file_hub_to_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteTablespaceReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2696,8 +4387,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBackupDirectoryRequest); i {
+		file_hub_to_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveLogDirectoryRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2708,8 +4399,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateBackupDirectoryReply); i {
+		file_hub_to_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArchiveLogDirectoryReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2720,8 +4411,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteDataDirectoriesRequest); i {
+		file_hub_to_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameDirectories); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2732,8 +4423,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteDataDirectoriesReply); i {
+		file_hub_to_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameDirectoriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2744,8 +4435,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteStateDirectoryRequest); i {
+		file_hub_to_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameDirectoriesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2756,8 +4447,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteStateDirectoryReply); i {
+		file_hub_to_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopAgentRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2768,8 +4459,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBackupDirectoryRequest); i {
+		file_hub_to_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StopAgentReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2780,8 +4471,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteBackupDirectoryReply); i {
+		file_hub_to_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckSegmentDiskSpaceRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2792,8 +4483,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTablespaceRequest); i {
+		file_hub_to_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckDiskSpaceReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2804,8 +4495,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteTablespaceReply); i {
+		file_hub_to_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskUsageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2816,8 +4507,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveLogDirectoryRequest); i {
+		file_hub_to_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskUsageReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2828,8 +4519,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ArchiveLogDirectoryReply); i {
+		file_hub_to_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsyncRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2840,8 +4531,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameDirectories); i {
+		file_hub_to_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RsyncReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2852,8 +4543,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameDirectoriesRequest); i {
+		file_hub_to_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePgControlRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2864,8 +4555,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameDirectoriesReply); i {
+		file_hub_to_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestorePgControlReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2876,8 +4567,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopAgentRequest); i {
+		file_hub_to_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFileConfOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2888,8 +4579,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*StopAgentReply); i {
+		file_hub_to_agent_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateConfigurationRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2900,8 +4591,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckSegmentDiskSpaceRequest); i {
+		file_hub_to_agent_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateConfigurationReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2912,8 +4603,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CheckDiskSpaceReply); i {
+		file_hub_to_agent_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameTablespacesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2924,8 +4615,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsyncRequest); i {
+		file_hub_to_agent_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RenameTablespacesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2936,8 +4627,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RsyncReply); i {
+		file_hub_to_agent_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRecoveryConfRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2948,8 +4639,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestorePgControlRequest); i {
+		file_hub_to_agent_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateRecoveryConfReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2960,8 +4651,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RestorePgControlReply); i {
+		file_hub_to_agent_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddReplicationEntriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2972,8 +4663,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateFileConfOptions); i {
+		file_hub_to_agent_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddReplicationEntriesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2984,8 +4675,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateConfigurationRequest); i {
+		file_hub_to_agent_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyRevertActionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -2996,8 +4687,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateConfigurationReply); i {
+		file_hub_to_agent_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyRevertActionsReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3008,8 +4699,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameTablespacesRequest); i {
+		file_hub_to_agent_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindLinkedDataDirectoriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3020,8 +4711,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RenameTablespacesReply); i {
+		file_hub_to_agent_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FindLinkedDataDirectoriesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3032,8 +4723,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRecoveryConfRequest); i {
+		file_hub_to_agent_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotSettings); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3044,8 +4735,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateRecoveryConfReply); i {
+		file_hub_to_agent_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotDirectory); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3056,8 +4747,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddReplicationEntriesRequest); i {
+		file_hub_to_agent_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotDirectoriesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3068,8 +4759,8 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddReplicationEntriesReply); i {
+		file_hub_to_agent_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SnapshotDirectoriesReply); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3080,7 +4771,127 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+		file_hub_to_agent_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ManifestEntry); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCoordinatorBackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCoordinatorBackupReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayCoordinatorBackupRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayCoordinatorBackupReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloneDirectories); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckCloneSupportRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CheckCloneSupportReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHostInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetHostInfoReply); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CheckDiskSpaceReply_DiskUsage); i {
 			case 0:
 				return &v.state
@@ -3092,7 +4903,19 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+		file_hub_to_agent_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiskUsageReply_FilesystemUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_hub_to_agent_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RsyncRequest_RsyncOptions); i {
 			case 0:
 				return &v.state
@@ -3104,7 +4927,7 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+		file_hub_to_agent_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RenameTablespacesRequest_RenamePair); i {
 			case 0:
 				return &v.state
@@ -3116,7 +4939,7 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+		file_hub_to_agent_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateRecoveryConfRequest_Connection); i {
 			case 0:
 				return &v.state
@@ -3128,7 +4951,7 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
-		file_hub_to_agent_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+		file_hub_to_agent_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AddReplicationEntriesRequest_Entry); i {
 			case 0:
 				return &v.state
@@ -3140,14 +4963,26 @@ func file_hub_to_agent_proto_init() {
 				return nil
 			}
 		}
+		file_hub_to_agent_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RelayCoordinatorBackupRequest_Target); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_hub_to_agent_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   42,
+			NumEnums:      3,
+			NumMessages:   66,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
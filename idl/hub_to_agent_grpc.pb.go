@@ -24,11 +24,13 @@ const _ = grpc.SupportPackageIsVersion7
 const (
 	Agent_CreateBackupDirectory_FullMethodName       = "/idl.Agent/CreateBackupDirectory"
 	Agent_CheckDiskSpace_FullMethodName              = "/idl.Agent/CheckDiskSpace"
+	Agent_GetDiskUsage_FullMethodName                = "/idl.Agent/GetDiskUsage"
 	Agent_UpgradePrimaries_FullMethodName            = "/idl.Agent/UpgradePrimaries"
 	Agent_RenameDirectories_FullMethodName           = "/idl.Agent/RenameDirectories"
 	Agent_StopAgent_FullMethodName                   = "/idl.Agent/StopAgent"
 	Agent_DeleteDataDirectories_FullMethodName       = "/idl.Agent/DeleteDataDirectories"
 	Agent_DeleteBackupDirectory_FullMethodName       = "/idl.Agent/DeleteBackupDirectory"
+	Agent_MoveBackupDirectory_FullMethodName         = "/idl.Agent/MoveBackupDirectory"
 	Agent_DeleteStateDirectory_FullMethodName        = "/idl.Agent/DeleteStateDirectory"
 	Agent_DeleteTablespaceDirectories_FullMethodName = "/idl.Agent/DeleteTablespaceDirectories"
 	Agent_ArchiveLogDirectory_FullMethodName         = "/idl.Agent/ArchiveLogDirectory"
@@ -39,6 +41,13 @@ const (
 	Agent_RenameTablespaces_FullMethodName           = "/idl.Agent/RenameTablespaces"
 	Agent_CreateRecoveryConf_FullMethodName          = "/idl.Agent/CreateRecoveryConf"
 	Agent_AddReplicationEntries_FullMethodName       = "/idl.Agent/AddReplicationEntries"
+	Agent_VerifyRevertActions_FullMethodName         = "/idl.Agent/VerifyRevertActions"
+	Agent_FindLinkedDataDirectories_FullMethodName   = "/idl.Agent/FindLinkedDataDirectories"
+	Agent_SnapshotDirectories_FullMethodName         = "/idl.Agent/SnapshotDirectories"
+	Agent_VerifyCoordinatorBackup_FullMethodName     = "/idl.Agent/VerifyCoordinatorBackup"
+	Agent_RelayCoordinatorBackup_FullMethodName      = "/idl.Agent/RelayCoordinatorBackup"
+	Agent_CheckCloneSupport_FullMethodName           = "/idl.Agent/CheckCloneSupport"
+	Agent_GetHostInfo_FullMethodName                 = "/idl.Agent/GetHostInfo"
 )
 
 // AgentClient is the client API for Agent service.
@@ -47,11 +56,13 @@ const (
 type AgentClient interface {
 	CreateBackupDirectory(ctx context.Context, in *CreateBackupDirectoryRequest, opts ...grpc.CallOption) (*CreateBackupDirectoryReply, error)
 	CheckDiskSpace(ctx context.Context, in *CheckSegmentDiskSpaceRequest, opts ...grpc.CallOption) (*CheckDiskSpaceReply, error)
+	GetDiskUsage(ctx context.Context, in *GetDiskUsageRequest, opts ...grpc.CallOption) (*GetDiskUsageReply, error)
 	UpgradePrimaries(ctx context.Context, in *UpgradePrimariesRequest, opts ...grpc.CallOption) (*UpgradePrimariesReply, error)
 	RenameDirectories(ctx context.Context, in *RenameDirectoriesRequest, opts ...grpc.CallOption) (*RenameDirectoriesReply, error)
 	StopAgent(ctx context.Context, in *StopAgentRequest, opts ...grpc.CallOption) (*StopAgentReply, error)
 	DeleteDataDirectories(ctx context.Context, in *DeleteDataDirectoriesRequest, opts ...grpc.CallOption) (*DeleteDataDirectoriesReply, error)
 	DeleteBackupDirectory(ctx context.Context, in *DeleteBackupDirectoryRequest, opts ...grpc.CallOption) (*DeleteBackupDirectoryReply, error)
+	MoveBackupDirectory(ctx context.Context, in *MoveBackupDirectoryRequest, opts ...grpc.CallOption) (*MoveBackupDirectoryReply, error)
 	DeleteStateDirectory(ctx context.Context, in *DeleteStateDirectoryRequest, opts ...grpc.CallOption) (*DeleteStateDirectoryReply, error)
 	DeleteTablespaceDirectories(ctx context.Context, in *DeleteTablespaceRequest, opts ...grpc.CallOption) (*DeleteTablespaceReply, error)
 	ArchiveLogDirectory(ctx context.Context, in *ArchiveLogDirectoryRequest, opts ...grpc.CallOption) (*ArchiveLogDirectoryReply, error)
@@ -62,6 +73,13 @@ type AgentClient interface {
 	RenameTablespaces(ctx context.Context, in *RenameTablespacesRequest, opts ...grpc.CallOption) (*RenameTablespacesReply, error)
 	CreateRecoveryConf(ctx context.Context, in *CreateRecoveryConfRequest, opts ...grpc.CallOption) (*CreateRecoveryConfReply, error)
 	AddReplicationEntries(ctx context.Context, in *AddReplicationEntriesRequest, opts ...grpc.CallOption) (*AddReplicationEntriesReply, error)
+	VerifyRevertActions(ctx context.Context, in *VerifyRevertActionsRequest, opts ...grpc.CallOption) (*VerifyRevertActionsReply, error)
+	FindLinkedDataDirectories(ctx context.Context, in *FindLinkedDataDirectoriesRequest, opts ...grpc.CallOption) (*FindLinkedDataDirectoriesReply, error)
+	SnapshotDirectories(ctx context.Context, in *SnapshotDirectoriesRequest, opts ...grpc.CallOption) (*SnapshotDirectoriesReply, error)
+	VerifyCoordinatorBackup(ctx context.Context, opts ...grpc.CallOption) (Agent_VerifyCoordinatorBackupClient, error)
+	RelayCoordinatorBackup(ctx context.Context, in *RelayCoordinatorBackupRequest, opts ...grpc.CallOption) (*RelayCoordinatorBackupReply, error)
+	CheckCloneSupport(ctx context.Context, in *CheckCloneSupportRequest, opts ...grpc.CallOption) (*CheckCloneSupportReply, error)
+	GetHostInfo(ctx context.Context, in *GetHostInfoRequest, opts ...grpc.CallOption) (*GetHostInfoReply, error)
 }
 
 type agentClient struct {
@@ -90,6 +108,15 @@ func (c *agentClient) CheckDiskSpace(ctx context.Context, in *CheckSegmentDiskSp
 	return out, nil
 }
 
+func (c *agentClient) GetDiskUsage(ctx context.Context, in *GetDiskUsageRequest, opts ...grpc.CallOption) (*GetDiskUsageReply, error) {
+	out := new(GetDiskUsageReply)
+	err := c.cc.Invoke(ctx, Agent_GetDiskUsage_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentClient) UpgradePrimaries(ctx context.Context, in *UpgradePrimariesRequest, opts ...grpc.CallOption) (*UpgradePrimariesReply, error) {
 	out := new(UpgradePrimariesReply)
 	err := c.cc.Invoke(ctx, Agent_UpgradePrimaries_FullMethodName, in, out, opts...)
@@ -135,6 +162,15 @@ func (c *agentClient) DeleteBackupDirectory(ctx context.Context, in *DeleteBacku
 	return out, nil
 }
 
+func (c *agentClient) MoveBackupDirectory(ctx context.Context, in *MoveBackupDirectoryRequest, opts ...grpc.CallOption) (*MoveBackupDirectoryReply, error) {
+	out := new(MoveBackupDirectoryReply)
+	err := c.cc.Invoke(ctx, Agent_MoveBackupDirectory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *agentClient) DeleteStateDirectory(ctx context.Context, in *DeleteStateDirectoryRequest, opts ...grpc.CallOption) (*DeleteStateDirectoryReply, error) {
 	out := new(DeleteStateDirectoryReply)
 	err := c.cc.Invoke(ctx, Agent_DeleteStateDirectory_FullMethodName, in, out, opts...)
@@ -225,17 +261,107 @@ func (c *agentClient) AddReplicationEntries(ctx context.Context, in *AddReplicat
 	return out, nil
 }
 
+func (c *agentClient) VerifyRevertActions(ctx context.Context, in *VerifyRevertActionsRequest, opts ...grpc.CallOption) (*VerifyRevertActionsReply, error) {
+	out := new(VerifyRevertActionsReply)
+	err := c.cc.Invoke(ctx, Agent_VerifyRevertActions_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) FindLinkedDataDirectories(ctx context.Context, in *FindLinkedDataDirectoriesRequest, opts ...grpc.CallOption) (*FindLinkedDataDirectoriesReply, error) {
+	out := new(FindLinkedDataDirectoriesReply)
+	err := c.cc.Invoke(ctx, Agent_FindLinkedDataDirectories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) SnapshotDirectories(ctx context.Context, in *SnapshotDirectoriesRequest, opts ...grpc.CallOption) (*SnapshotDirectoriesReply, error) {
+	out := new(SnapshotDirectoriesReply)
+	err := c.cc.Invoke(ctx, Agent_SnapshotDirectories_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) VerifyCoordinatorBackup(ctx context.Context, opts ...grpc.CallOption) (Agent_VerifyCoordinatorBackupClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Agent_ServiceDesc.Streams[0], Agent_VerifyCoordinatorBackup_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentVerifyCoordinatorBackupClient{stream}
+	return x, nil
+}
+
+type Agent_VerifyCoordinatorBackupClient interface {
+	Send(*VerifyCoordinatorBackupRequest) error
+	CloseAndRecv() (*VerifyCoordinatorBackupReply, error)
+	grpc.ClientStream
+}
+
+type agentVerifyCoordinatorBackupClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentVerifyCoordinatorBackupClient) Send(m *VerifyCoordinatorBackupRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentVerifyCoordinatorBackupClient) CloseAndRecv() (*VerifyCoordinatorBackupReply, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(VerifyCoordinatorBackupReply)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *agentClient) RelayCoordinatorBackup(ctx context.Context, in *RelayCoordinatorBackupRequest, opts ...grpc.CallOption) (*RelayCoordinatorBackupReply, error) {
+	out := new(RelayCoordinatorBackupReply)
+	err := c.cc.Invoke(ctx, Agent_RelayCoordinatorBackup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) CheckCloneSupport(ctx context.Context, in *CheckCloneSupportRequest, opts ...grpc.CallOption) (*CheckCloneSupportReply, error) {
+	out := new(CheckCloneSupportReply)
+	err := c.cc.Invoke(ctx, Agent_CheckCloneSupport_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) GetHostInfo(ctx context.Context, in *GetHostInfoRequest, opts ...grpc.CallOption) (*GetHostInfoReply, error) {
+	out := new(GetHostInfoReply)
+	err := c.cc.Invoke(ctx, Agent_GetHostInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations should embed UnimplementedAgentServer
 // for forward compatibility
 type AgentServer interface {
 	CreateBackupDirectory(context.Context, *CreateBackupDirectoryRequest) (*CreateBackupDirectoryReply, error)
 	CheckDiskSpace(context.Context, *CheckSegmentDiskSpaceRequest) (*CheckDiskSpaceReply, error)
+	GetDiskUsage(context.Context, *GetDiskUsageRequest) (*GetDiskUsageReply, error)
 	UpgradePrimaries(context.Context, *UpgradePrimariesRequest) (*UpgradePrimariesReply, error)
 	RenameDirectories(context.Context, *RenameDirectoriesRequest) (*RenameDirectoriesReply, error)
 	StopAgent(context.Context, *StopAgentRequest) (*StopAgentReply, error)
 	DeleteDataDirectories(context.Context, *DeleteDataDirectoriesRequest) (*DeleteDataDirectoriesReply, error)
 	DeleteBackupDirectory(context.Context, *DeleteBackupDirectoryRequest) (*DeleteBackupDirectoryReply, error)
+	MoveBackupDirectory(context.Context, *MoveBackupDirectoryRequest) (*MoveBackupDirectoryReply, error)
 	DeleteStateDirectory(context.Context, *DeleteStateDirectoryRequest) (*DeleteStateDirectoryReply, error)
 	DeleteTablespaceDirectories(context.Context, *DeleteTablespaceRequest) (*DeleteTablespaceReply, error)
 	ArchiveLogDirectory(context.Context, *ArchiveLogDirectoryRequest) (*ArchiveLogDirectoryReply, error)
@@ -246,6 +372,13 @@ type AgentServer interface {
 	RenameTablespaces(context.Context, *RenameTablespacesRequest) (*RenameTablespacesReply, error)
 	CreateRecoveryConf(context.Context, *CreateRecoveryConfRequest) (*CreateRecoveryConfReply, error)
 	AddReplicationEntries(context.Context, *AddReplicationEntriesRequest) (*AddReplicationEntriesReply, error)
+	VerifyRevertActions(context.Context, *VerifyRevertActionsRequest) (*VerifyRevertActionsReply, error)
+	FindLinkedDataDirectories(context.Context, *FindLinkedDataDirectoriesRequest) (*FindLinkedDataDirectoriesReply, error)
+	SnapshotDirectories(context.Context, *SnapshotDirectoriesRequest) (*SnapshotDirectoriesReply, error)
+	VerifyCoordinatorBackup(Agent_VerifyCoordinatorBackupServer) error
+	RelayCoordinatorBackup(context.Context, *RelayCoordinatorBackupRequest) (*RelayCoordinatorBackupReply, error)
+	CheckCloneSupport(context.Context, *CheckCloneSupportRequest) (*CheckCloneSupportReply, error)
+	GetHostInfo(context.Context, *GetHostInfoRequest) (*GetHostInfoReply, error)
 }
 
 // UnimplementedAgentServer should be embedded to have forward compatible implementations.
@@ -258,6 +391,9 @@ func (UnimplementedAgentServer) CreateBackupDirectory(context.Context, *CreateBa
 func (UnimplementedAgentServer) CheckDiskSpace(context.Context, *CheckSegmentDiskSpaceRequest) (*CheckDiskSpaceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckDiskSpace not implemented")
 }
+func (UnimplementedAgentServer) GetDiskUsage(context.Context, *GetDiskUsageRequest) (*GetDiskUsageReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDiskUsage not implemented")
+}
 func (UnimplementedAgentServer) UpgradePrimaries(context.Context, *UpgradePrimariesRequest) (*UpgradePrimariesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpgradePrimaries not implemented")
 }
@@ -273,6 +409,9 @@ func (UnimplementedAgentServer) DeleteDataDirectories(context.Context, *DeleteDa
 func (UnimplementedAgentServer) DeleteBackupDirectory(context.Context, *DeleteBackupDirectoryRequest) (*DeleteBackupDirectoryReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteBackupDirectory not implemented")
 }
+func (UnimplementedAgentServer) MoveBackupDirectory(context.Context, *MoveBackupDirectoryRequest) (*MoveBackupDirectoryReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveBackupDirectory not implemented")
+}
 func (UnimplementedAgentServer) DeleteStateDirectory(context.Context, *DeleteStateDirectoryRequest) (*DeleteStateDirectoryReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteStateDirectory not implemented")
 }
@@ -303,6 +442,27 @@ func (UnimplementedAgentServer) CreateRecoveryConf(context.Context, *CreateRecov
 func (UnimplementedAgentServer) AddReplicationEntries(context.Context, *AddReplicationEntriesRequest) (*AddReplicationEntriesReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddReplicationEntries not implemented")
 }
+func (UnimplementedAgentServer) VerifyRevertActions(context.Context, *VerifyRevertActionsRequest) (*VerifyRevertActionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyRevertActions not implemented")
+}
+func (UnimplementedAgentServer) FindLinkedDataDirectories(context.Context, *FindLinkedDataDirectoriesRequest) (*FindLinkedDataDirectoriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindLinkedDataDirectories not implemented")
+}
+func (UnimplementedAgentServer) SnapshotDirectories(context.Context, *SnapshotDirectoriesRequest) (*SnapshotDirectoriesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SnapshotDirectories not implemented")
+}
+func (UnimplementedAgentServer) VerifyCoordinatorBackup(Agent_VerifyCoordinatorBackupServer) error {
+	return status.Errorf(codes.Unimplemented, "method VerifyCoordinatorBackup not implemented")
+}
+func (UnimplementedAgentServer) RelayCoordinatorBackup(context.Context, *RelayCoordinatorBackupRequest) (*RelayCoordinatorBackupReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RelayCoordinatorBackup not implemented")
+}
+func (UnimplementedAgentServer) CheckCloneSupport(context.Context, *CheckCloneSupportRequest) (*CheckCloneSupportReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckCloneSupport not implemented")
+}
+func (UnimplementedAgentServer) GetHostInfo(context.Context, *GetHostInfoRequest) (*GetHostInfoReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHostInfo not implemented")
+}
 
 // UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
 // Use of this interface is not recommended, as added methods to AgentServer will
@@ -351,6 +511,24 @@ func _Agent_CheckDiskSpace_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_GetDiskUsage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDiskUsageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetDiskUsage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_GetDiskUsage_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetDiskUsage(ctx, req.(*GetDiskUsageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Agent_UpgradePrimaries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpgradePrimariesRequest)
 	if err := dec(in); err != nil {
@@ -441,6 +619,24 @@ func _Agent_DeleteBackupDirectory_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_MoveBackupDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveBackupDirectoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).MoveBackupDirectory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_MoveBackupDirectory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).MoveBackupDirectory(ctx, req.(*MoveBackupDirectoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Agent_DeleteStateDirectory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteStateDirectoryRequest)
 	if err := dec(in); err != nil {
@@ -621,6 +817,140 @@ func _Agent_AddReplicationEntries_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_VerifyRevertActions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyRevertActionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).VerifyRevertActions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_VerifyRevertActions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).VerifyRevertActions(ctx, req.(*VerifyRevertActionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_FindLinkedDataDirectories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindLinkedDataDirectoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).FindLinkedDataDirectories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_FindLinkedDataDirectories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).FindLinkedDataDirectories(ctx, req.(*FindLinkedDataDirectoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_SnapshotDirectories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SnapshotDirectoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).SnapshotDirectories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_SnapshotDirectories_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).SnapshotDirectories(ctx, req.(*SnapshotDirectoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_VerifyCoordinatorBackup_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServer).VerifyCoordinatorBackup(&agentVerifyCoordinatorBackupServer{stream})
+}
+
+type Agent_VerifyCoordinatorBackupServer interface {
+	SendAndClose(*VerifyCoordinatorBackupReply) error
+	Recv() (*VerifyCoordinatorBackupRequest, error)
+	grpc.ServerStream
+}
+
+type agentVerifyCoordinatorBackupServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentVerifyCoordinatorBackupServer) SendAndClose(m *VerifyCoordinatorBackupReply) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentVerifyCoordinatorBackupServer) Recv() (*VerifyCoordinatorBackupRequest, error) {
+	m := new(VerifyCoordinatorBackupRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _Agent_RelayCoordinatorBackup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RelayCoordinatorBackupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).RelayCoordinatorBackup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_RelayCoordinatorBackup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).RelayCoordinatorBackup(ctx, req.(*RelayCoordinatorBackupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_CheckCloneSupport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckCloneSupportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).CheckCloneSupport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_CheckCloneSupport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).CheckCloneSupport(ctx, req.(*CheckCloneSupportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_GetHostInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHostInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).GetHostInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Agent_GetHostInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).GetHostInfo(ctx, req.(*GetHostInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Agent_ServiceDesc is the grpc.ServiceDesc for Agent service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -636,6 +966,10 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckDiskSpace",
 			Handler:    _Agent_CheckDiskSpace_Handler,
 		},
+		{
+			MethodName: "GetDiskUsage",
+			Handler:    _Agent_GetDiskUsage_Handler,
+		},
 		{
 			MethodName: "UpgradePrimaries",
 			Handler:    _Agent_UpgradePrimaries_Handler,
@@ -656,6 +990,10 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteBackupDirectory",
 			Handler:    _Agent_DeleteBackupDirectory_Handler,
 		},
+		{
+			MethodName: "MoveBackupDirectory",
+			Handler:    _Agent_MoveBackupDirectory_Handler,
+		},
 		{
 			MethodName: "DeleteStateDirectory",
 			Handler:    _Agent_DeleteStateDirectory_Handler,
@@ -696,7 +1034,37 @@ var Agent_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddReplicationEntries",
 			Handler:    _Agent_AddReplicationEntries_Handler,
 		},
+		{
+			MethodName: "VerifyRevertActions",
+			Handler:    _Agent_VerifyRevertActions_Handler,
+		},
+		{
+			MethodName: "FindLinkedDataDirectories",
+			Handler:    _Agent_FindLinkedDataDirectories_Handler,
+		},
+		{
+			MethodName: "SnapshotDirectories",
+			Handler:    _Agent_SnapshotDirectories_Handler,
+		},
+		{
+			MethodName: "RelayCoordinatorBackup",
+			Handler:    _Agent_RelayCoordinatorBackup_Handler,
+		},
+		{
+			MethodName: "CheckCloneSupport",
+			Handler:    _Agent_CheckCloneSupport_Handler,
+		},
+		{
+			MethodName: "GetHostInfo",
+			Handler:    _Agent_GetHostInfo_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "VerifyCoordinatorBackup",
+			Handler:       _Agent_VerifyCoordinatorBackup_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "hub_to_agent.proto",
 }
@@ -37,6 +37,26 @@ func (m *MockCliToHubClient) EXPECT() *MockCliToHubClientMockRecorder {
 	return m.recorder
 }
 
+// AddMirrors mocks base method.
+func (m *MockCliToHubClient) AddMirrors(ctx context.Context, in *idl.AddMirrorsRequest, opts ...grpc.CallOption) (idl.CliToHub_AddMirrorsClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "AddMirrors", varargs...)
+	ret0, _ := ret[0].(idl.CliToHub_AddMirrorsClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddMirrors indicates an expected call of AddMirrors.
+func (mr *MockCliToHubClientMockRecorder) AddMirrors(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMirrors", reflect.TypeOf((*MockCliToHubClient)(nil).AddMirrors), varargs...)
+}
+
 // Execute mocks base method.
 func (m *MockCliToHubClient) Execute(ctx context.Context, in *idl.ExecuteRequest, opts ...grpc.CallOption) (idl.CliToHub_ExecuteClient, error) {
 	m.ctrl.T.Helper()
@@ -177,6 +197,46 @@ func (mr *MockCliToHubClientMockRecorder) Revert(ctx, in interface{}, opts ...in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revert", reflect.TypeOf((*MockCliToHubClient)(nil).Revert), varargs...)
 }
 
+// RevertPlan mocks base method.
+func (m *MockCliToHubClient) RevertPlan(ctx context.Context, in *idl.RevertPlanRequest, opts ...grpc.CallOption) (*idl.RevertPlanReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RevertPlan", varargs...)
+	ret0, _ := ret[0].(*idl.RevertPlanReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertPlan indicates an expected call of RevertPlan.
+func (mr *MockCliToHubClientMockRecorder) RevertPlan(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertPlan", reflect.TypeOf((*MockCliToHubClient)(nil).RevertPlan), varargs...)
+}
+
+// SetConfig mocks base method.
+func (m *MockCliToHubClient) SetConfig(ctx context.Context, in *idl.SetConfigRequest, opts ...grpc.CallOption) (*idl.SetConfigReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SetConfig", varargs...)
+	ret0, _ := ret[0].(*idl.SetConfigReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConfig indicates an expected call of SetConfig.
+func (mr *MockCliToHubClientMockRecorder) SetConfig(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfig", reflect.TypeOf((*MockCliToHubClient)(nil).SetConfig), varargs...)
+}
+
 // StopServices mocks base method.
 func (m *MockCliToHubClient) StopServices(ctx context.Context, in *idl.StopServicesRequest, opts ...grpc.CallOption) (*idl.StopServicesReply, error) {
 	m.ctrl.T.Helper()
@@ -812,6 +872,129 @@ func (mr *MockCliToHub_RevertClientMockRecorder) Trailer() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockCliToHub_RevertClient)(nil).Trailer))
 }
 
+// MockCliToHub_AddMirrorsClient is a mock of CliToHub_AddMirrorsClient interface.
+type MockCliToHub_AddMirrorsClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockCliToHub_AddMirrorsClientMockRecorder
+}
+
+// MockCliToHub_AddMirrorsClientMockRecorder is the mock recorder for MockCliToHub_AddMirrorsClient.
+type MockCliToHub_AddMirrorsClientMockRecorder struct {
+	mock *MockCliToHub_AddMirrorsClient
+}
+
+// NewMockCliToHub_AddMirrorsClient creates a new mock instance.
+func NewMockCliToHub_AddMirrorsClient(ctrl *gomock.Controller) *MockCliToHub_AddMirrorsClient {
+	mock := &MockCliToHub_AddMirrorsClient{ctrl: ctrl}
+	mock.recorder = &MockCliToHub_AddMirrorsClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCliToHub_AddMirrorsClient) EXPECT() *MockCliToHub_AddMirrorsClientMockRecorder {
+	return m.recorder
+}
+
+// CloseSend mocks base method.
+func (m *MockCliToHub_AddMirrorsClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockCliToHub_AddMirrorsClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockCliToHub_AddMirrorsClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockCliToHub_AddMirrorsClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockCliToHub_AddMirrorsClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockCliToHub_AddMirrorsClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockCliToHub_AddMirrorsClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockCliToHub_AddMirrorsClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockCliToHub_AddMirrorsClient)(nil).Header))
+}
+
+// Recv mocks base method.
+func (m *MockCliToHub_AddMirrorsClient) Recv() (*idl.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*idl.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockCliToHub_AddMirrorsClientMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockCliToHub_AddMirrorsClient)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockCliToHub_AddMirrorsClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockCliToHub_AddMirrorsClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockCliToHub_AddMirrorsClient)(nil).RecvMsg), m)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockCliToHub_AddMirrorsClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockCliToHub_AddMirrorsClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockCliToHub_AddMirrorsClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockCliToHub_AddMirrorsClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockCliToHub_AddMirrorsClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockCliToHub_AddMirrorsClient)(nil).Trailer))
+}
+
 // MockCliToHubServer is a mock of CliToHubServer interface.
 type MockCliToHubServer struct {
 	ctrl     *gomock.Controller
@@ -835,6 +1018,20 @@ func (m *MockCliToHubServer) EXPECT() *MockCliToHubServerMockRecorder {
 	return m.recorder
 }
 
+// AddMirrors mocks base method.
+func (m *MockCliToHubServer) AddMirrors(arg0 *idl.AddMirrorsRequest, arg1 idl.CliToHub_AddMirrorsServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddMirrors", arg0, arg1)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddMirrors indicates an expected call of AddMirrors.
+func (mr *MockCliToHubServerMockRecorder) AddMirrors(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddMirrors", reflect.TypeOf((*MockCliToHubServer)(nil).AddMirrors), arg0, arg1)
+}
+
 // Execute mocks base method.
 func (m *MockCliToHubServer) Execute(arg0 *idl.ExecuteRequest, arg1 idl.CliToHub_ExecuteServer) error {
 	m.ctrl.T.Helper()
@@ -935,6 +1132,36 @@ func (mr *MockCliToHubServerMockRecorder) Revert(arg0, arg1 interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Revert", reflect.TypeOf((*MockCliToHubServer)(nil).Revert), arg0, arg1)
 }
 
+// RevertPlan mocks base method.
+func (m *MockCliToHubServer) RevertPlan(arg0 context.Context, arg1 *idl.RevertPlanRequest) (*idl.RevertPlanReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertPlan", arg0, arg1)
+	ret0, _ := ret[0].(*idl.RevertPlanReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertPlan indicates an expected call of RevertPlan.
+func (mr *MockCliToHubServerMockRecorder) RevertPlan(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertPlan", reflect.TypeOf((*MockCliToHubServer)(nil).RevertPlan), arg0, arg1)
+}
+
+// SetConfig mocks base method.
+func (m *MockCliToHubServer) SetConfig(arg0 context.Context, arg1 *idl.SetConfigRequest) (*idl.SetConfigReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetConfig", arg0, arg1)
+	ret0, _ := ret[0].(*idl.SetConfigReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetConfig indicates an expected call of SetConfig.
+func (mr *MockCliToHubServerMockRecorder) SetConfig(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfig", reflect.TypeOf((*MockCliToHubServer)(nil).SetConfig), arg0, arg1)
+}
+
 // StopServices mocks base method.
 func (m *MockCliToHubServer) StopServices(arg0 context.Context, arg1 *idl.StopServicesRequest) (*idl.StopServicesReply, error) {
 	m.ctrl.T.Helper()
@@ -1579,3 +1806,122 @@ func (mr *MockCliToHub_RevertServerMockRecorder) SetTrailer(arg0 interface{}) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockCliToHub_RevertServer)(nil).SetTrailer), arg0)
 }
+
+// MockCliToHub_AddMirrorsServer is a mock of CliToHub_AddMirrorsServer interface.
+type MockCliToHub_AddMirrorsServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockCliToHub_AddMirrorsServerMockRecorder
+}
+
+// MockCliToHub_AddMirrorsServerMockRecorder is the mock recorder for MockCliToHub_AddMirrorsServer.
+type MockCliToHub_AddMirrorsServerMockRecorder struct {
+	mock *MockCliToHub_AddMirrorsServer
+}
+
+// NewMockCliToHub_AddMirrorsServer creates a new mock instance.
+func NewMockCliToHub_AddMirrorsServer(ctrl *gomock.Controller) *MockCliToHub_AddMirrorsServer {
+	mock := &MockCliToHub_AddMirrorsServer{ctrl: ctrl}
+	mock.recorder = &MockCliToHub_AddMirrorsServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockCliToHub_AddMirrorsServer) EXPECT() *MockCliToHub_AddMirrorsServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockCliToHub_AddMirrorsServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockCliToHub_AddMirrorsServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockCliToHub_AddMirrorsServer)(nil).Context))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockCliToHub_AddMirrorsServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockCliToHub_AddMirrorsServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockCliToHub_AddMirrorsServer)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockCliToHub_AddMirrorsServer) Send(arg0 *idl.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockCliToHub_AddMirrorsServerMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockCliToHub_AddMirrorsServer)(nil).Send), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockCliToHub_AddMirrorsServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockCliToHub_AddMirrorsServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockCliToHub_AddMirrorsServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockCliToHub_AddMirrorsServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockCliToHub_AddMirrorsServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockCliToHub_AddMirrorsServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockCliToHub_AddMirrorsServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockCliToHub_AddMirrorsServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockCliToHub_AddMirrorsServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockCliToHub_AddMirrorsServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockCliToHub_AddMirrorsServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockCliToHub_AddMirrorsServer)(nil).SetTrailer), arg0)
+}
@@ -11,6 +11,7 @@ import (
 	gomock "github.com/golang/mock/gomock"
 	idl "github.com/greenplum-db/gpupgrade/idl"
 	grpc "google.golang.org/grpc"
+	metadata "google.golang.org/grpc/metadata"
 )
 
 // MockAgentClient is a mock of AgentClient interface.
@@ -76,6 +77,26 @@ func (mr *MockAgentClientMockRecorder) ArchiveLogDirectory(ctx, in interface{},
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveLogDirectory", reflect.TypeOf((*MockAgentClient)(nil).ArchiveLogDirectory), varargs...)
 }
 
+// CheckCloneSupport mocks base method.
+func (m *MockAgentClient) CheckCloneSupport(ctx context.Context, in *idl.CheckCloneSupportRequest, opts ...grpc.CallOption) (*idl.CheckCloneSupportReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "CheckCloneSupport", varargs...)
+	ret0, _ := ret[0].(*idl.CheckCloneSupportReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckCloneSupport indicates an expected call of CheckCloneSupport.
+func (mr *MockAgentClientMockRecorder) CheckCloneSupport(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCloneSupport", reflect.TypeOf((*MockAgentClient)(nil).CheckCloneSupport), varargs...)
+}
+
 // CheckDiskSpace mocks base method.
 func (m *MockAgentClient) CheckDiskSpace(ctx context.Context, in *idl.CheckSegmentDiskSpaceRequest, opts ...grpc.CallOption) (*idl.CheckDiskSpaceReply, error) {
 	m.ctrl.T.Helper()
@@ -216,6 +237,106 @@ func (mr *MockAgentClientMockRecorder) DeleteTablespaceDirectories(ctx, in inter
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTablespaceDirectories", reflect.TypeOf((*MockAgentClient)(nil).DeleteTablespaceDirectories), varargs...)
 }
 
+// FindLinkedDataDirectories mocks base method.
+func (m *MockAgentClient) FindLinkedDataDirectories(ctx context.Context, in *idl.FindLinkedDataDirectoriesRequest, opts ...grpc.CallOption) (*idl.FindLinkedDataDirectoriesReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "FindLinkedDataDirectories", varargs...)
+	ret0, _ := ret[0].(*idl.FindLinkedDataDirectoriesReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLinkedDataDirectories indicates an expected call of FindLinkedDataDirectories.
+func (mr *MockAgentClientMockRecorder) FindLinkedDataDirectories(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLinkedDataDirectories", reflect.TypeOf((*MockAgentClient)(nil).FindLinkedDataDirectories), varargs...)
+}
+
+// GetDiskUsage mocks base method.
+func (m *MockAgentClient) GetDiskUsage(ctx context.Context, in *idl.GetDiskUsageRequest, opts ...grpc.CallOption) (*idl.GetDiskUsageReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDiskUsage", varargs...)
+	ret0, _ := ret[0].(*idl.GetDiskUsageReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiskUsage indicates an expected call of GetDiskUsage.
+func (mr *MockAgentClientMockRecorder) GetDiskUsage(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskUsage", reflect.TypeOf((*MockAgentClient)(nil).GetDiskUsage), varargs...)
+}
+
+// GetHostInfo mocks base method.
+func (m *MockAgentClient) GetHostInfo(ctx context.Context, in *idl.GetHostInfoRequest, opts ...grpc.CallOption) (*idl.GetHostInfoReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostInfo", varargs...)
+	ret0, _ := ret[0].(*idl.GetHostInfoReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostInfo indicates an expected call of GetHostInfo.
+func (mr *MockAgentClientMockRecorder) GetHostInfo(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostInfo", reflect.TypeOf((*MockAgentClient)(nil).GetHostInfo), varargs...)
+}
+
+// MoveBackupDirectory mocks base method.
+func (m *MockAgentClient) MoveBackupDirectory(ctx context.Context, in *idl.MoveBackupDirectoryRequest, opts ...grpc.CallOption) (*idl.MoveBackupDirectoryReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "MoveBackupDirectory", varargs...)
+	ret0, _ := ret[0].(*idl.MoveBackupDirectoryReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveBackupDirectory indicates an expected call of MoveBackupDirectory.
+func (mr *MockAgentClientMockRecorder) MoveBackupDirectory(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBackupDirectory", reflect.TypeOf((*MockAgentClient)(nil).MoveBackupDirectory), varargs...)
+}
+
+// RelayCoordinatorBackup mocks base method.
+func (m *MockAgentClient) RelayCoordinatorBackup(ctx context.Context, in *idl.RelayCoordinatorBackupRequest, opts ...grpc.CallOption) (*idl.RelayCoordinatorBackupReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "RelayCoordinatorBackup", varargs...)
+	ret0, _ := ret[0].(*idl.RelayCoordinatorBackupReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayCoordinatorBackup indicates an expected call of RelayCoordinatorBackup.
+func (mr *MockAgentClientMockRecorder) RelayCoordinatorBackup(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayCoordinatorBackup", reflect.TypeOf((*MockAgentClient)(nil).RelayCoordinatorBackup), varargs...)
+}
+
 // RenameDirectories mocks base method.
 func (m *MockAgentClient) RenameDirectories(ctx context.Context, in *idl.RenameDirectoriesRequest, opts ...grpc.CallOption) (*idl.RenameDirectoriesReply, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +437,26 @@ func (mr *MockAgentClientMockRecorder) RsyncTablespaceDirectories(ctx, in interf
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RsyncTablespaceDirectories", reflect.TypeOf((*MockAgentClient)(nil).RsyncTablespaceDirectories), varargs...)
 }
 
+// SnapshotDirectories mocks base method.
+func (m *MockAgentClient) SnapshotDirectories(ctx context.Context, in *idl.SnapshotDirectoriesRequest, opts ...grpc.CallOption) (*idl.SnapshotDirectoriesReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "SnapshotDirectories", varargs...)
+	ret0, _ := ret[0].(*idl.SnapshotDirectoriesReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapshotDirectories indicates an expected call of SnapshotDirectories.
+func (mr *MockAgentClientMockRecorder) SnapshotDirectories(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotDirectories", reflect.TypeOf((*MockAgentClient)(nil).SnapshotDirectories), varargs...)
+}
+
 // StopAgent mocks base method.
 func (m *MockAgentClient) StopAgent(ctx context.Context, in *idl.StopAgentRequest, opts ...grpc.CallOption) (*idl.StopAgentReply, error) {
 	m.ctrl.T.Helper()
@@ -376,6 +517,183 @@ func (mr *MockAgentClientMockRecorder) UpgradePrimaries(ctx, in interface{}, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradePrimaries", reflect.TypeOf((*MockAgentClient)(nil).UpgradePrimaries), varargs...)
 }
 
+// VerifyCoordinatorBackup mocks base method.
+func (m *MockAgentClient) VerifyCoordinatorBackup(ctx context.Context, opts ...grpc.CallOption) (idl.Agent_VerifyCoordinatorBackupClient, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyCoordinatorBackup", varargs...)
+	ret0, _ := ret[0].(idl.Agent_VerifyCoordinatorBackupClient)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyCoordinatorBackup indicates an expected call of VerifyCoordinatorBackup.
+func (mr *MockAgentClientMockRecorder) VerifyCoordinatorBackup(ctx interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyCoordinatorBackup", reflect.TypeOf((*MockAgentClient)(nil).VerifyCoordinatorBackup), varargs...)
+}
+
+// VerifyRevertActions mocks base method.
+func (m *MockAgentClient) VerifyRevertActions(ctx context.Context, in *idl.VerifyRevertActionsRequest, opts ...grpc.CallOption) (*idl.VerifyRevertActionsReply, error) {
+	m.ctrl.T.Helper()
+	varargs := []interface{}{ctx, in}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "VerifyRevertActions", varargs...)
+	ret0, _ := ret[0].(*idl.VerifyRevertActionsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyRevertActions indicates an expected call of VerifyRevertActions.
+func (mr *MockAgentClientMockRecorder) VerifyRevertActions(ctx, in interface{}, opts ...interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]interface{}{ctx, in}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyRevertActions", reflect.TypeOf((*MockAgentClient)(nil).VerifyRevertActions), varargs...)
+}
+
+// MockAgent_VerifyCoordinatorBackupClient is a mock of Agent_VerifyCoordinatorBackupClient interface.
+type MockAgent_VerifyCoordinatorBackupClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockAgent_VerifyCoordinatorBackupClientMockRecorder
+}
+
+// MockAgent_VerifyCoordinatorBackupClientMockRecorder is the mock recorder for MockAgent_VerifyCoordinatorBackupClient.
+type MockAgent_VerifyCoordinatorBackupClientMockRecorder struct {
+	mock *MockAgent_VerifyCoordinatorBackupClient
+}
+
+// NewMockAgent_VerifyCoordinatorBackupClient creates a new mock instance.
+func NewMockAgent_VerifyCoordinatorBackupClient(ctrl *gomock.Controller) *MockAgent_VerifyCoordinatorBackupClient {
+	mock := &MockAgent_VerifyCoordinatorBackupClient{ctrl: ctrl}
+	mock.recorder = &MockAgent_VerifyCoordinatorBackupClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAgent_VerifyCoordinatorBackupClient) EXPECT() *MockAgent_VerifyCoordinatorBackupClientMockRecorder {
+	return m.recorder
+}
+
+// CloseAndRecv mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupClient) CloseAndRecv() (*idl.VerifyCoordinatorBackupReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseAndRecv")
+	ret0, _ := ret[0].(*idl.VerifyCoordinatorBackupReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloseAndRecv indicates an expected call of CloseAndRecv.
+func (mr *MockAgent_VerifyCoordinatorBackupClientMockRecorder) CloseAndRecv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseAndRecv", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupClient)(nil).CloseAndRecv))
+}
+
+// CloseSend mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupClient) CloseSend() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloseSend")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CloseSend indicates an expected call of CloseSend.
+func (mr *MockAgent_VerifyCoordinatorBackupClientMockRecorder) CloseSend() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloseSend", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupClient)(nil).CloseSend))
+}
+
+// Context mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupClient) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAgent_VerifyCoordinatorBackupClientMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupClient)(nil).Context))
+}
+
+// Header mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupClient) Header() (metadata.MD, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Header")
+	ret0, _ := ret[0].(metadata.MD)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Header indicates an expected call of Header.
+func (mr *MockAgent_VerifyCoordinatorBackupClientMockRecorder) Header() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Header", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupClient)(nil).Header))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAgent_VerifyCoordinatorBackupClient) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAgent_VerifyCoordinatorBackupClientMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupClient)(nil).RecvMsg), m)
+}
+
+// Send mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupClient) Send(arg0 *idl.VerifyCoordinatorBackupRequest) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Send", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Send indicates an expected call of Send.
+func (mr *MockAgent_VerifyCoordinatorBackupClientMockRecorder) Send(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Send", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupClient)(nil).Send), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAgent_VerifyCoordinatorBackupClient) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAgent_VerifyCoordinatorBackupClientMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupClient)(nil).SendMsg), m)
+}
+
+// Trailer mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupClient) Trailer() metadata.MD {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Trailer")
+	ret0, _ := ret[0].(metadata.MD)
+	return ret0
+}
+
+// Trailer indicates an expected call of Trailer.
+func (mr *MockAgent_VerifyCoordinatorBackupClientMockRecorder) Trailer() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Trailer", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupClient)(nil).Trailer))
+}
+
 // MockAgentServer is a mock of AgentServer interface.
 type MockAgentServer struct {
 	ctrl     *gomock.Controller
@@ -429,6 +747,21 @@ func (mr *MockAgentServerMockRecorder) ArchiveLogDirectory(arg0, arg1 interface{
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveLogDirectory", reflect.TypeOf((*MockAgentServer)(nil).ArchiveLogDirectory), arg0, arg1)
 }
 
+// CheckCloneSupport mocks base method.
+func (m *MockAgentServer) CheckCloneSupport(arg0 context.Context, arg1 *idl.CheckCloneSupportRequest) (*idl.CheckCloneSupportReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckCloneSupport", arg0, arg1)
+	ret0, _ := ret[0].(*idl.CheckCloneSupportReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckCloneSupport indicates an expected call of CheckCloneSupport.
+func (mr *MockAgentServerMockRecorder) CheckCloneSupport(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckCloneSupport", reflect.TypeOf((*MockAgentServer)(nil).CheckCloneSupport), arg0, arg1)
+}
+
 // CheckDiskSpace mocks base method.
 func (m *MockAgentServer) CheckDiskSpace(arg0 context.Context, arg1 *idl.CheckSegmentDiskSpaceRequest) (*idl.CheckDiskSpaceReply, error) {
 	m.ctrl.T.Helper()
@@ -534,6 +867,81 @@ func (mr *MockAgentServerMockRecorder) DeleteTablespaceDirectories(arg0, arg1 in
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTablespaceDirectories", reflect.TypeOf((*MockAgentServer)(nil).DeleteTablespaceDirectories), arg0, arg1)
 }
 
+// FindLinkedDataDirectories mocks base method.
+func (m *MockAgentServer) FindLinkedDataDirectories(arg0 context.Context, arg1 *idl.FindLinkedDataDirectoriesRequest) (*idl.FindLinkedDataDirectoriesReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindLinkedDataDirectories", arg0, arg1)
+	ret0, _ := ret[0].(*idl.FindLinkedDataDirectoriesReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindLinkedDataDirectories indicates an expected call of FindLinkedDataDirectories.
+func (mr *MockAgentServerMockRecorder) FindLinkedDataDirectories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindLinkedDataDirectories", reflect.TypeOf((*MockAgentServer)(nil).FindLinkedDataDirectories), arg0, arg1)
+}
+
+// GetDiskUsage mocks base method.
+func (m *MockAgentServer) GetDiskUsage(arg0 context.Context, arg1 *idl.GetDiskUsageRequest) (*idl.GetDiskUsageReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiskUsage", arg0, arg1)
+	ret0, _ := ret[0].(*idl.GetDiskUsageReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiskUsage indicates an expected call of GetDiskUsage.
+func (mr *MockAgentServerMockRecorder) GetDiskUsage(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskUsage", reflect.TypeOf((*MockAgentServer)(nil).GetDiskUsage), arg0, arg1)
+}
+
+// GetHostInfo mocks base method.
+func (m *MockAgentServer) GetHostInfo(arg0 context.Context, arg1 *idl.GetHostInfoRequest) (*idl.GetHostInfoReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHostInfo", arg0, arg1)
+	ret0, _ := ret[0].(*idl.GetHostInfoReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHostInfo indicates an expected call of GetHostInfo.
+func (mr *MockAgentServerMockRecorder) GetHostInfo(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostInfo", reflect.TypeOf((*MockAgentServer)(nil).GetHostInfo), arg0, arg1)
+}
+
+// MoveBackupDirectory mocks base method.
+func (m *MockAgentServer) MoveBackupDirectory(arg0 context.Context, arg1 *idl.MoveBackupDirectoryRequest) (*idl.MoveBackupDirectoryReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveBackupDirectory", arg0, arg1)
+	ret0, _ := ret[0].(*idl.MoveBackupDirectoryReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveBackupDirectory indicates an expected call of MoveBackupDirectory.
+func (mr *MockAgentServerMockRecorder) MoveBackupDirectory(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveBackupDirectory", reflect.TypeOf((*MockAgentServer)(nil).MoveBackupDirectory), arg0, arg1)
+}
+
+// RelayCoordinatorBackup mocks base method.
+func (m *MockAgentServer) RelayCoordinatorBackup(arg0 context.Context, arg1 *idl.RelayCoordinatorBackupRequest) (*idl.RelayCoordinatorBackupReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RelayCoordinatorBackup", arg0, arg1)
+	ret0, _ := ret[0].(*idl.RelayCoordinatorBackupReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RelayCoordinatorBackup indicates an expected call of RelayCoordinatorBackup.
+func (mr *MockAgentServerMockRecorder) RelayCoordinatorBackup(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RelayCoordinatorBackup", reflect.TypeOf((*MockAgentServer)(nil).RelayCoordinatorBackup), arg0, arg1)
+}
+
 // RenameDirectories mocks base method.
 func (m *MockAgentServer) RenameDirectories(arg0 context.Context, arg1 *idl.RenameDirectoriesRequest) (*idl.RenameDirectoriesReply, error) {
 	m.ctrl.T.Helper()
@@ -609,6 +1017,21 @@ func (mr *MockAgentServerMockRecorder) RsyncTablespaceDirectories(arg0, arg1 int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RsyncTablespaceDirectories", reflect.TypeOf((*MockAgentServer)(nil).RsyncTablespaceDirectories), arg0, arg1)
 }
 
+// SnapshotDirectories mocks base method.
+func (m *MockAgentServer) SnapshotDirectories(arg0 context.Context, arg1 *idl.SnapshotDirectoriesRequest) (*idl.SnapshotDirectoriesReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SnapshotDirectories", arg0, arg1)
+	ret0, _ := ret[0].(*idl.SnapshotDirectoriesReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SnapshotDirectories indicates an expected call of SnapshotDirectories.
+func (mr *MockAgentServerMockRecorder) SnapshotDirectories(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SnapshotDirectories", reflect.TypeOf((*MockAgentServer)(nil).SnapshotDirectories), arg0, arg1)
+}
+
 // StopAgent mocks base method.
 func (m *MockAgentServer) StopAgent(arg0 context.Context, arg1 *idl.StopAgentRequest) (*idl.StopAgentReply, error) {
 	m.ctrl.T.Helper()
@@ -654,6 +1077,35 @@ func (mr *MockAgentServerMockRecorder) UpgradePrimaries(arg0, arg1 interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradePrimaries", reflect.TypeOf((*MockAgentServer)(nil).UpgradePrimaries), arg0, arg1)
 }
 
+// VerifyCoordinatorBackup mocks base method.
+func (m *MockAgentServer) VerifyCoordinatorBackup(arg0 idl.Agent_VerifyCoordinatorBackupServer) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyCoordinatorBackup", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// VerifyCoordinatorBackup indicates an expected call of VerifyCoordinatorBackup.
+func (mr *MockAgentServerMockRecorder) VerifyCoordinatorBackup(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyCoordinatorBackup", reflect.TypeOf((*MockAgentServer)(nil).VerifyCoordinatorBackup), arg0)
+}
+
+// VerifyRevertActions mocks base method.
+func (m *MockAgentServer) VerifyRevertActions(arg0 context.Context, arg1 *idl.VerifyRevertActionsRequest) (*idl.VerifyRevertActionsReply, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyRevertActions", arg0, arg1)
+	ret0, _ := ret[0].(*idl.VerifyRevertActionsReply)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyRevertActions indicates an expected call of VerifyRevertActions.
+func (mr *MockAgentServerMockRecorder) VerifyRevertActions(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyRevertActions", reflect.TypeOf((*MockAgentServer)(nil).VerifyRevertActions), arg0, arg1)
+}
+
 // MockUnsafeAgentServer is a mock of UnsafeAgentServer interface.
 type MockUnsafeAgentServer struct {
 	ctrl     *gomock.Controller
@@ -688,3 +1140,137 @@ func (mr *MockUnsafeAgentServerMockRecorder) mustEmbedUnimplementedAgentServer()
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "mustEmbedUnimplementedAgentServer", reflect.TypeOf((*MockUnsafeAgentServer)(nil).mustEmbedUnimplementedAgentServer))
 }
+
+// MockAgent_VerifyCoordinatorBackupServer is a mock of Agent_VerifyCoordinatorBackupServer interface.
+type MockAgent_VerifyCoordinatorBackupServer struct {
+	ctrl     *gomock.Controller
+	recorder *MockAgent_VerifyCoordinatorBackupServerMockRecorder
+}
+
+// MockAgent_VerifyCoordinatorBackupServerMockRecorder is the mock recorder for MockAgent_VerifyCoordinatorBackupServer.
+type MockAgent_VerifyCoordinatorBackupServerMockRecorder struct {
+	mock *MockAgent_VerifyCoordinatorBackupServer
+}
+
+// NewMockAgent_VerifyCoordinatorBackupServer creates a new mock instance.
+func NewMockAgent_VerifyCoordinatorBackupServer(ctrl *gomock.Controller) *MockAgent_VerifyCoordinatorBackupServer {
+	mock := &MockAgent_VerifyCoordinatorBackupServer{ctrl: ctrl}
+	mock.recorder = &MockAgent_VerifyCoordinatorBackupServerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockAgent_VerifyCoordinatorBackupServer) EXPECT() *MockAgent_VerifyCoordinatorBackupServerMockRecorder {
+	return m.recorder
+}
+
+// Context mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupServer) Context() context.Context {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Context")
+	ret0, _ := ret[0].(context.Context)
+	return ret0
+}
+
+// Context indicates an expected call of Context.
+func (mr *MockAgent_VerifyCoordinatorBackupServerMockRecorder) Context() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Context", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupServer)(nil).Context))
+}
+
+// Recv mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupServer) Recv() (*idl.VerifyCoordinatorBackupRequest, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Recv")
+	ret0, _ := ret[0].(*idl.VerifyCoordinatorBackupRequest)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Recv indicates an expected call of Recv.
+func (mr *MockAgent_VerifyCoordinatorBackupServerMockRecorder) Recv() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Recv", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupServer)(nil).Recv))
+}
+
+// RecvMsg mocks base method.
+func (m_2 *MockAgent_VerifyCoordinatorBackupServer) RecvMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "RecvMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RecvMsg indicates an expected call of RecvMsg.
+func (mr *MockAgent_VerifyCoordinatorBackupServerMockRecorder) RecvMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecvMsg", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupServer)(nil).RecvMsg), m)
+}
+
+// SendAndClose mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupServer) SendAndClose(arg0 *idl.VerifyCoordinatorBackupReply) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendAndClose", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendAndClose indicates an expected call of SendAndClose.
+func (mr *MockAgent_VerifyCoordinatorBackupServerMockRecorder) SendAndClose(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendAndClose", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupServer)(nil).SendAndClose), arg0)
+}
+
+// SendHeader mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupServer) SendHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SendHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendHeader indicates an expected call of SendHeader.
+func (mr *MockAgent_VerifyCoordinatorBackupServerMockRecorder) SendHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendHeader", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupServer)(nil).SendHeader), arg0)
+}
+
+// SendMsg mocks base method.
+func (m_2 *MockAgent_VerifyCoordinatorBackupServer) SendMsg(m interface{}) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "SendMsg", m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SendMsg indicates an expected call of SendMsg.
+func (mr *MockAgent_VerifyCoordinatorBackupServerMockRecorder) SendMsg(m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SendMsg", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupServer)(nil).SendMsg), m)
+}
+
+// SetHeader mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupServer) SetHeader(arg0 metadata.MD) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetHeader", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetHeader indicates an expected call of SetHeader.
+func (mr *MockAgent_VerifyCoordinatorBackupServerMockRecorder) SetHeader(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetHeader", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupServer)(nil).SetHeader), arg0)
+}
+
+// SetTrailer mocks base method.
+func (m *MockAgent_VerifyCoordinatorBackupServer) SetTrailer(arg0 metadata.MD) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "SetTrailer", arg0)
+}
+
+// SetTrailer indicates an expected call of SetTrailer.
+func (mr *MockAgent_VerifyCoordinatorBackupServerMockRecorder) SetTrailer(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetTrailer", reflect.TypeOf((*MockAgent_VerifyCoordinatorBackupServer)(nil).SetTrailer), arg0)
+}
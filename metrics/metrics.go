@@ -0,0 +1,215 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+// Package metrics holds gauges and counters about the progress of an upgrade
+// and serves them in the Prometheus text exposition format such that a
+// monitoring stack can scrape and alert on them during an upgrade.
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// ContentType is the version of the Prometheus text exposition format served.
+const ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+const (
+	gaugeType   = "gauge"
+	counterType = "counter"
+)
+
+// Registry holds the metrics served by its handler.
+type Registry struct {
+	mutex    sync.Mutex
+	families map[string]*family
+}
+
+func NewRegistry() *Registry {
+	return &Registry{families: make(map[string]*family)}
+}
+
+// family is a metric along with a sample for each set of label values.
+type family struct {
+	registry *Registry
+	name     string
+	help     string
+	kind     string
+	labels   []string
+	samples  map[string]*sample
+}
+
+type sample struct {
+	labelValues []string
+	value       float64
+}
+
+// Gauge is a metric whose value can go up and down such as the number of
+// processes running.
+type Gauge struct {
+	*family
+}
+
+// Counter is a metric whose value only increases such as the number of
+// substeps run.
+type Counter struct {
+	*family
+}
+
+func (r *Registry) NewGauge(name string, help string, labels ...string) *Gauge {
+	return &Gauge{r.register(name, help, gaugeType, labels)}
+}
+
+func (r *Registry) NewCounter(name string, help string, labels ...string) *Counter {
+	return &Counter{r.register(name, help, counterType, labels)}
+}
+
+func (r *Registry) register(name string, help string, kind string, labels []string) *family {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if _, ok := r.families[name]; ok {
+		panic(fmt.Sprintf("metric %q is already registered", name))
+	}
+
+	f := &family{registry: r, name: name, help: help, kind: kind, labels: labels, samples: make(map[string]*sample)}
+	r.families[name] = f
+	return f
+}
+
+// Set sets the value of the gauge for the label values.
+func (g *Gauge) Set(value float64, labelValues ...string) {
+	g.update(labelValues, func(s *sample) {
+		s.value = value
+	})
+}
+
+// Add adds the delta, which may be negative, to the value of the gauge for the
+// label values.
+func (g *Gauge) Add(delta float64, labelValues ...string) {
+	g.update(labelValues, func(s *sample) {
+		s.value += delta
+	})
+}
+
+// Inc increments the counter for the label values.
+func (c *Counter) Inc(labelValues ...string) {
+	c.update(labelValues, func(s *sample) {
+		s.value++
+	})
+}
+
+// Delete removes the sample for the label values such that it is no longer
+// served.
+func (f *family) Delete(labelValues ...string) {
+	f.registry.mutex.Lock()
+	defer f.registry.mutex.Unlock()
+
+	delete(f.samples, f.key(labelValues))
+}
+
+// Reset removes all samples of the metric.
+func (f *family) Reset() {
+	f.registry.mutex.Lock()
+	defer f.registry.mutex.Unlock()
+
+	f.samples = make(map[string]*sample)
+}
+
+func (f *family) update(labelValues []string, update func(s *sample)) {
+	f.registry.mutex.Lock()
+	defer f.registry.mutex.Unlock()
+
+	key := f.key(labelValues)
+	s, ok := f.samples[key]
+	if !ok {
+		s = &sample{labelValues: append([]string(nil), labelValues...)}
+		f.samples[key] = s
+	}
+
+	update(s)
+}
+
+func (f *family) key(labelValues []string) string {
+	if len(labelValues) != len(f.labels) {
+		panic(fmt.Sprintf("metric %q has labels %q but got values %q", f.name, f.labels, labelValues))
+	}
+
+	return strings.Join(labelValues, "\xff")
+}
+
+// Write writes the metrics in the Prometheus text exposition format ordered by
+// name and label values such that the output is stable.
+func (r *Registry) Write(w io.Writer) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	var names []string
+	for name := range r.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	buf := bufio.NewWriter(w)
+	for _, name := range names {
+		f := r.families[name]
+
+		fmt.Fprintf(buf, "# HELP %s %s\n", f.name, escapeHelp(f.help))
+		fmt.Fprintf(buf, "# TYPE %s %s\n", f.name, f.kind)
+
+		var keys []string
+		for key := range f.samples {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			s := f.samples[key]
+			fmt.Fprintf(buf, "%s%s %s\n", f.name, formatLabels(f.labels, s.labelValues), strconv.FormatFloat(s.value, 'g', -1, 64))
+		}
+	}
+
+	return buf.Flush()
+}
+
+// ServeHTTP serves the metrics to a scraper.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", ContentType)
+
+	err := r.Write(w)
+	if err != nil {
+		log.Printf("writing metrics: %v", err)
+	}
+}
+
+func formatLabels(labels []string, values []string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	var pairs []string
+	for i, label := range labels {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, label, escapeLabelValue(values[i])))
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+var helpEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+
+func escapeHelp(help string) string {
+	return helpEscaper.Replace(help)
+}
+
+func escapeLabelValue(value string) string {
+	return labelValueEscaper.Replace(value)
+}
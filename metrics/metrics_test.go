@@ -0,0 +1,127 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package metrics_test
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/metrics"
+)
+
+func TestRegistry(t *testing.T) {
+	t.Run("writes metrics in the text exposition format ordered by name and labels", func(t *testing.T) {
+		registry := metrics.NewRegistry()
+		inFlight := registry.NewGauge("gpupgrade_processes_in_flight", "Processes running per host.", "host", "process")
+		substeps := registry.NewCounter("gpupgrade_substeps_total", "Finished substeps.", "substep", "status")
+		connected := registry.NewGauge("gpupgrade_agent_connected", "Whether the agent is connected.\nSet to 1 or 0.", "host")
+
+		inFlight.Add(4, "sdw2", "rsync")
+		inFlight.Add(2, "sdw1", "pg_upgrade")
+		inFlight.Add(-2, "sdw1", "pg_upgrade")
+		substeps.Inc("upgrade_primaries", "complete")
+		substeps.Inc("upgrade_primaries", "complete")
+		connected.Set(1, `sdw"1\`)
+
+		var b strings.Builder
+		err := registry.Write(&b)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := `# HELP gpupgrade_agent_connected Whether the agent is connected.\nSet to 1 or 0.
+# TYPE gpupgrade_agent_connected gauge
+gpupgrade_agent_connected{host="sdw\"1\\"} 1
+# HELP gpupgrade_processes_in_flight Processes running per host.
+# TYPE gpupgrade_processes_in_flight gauge
+gpupgrade_processes_in_flight{host="sdw1",process="pg_upgrade"} 0
+gpupgrade_processes_in_flight{host="sdw2",process="rsync"} 4
+# HELP gpupgrade_substeps_total Finished substeps.
+# TYPE gpupgrade_substeps_total counter
+gpupgrade_substeps_total{substep="upgrade_primaries",status="complete"} 2
+`
+		if b.String() != expected {
+			t.Errorf("got metrics\n%s\nwant\n%s", b.String(), expected)
+		}
+	})
+
+	t.Run("deletes and resets samples", func(t *testing.T) {
+		registry := metrics.NewRegistry()
+		step := registry.NewGauge("gpupgrade_current_step", "The running step.", "step")
+		duration := registry.NewGauge("gpupgrade_duration_seconds", "A duration.")
+
+		step.Set(1, "initialize")
+		step.Set(1, "execute")
+		step.Delete("initialize")
+		duration.Set(1.5)
+
+		var b strings.Builder
+		err := registry.Write(&b)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if !strings.Contains(b.String(), "gpupgrade_current_step{step=\"execute\"} 1\n") || strings.Contains(b.String(), "initialize") {
+			t.Errorf("unexpected metrics %s", b.String())
+		}
+
+		if !strings.Contains(b.String(), "gpupgrade_duration_seconds 1.5\n") {
+			t.Errorf("expected metric without labels got %s", b.String())
+		}
+
+		step.Reset()
+		b.Reset()
+		err = registry.Write(&b)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if strings.Contains(b.String(), "execute") {
+			t.Errorf("expected samples to be reset got %s", b.String())
+		}
+	})
+
+	t.Run("panics on mismatched label values", func(t *testing.T) {
+		registry := metrics.NewRegistry()
+		gauge := registry.NewGauge("gpupgrade_current_step", "The running step.", "step")
+
+		defer func() {
+			if recover() == nil {
+				t.Errorf("expected panic")
+			}
+		}()
+
+		gauge.Set(1)
+	})
+
+	t.Run("serves the metrics over http", func(t *testing.T) {
+		registry := metrics.NewRegistry()
+		registry.NewCounter("gpupgrade_steps_total", "Finished steps.", "step").Inc("execute")
+
+		server := httptest.NewServer(registry)
+		defer server.Close()
+
+		resp, err := http.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+		defer resp.Body.Close()
+
+		if resp.Header.Get("Content-Type") != metrics.ContentType {
+			t.Errorf("got content type %q want %q", resp.Header.Get("Content-Type"), metrics.ContentType)
+		}
+
+		body, err := io.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if !strings.Contains(string(body), `gpupgrade_steps_total{step="execute"} 1`) {
+			t.Errorf("unexpected body %s", body)
+		}
+	})
+}
@@ -0,0 +1,264 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+// Package notify posts JSON events about the progress of an upgrade to webhook
+// URLs such that operators are notified when a step or substep finishes or
+// fails without watching a terminal. Events are delivered in order in the
+// background with retries, and delivery failures are only logged so that they
+// never block or fail the upgrade.
+package notify
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/xerrors"
+)
+
+const (
+	StepStarted   = "step_started"
+	StepCompleted = "step_completed"
+	StepFailed    = "step_failed"
+	SubstepStatus = "substep_status"
+)
+
+// Event is the JSON body posted to each webhook URL.
+type Event struct {
+	Type        string
+	Time        time.Time
+	UpgradeID   string
+	ClusterName string `json:",omitempty"`
+	Step        string
+	Substep     string  `json:",omitempty"`
+	Status      string  `json:",omitempty"`
+	Seconds     float64 `json:",omitempty"` // duration of a finished substep or step
+	Error       string  `json:",omitempty"`
+	NextActions string  `json:",omitempty"`
+}
+
+// queueSize bounds the events waiting to be delivered. Events are dropped
+// rather than blocking the upgrade when the webhooks cannot keep up.
+const queueSize = 1024
+
+var (
+	maxAttempts    = 5
+	initialBackoff = time.Second
+	maxBackoff     = 30 * time.Second
+	requestTimeout = 10 * time.Second
+)
+
+type delivery struct {
+	urls  []string
+	event Event
+}
+
+type Notifier struct {
+	client         *http.Client
+	initialBackoff time.Duration
+	maxBackoff     time.Duration
+	deliveries     chan delivery
+	done           chan struct{}
+	cancel         context.CancelFunc
+	ctx            context.Context
+
+	mutex  sync.Mutex
+	closed bool
+}
+
+// New starts a notifier which delivers events until it is closed.
+func New() *Notifier {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	n := &Notifier{
+		client:         &http.Client{Timeout: requestTimeout},
+		initialBackoff: initialBackoff,
+		maxBackoff:     maxBackoff,
+		deliveries:     make(chan delivery, queueSize),
+		done:           make(chan struct{}),
+		ctx:            ctx,
+		cancel:         cancel,
+	}
+
+	go n.deliver()
+	return n
+}
+
+// Notify queues the event for delivery to the URLs without blocking.
+func (n *Notifier) Notify(urls []string, event Event) {
+	if len(urls) == 0 {
+		return
+	}
+
+	if event.Time.IsZero() {
+		event.Time = time.Now()
+	}
+
+	n.mutex.Lock()
+	defer n.mutex.Unlock()
+
+	if n.closed {
+		return
+	}
+
+	select {
+	case n.deliveries <- delivery{urls: urls, event: event}:
+	default:
+		log.Printf("dropping %s notification for %s: too many pending notifications", event.Type, event.Step)
+	}
+}
+
+// Close waits up to the timeout for queued events to be delivered such that
+// the final events of a step are not lost when the hub stops. Any remaining
+// events are dropped and Close returns once in-flight requests are canceled.
+func (n *Notifier) Close(timeout time.Duration) {
+	n.mutex.Lock()
+	if n.closed {
+		n.mutex.Unlock()
+		return
+	}
+
+	n.closed = true
+	close(n.deliveries)
+	n.mutex.Unlock()
+
+	select {
+	case <-n.done:
+	case <-time.After(timeout):
+		log.Printf("timed out delivering notifications after %s", timeout)
+	}
+
+	n.cancel()
+	<-n.done
+}
+
+func (n *Notifier) deliver() {
+	defer close(n.done)
+
+	var dropped int
+	defer func() {
+		if dropped > 0 {
+			log.Printf("dropped %d pending notifications since the notifier is closed", dropped)
+		}
+	}()
+
+	for d := range n.deliveries {
+		if n.ctx.Err() != nil {
+			dropped++
+			continue
+		}
+
+		body, err := json.Marshal(d.event)
+		if err != nil {
+			log.Printf("marshal %s notification: %v", d.event.Type, err)
+			continue
+		}
+
+		for _, u := range d.urls {
+			err := n.post(u, body)
+			if err != nil {
+				log.Printf("delivering %s notification for %s to %s: %v", d.event.Type, d.event.Step, redact(u), err)
+			}
+		}
+	}
+}
+
+// post sends the body to the URL retrying with exponential backoff on
+// connection errors, server errors, and rate limiting.
+func (n *Notifier) post(u string, body []byte) error {
+	backoff := n.initialBackoff
+
+	var err error
+	for attempt := 1; attempt <= maxAttempts; attempt++ {
+		var retry bool
+		retry, err = n.postOnce(u, body)
+		if err == nil || !retry || attempt == maxAttempts {
+			break
+		}
+
+		select {
+		case <-time.After(backoff):
+		case <-n.ctx.Done():
+			return xerrors.Errorf("notifier closed: %w", err)
+		}
+
+		backoff = min(2*backoff, n.maxBackoff)
+	}
+
+	return err
+}
+
+func (n *Notifier) postOnce(u string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(n.ctx, http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return false, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close() //nolint
+
+	if resp.StatusCode >= 200 && resp.StatusCode < 300 {
+		return false, nil
+	}
+
+	retry := resp.StatusCode >= 500 || resp.StatusCode == http.StatusTooManyRequests
+	return retry, fmt.Errorf("unexpected response %q", resp.Status)
+}
+
+// ParseURLs parses a comma separated list of http or https webhook URLs. An
+// empty value disables notifications.
+func ParseURLs(value string) ([]string, error) {
+	var urls []string
+	for _, u := range strings.Split(value, ",") {
+		u = strings.TrimSpace(u)
+		if u == "" {
+			continue
+		}
+
+		parsed, err := url.Parse(u)
+		if err != nil || (parsed.Scheme != "http" && parsed.Scheme != "https") || parsed.Host == "" {
+			return nil, xerrors.Errorf("invalid webhook URL %q. Please specify an http or https URL.", redact(u))
+		}
+
+		urls = append(urls, u)
+	}
+
+	return urls, nil
+}
+
+// redact removes credentials and query parameters, which often hold tokens,
+// from a URL before it is logged.
+func redact(u string) string {
+	parsed, err := url.Parse(u)
+	if err != nil {
+		return "<invalid URL>"
+	}
+
+	parsed.User = nil
+	parsed.RawQuery = ""
+	return parsed.String()
+}
+
+// XXX: for internal testing only
+func SetBackoff(initial time.Duration, maximum time.Duration) {
+	initialBackoff = initial
+	maxBackoff = maximum
+}
+
+// XXX: for internal testing only
+func ResetBackoff() {
+	initialBackoff = time.Second
+	maxBackoff = 30 * time.Second
+}
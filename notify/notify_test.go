@@ -0,0 +1,216 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package notify_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/greenplum-db/gpupgrade/notify"
+)
+
+// webhook is a local stand-in for a webhook receiver which records the events
+// posted to it. It fails the first requests with the given status.
+type webhook struct {
+	*httptest.Server
+
+	mutex    sync.Mutex
+	events   []notify.Event
+	requests int
+	failures int
+	status   int
+	received chan struct{}
+}
+
+func newWebhook(t *testing.T, failures int, status int) *webhook {
+	t.Helper()
+
+	w := &webhook{failures: failures, status: status, received: make(chan struct{}, 100)}
+	w.Server = httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		w.mutex.Lock()
+		defer w.mutex.Unlock()
+
+		w.requests++
+		if w.requests <= w.failures {
+			rw.WriteHeader(w.status)
+			return
+		}
+
+		if r.Header.Get("Content-Type") != "application/json" {
+			t.Errorf("got content type %q want application/json", r.Header.Get("Content-Type"))
+		}
+
+		var event notify.Event
+		err := json.NewDecoder(r.Body).Decode(&event)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		w.events = append(w.events, event)
+		w.received <- struct{}{}
+	}))
+
+	t.Cleanup(w.Close)
+	return w
+}
+
+func (w *webhook) Events() []notify.Event {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.events
+}
+
+func (w *webhook) Requests() int {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	return w.requests
+}
+
+func TestNotifier(t *testing.T) {
+	notify.SetBackoff(time.Millisecond, 5*time.Millisecond)
+	defer notify.ResetBackoff()
+
+	t.Run("posts events in order to every URL", func(t *testing.T) {
+		first := newWebhook(t, 0, 0)
+		second := newWebhook(t, 0, 0)
+
+		now := time.Now().UTC()
+		events := []notify.Event{
+			{Type: notify.StepStarted, Time: now, UpgradeID: "AAAAAAAAAAA", Step: "execute"},
+			{Type: notify.SubstepStatus, Time: now, UpgradeID: "AAAAAAAAAAA", Step: "execute", Substep: "upgrade_master", Status: "complete", Seconds: 90},
+			{Type: notify.StepFailed, Time: now, UpgradeID: "AAAAAAAAAAA", Step: "execute", Error: "failed", NextActions: "Fix it."},
+		}
+
+		n := notify.New()
+		for _, event := range events {
+			n.Notify([]string{first.URL, second.URL}, event)
+		}
+		n.Close(5 * time.Second)
+
+		for _, w := range []*webhook{first, second} {
+			if !reflect.DeepEqual(w.Events(), events) {
+				t.Errorf("got events %+v want %+v", w.Events(), events)
+			}
+		}
+	})
+
+	t.Run("retries server errors with backoff", func(t *testing.T) {
+		w := newWebhook(t, 2, http.StatusServiceUnavailable)
+
+		n := notify.New()
+		n.Notify([]string{w.URL}, notify.Event{Type: notify.StepCompleted, Step: "initialize"})
+		n.Close(5 * time.Second)
+
+		if w.Requests() != 3 {
+			t.Errorf("got %d requests want 3", w.Requests())
+		}
+
+		if len(w.Events()) != 1 || w.Events()[0].Type != notify.StepCompleted {
+			t.Errorf("got events %+v", w.Events())
+		}
+
+		if w.Events()[0].Time.IsZero() {
+			t.Errorf("expected the event time to be set")
+		}
+	})
+
+	t.Run("gives up after the maximum attempts", func(t *testing.T) {
+		w := newWebhook(t, 100, http.StatusInternalServerError)
+
+		n := notify.New()
+		n.Notify([]string{w.URL}, notify.Event{Type: notify.StepCompleted, Step: "initialize"})
+		n.Close(5 * time.Second)
+
+		if w.Requests() != 5 {
+			t.Errorf("got %d requests want 5", w.Requests())
+		}
+	})
+
+	t.Run("does not retry client errors", func(t *testing.T) {
+		w := newWebhook(t, 1, http.StatusBadRequest)
+
+		n := notify.New()
+		n.Notify([]string{w.URL}, notify.Event{Type: notify.StepCompleted, Step: "initialize"})
+		n.Close(5 * time.Second)
+
+		if w.Requests() != 1 {
+			t.Errorf("got %d requests want 1", w.Requests())
+		}
+	})
+
+	t.Run("does not block when the webhook is unresponsive", func(t *testing.T) {
+		block := make(chan struct{})
+		server := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			<-block
+		}))
+		defer server.Close()
+		defer close(block)
+
+		n := notify.New()
+
+		done := make(chan struct{})
+		go func() {
+			for i := 0; i < 2000; i++ {
+				n.Notify([]string{server.URL}, notify.Event{Type: notify.SubstepStatus, Step: "execute"})
+			}
+			close(done)
+		}()
+
+		select {
+		case <-done:
+		case <-time.After(5 * time.Second):
+			t.Fatalf("notify blocked on an unresponsive webhook")
+		}
+
+		start := time.Now()
+		n.Close(10 * time.Millisecond)
+		if time.Since(start) > 5*time.Second {
+			t.Errorf("close did not honor its timeout")
+		}
+
+		// notifying after closing is ignored
+		n.Notify([]string{server.URL}, notify.Event{Type: notify.SubstepStatus, Step: "execute"})
+	})
+
+	t.Run("ignores events without URLs", func(t *testing.T) {
+		n := notify.New()
+		n.Notify(nil, notify.Event{Type: notify.StepStarted, Step: "execute"})
+		n.Close(time.Second)
+	})
+}
+
+func TestParseURLs(t *testing.T) {
+	urls, err := notify.ParseURLs(" https://alerts.example.com/hook , http://localhost:8080/gpupgrade?token=secret")
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	expected := []string{"https://alerts.example.com/hook", "http://localhost:8080/gpupgrade?token=secret"}
+	if !reflect.DeepEqual(urls, expected) {
+		t.Errorf("got %v want %v", urls, expected)
+	}
+
+	urls, err = notify.ParseURLs("")
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	if len(urls) != 0 {
+		t.Errorf("got %v want none", urls)
+	}
+
+	for _, value := range []string{"alerts.example.com", "ftp://alerts.example.com", "https://", "https://example.com,::"} {
+		_, err := notify.ParseURLs(value)
+		if err == nil {
+			t.Errorf("expected error for %q got nil", value)
+		}
+	}
+}
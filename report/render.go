@@ -0,0 +1,53 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+import (
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/utils/disk"
+	"github.com/greenplum-db/gpupgrade/utils/stopwatch"
+)
+
+var funcs = map[string]any{
+	"bytes":    disk.FormatBytes,
+	"duration": stopwatch.Format,
+	"time": func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+
+		return t.Local().Format("2006-01-02 15:04:05")
+	},
+	// cell escapes the Markdown table delimiter and keeps multiline text
+	// such as next actions within a single table cell.
+	"cell": func(s string) string {
+		s = strings.ReplaceAll(s, "|", `\|`)
+		return strings.ReplaceAll(strings.TrimSpace(s), "\n", "<br>")
+	},
+}
+
+var (
+	markdown = texttemplate.Must(texttemplate.New("markdown").Funcs(funcs).Parse(markdownTemplate))
+	html     = htmltemplate.Must(htmltemplate.New("html").Funcs(funcs).Parse(htmlTemplate))
+)
+
+// Render writes the report in either Markdown or HTML format. Both are single
+// files without external resources.
+func Render(w io.Writer, r Report, format string) error {
+	switch format {
+	case FormatMarkdown:
+		return markdown.Execute(w, r)
+	case FormatHTML:
+		return html.Execute(w, r)
+	default:
+		return xerrors.Errorf("invalid format %q. Please specify either %s or %s.", format, FormatMarkdown, FormatHTML)
+	}
+}
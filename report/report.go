@@ -0,0 +1,317 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+// Package report generates a self-contained report of an upgrade for change
+// management. The report data is kept as JSON in the log archive directory
+// such that the report can be rendered again, for example after applying the
+// finalize or revert data migration scripts which runs after the log
+// directory is archived.
+package report
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+const (
+	DataFileName      = "report.json"
+	DiskUsageFileName = "disk_usage.json"
+	MarkdownFileName  = "gpupgrade_report.md"
+	HTMLFileName      = "gpupgrade_report.html"
+
+	FormatMarkdown = "markdown"
+	FormatHTML     = "html"
+)
+
+type Report struct {
+	UpgradeID            string
+	ClusterName          string `json:",omitempty"`
+	Outcome              string
+	Mode                 string
+	Generated            time.Time
+	Source               Cluster
+	Target               Cluster
+	Substeps             []step.SubstepDuration
+	DiskUsageBefore      []DiskUsage
+	DiskUsageAfter       []DiskUsage
+	DataMigrationScripts []ScriptPhase
+	Issues               []step.Issue
+	ArchivedPaths        []ArchivedPath
+}
+
+type Cluster struct {
+	GPHome   string
+	Version  string
+	Segments greenplum.SegConfigs
+}
+
+// DiskUsage is the usage in kilobytes of a filesystem holding data
+// directories or tablespaces. The size excludes space reserved for the
+// superuser.
+type DiskUsage struct {
+	Host        string
+	Filesystem  string
+	UsedKB      uint64
+	AvailableKB uint64
+	SizeKB      uint64
+}
+
+// ScriptPhase lists the data migration scripts applied during a phase such as
+// initialize or finalize.
+type ScriptPhase struct {
+	Phase   string
+	Scripts []string
+}
+
+type ArchivedPath struct {
+	Description string
+	Path        string
+}
+
+// New assembles the report of the upgrade from its configuration and the
+// files recorded in the state and log archive directories. Source is the
+// cluster as it was before the upgrade, and target the resulting cluster
+// which is the source again after a revert.
+func New(conf *config.Config, outcome string, target *greenplum.Cluster, stateDir string, logArchiveDir string, diskUsageAfter []*idl.GetDiskUsageReply_FilesystemUsage, archivedPaths []ArchivedPath) (Report, error) {
+	r := Report{
+		UpgradeID:      conf.UpgradeID,
+		ClusterName:    utils.GetClusterName(),
+		Outcome:        outcome,
+		Mode:           conf.Mode.String(),
+		Source:         newCluster(conf.Source),
+		Target:         newCluster(target),
+		DiskUsageAfter: newDiskUsage(diskUsageAfter),
+		ArchivedPaths:  archivedPaths,
+	}
+
+	var err error
+	r.DiskUsageBefore, err = ReadDiskUsage(stateDir)
+	if err != nil {
+		return Report{}, err
+	}
+
+	err = r.refresh(stateDir, logArchiveDir)
+	if err != nil {
+		return Report{}, err
+	}
+
+	return r, nil
+}
+
+func newCluster(c *greenplum.Cluster) Cluster {
+	if c == nil {
+		return Cluster{}
+	}
+
+	segs := c.SelectSegments(func(*greenplum.SegConfig) bool { return true })
+	sort.Slice(segs, func(i, j int) bool {
+		return segs[i].DbID < segs[j].DbID
+	})
+
+	return Cluster{GPHome: c.GPHome, Version: c.Version.String(), Segments: segs}
+}
+
+func newDiskUsage(usages []*idl.GetDiskUsageReply_FilesystemUsage) []DiskUsage {
+	var result []DiskUsage
+	for _, usage := range usages {
+		result = append(result, DiskUsage{
+			Host:        usage.GetHost(),
+			Filesystem:  usage.GetFs(),
+			UsedKB:      usage.GetUsed(),
+			AvailableKB: usage.GetAvailable(),
+			SizeKB:      usage.GetTotal(),
+		})
+	}
+
+	return result
+}
+
+// refresh updates the parts of the report that may change after it is first
+// generated. The substep durations and issues are only updated while the
+// state directory exists.
+func (r *Report) refresh(stateDir string, logArchiveDir string) error {
+	r.Generated = time.Now()
+
+	var err error
+	r.DataMigrationScripts, err = readScriptPhases(logArchiveDir)
+	if err != nil {
+		return err
+	}
+
+	_, err = os.Stat(stateDir)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	r.Substeps, err = step.ReadDurations(stateDir)
+	if err != nil {
+		return err
+	}
+
+	r.Issues, err = step.ReadIssues(stateDir)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// WriteDiskUsage records the disk usage before the upgrade in the state
+// directory.
+func WriteDiskUsage(stateDir string, usages []*idl.GetDiskUsageReply_FilesystemUsage) error {
+	data, err := json.MarshalIndent(newDiskUsage(usages), "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return utils.AtomicallyWrite(filepath.Join(stateDir, DiskUsageFileName), data)
+}
+
+func ReadDiskUsage(stateDir string) ([]DiskUsage, error) {
+	var usages []DiskUsage
+	err := readJSON(filepath.Join(stateDir, DiskUsageFileName), &usages)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	return usages, err
+}
+
+// readScriptPhases returns the data migration scripts applied during each
+// phase from the checkpoint files written when applying them. Phases are
+// ordered as they occur during the upgrade.
+func readScriptPhases(logArchiveDir string) ([]ScriptPhase, error) {
+	paths, err := filepath.Glob(filepath.Join(logArchiveDir, "apply_*.checkpoint"))
+	if err != nil {
+		return nil, err
+	}
+
+	var phases []ScriptPhase
+	for _, path := range paths {
+		contents, err := os.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+
+		phase := ScriptPhase{Phase: strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), "apply_"), ".checkpoint")}
+		for _, line := range strings.Split(string(contents), "\n") {
+			// Each line is the script path followed by a tab and the hash of
+			// its contents.
+			script, _, _ := strings.Cut(line, "\t")
+			script = strings.TrimSpace(script)
+			if script != "" {
+				phase.Scripts = append(phase.Scripts, script)
+			}
+		}
+
+		phases = append(phases, phase)
+	}
+
+	sort.SliceStable(phases, func(i, j int) bool {
+		return idl.Step_value[phases[i].Phase] < idl.Step_value[phases[j].Phase]
+	})
+
+	return phases, nil
+}
+
+// Write writes the report data along with the Markdown and HTML reports to
+// the directory and returns the paths of the reports.
+func Write(dir string, r Report) ([]string, error) {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	err = utils.AtomicallyWrite(filepath.Join(dir, DataFileName), data)
+	if err != nil {
+		return nil, err
+	}
+
+	var paths []string
+	for _, format := range []string{FormatMarkdown, FormatHTML} {
+		path := filepath.Join(dir, FileName(format))
+
+		var b strings.Builder
+		err = Render(&b, r, format)
+		if err != nil {
+			return nil, err
+		}
+
+		err = utils.AtomicallyWrite(path, []byte(b.String()))
+		if err != nil {
+			return nil, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// Regenerate renders the reports in the log archive directory again to include
+// data migration scripts applied after the report was first generated. The
+// substep durations and issues are updated from the state directory when it
+// still exists.
+func Regenerate(logArchiveDir string) ([]string, error) {
+	r, err := Read(logArchiveDir)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.refresh(utils.GetStateDir(), logArchiveDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return Write(logArchiveDir, r)
+}
+
+// Read returns the report data from the log archive directory.
+func Read(logArchiveDir string) (Report, error) {
+	var r Report
+	err := readJSON(filepath.Join(logArchiveDir, DataFileName), &r)
+	if err != nil {
+		return Report{}, xerrors.Errorf("read report: %w", err)
+	}
+
+	return r, nil
+}
+
+func FileName(format string) string {
+	if format == FormatHTML {
+		return HTMLFileName
+	}
+
+	return MarkdownFileName
+}
+
+func readJSON(path string, v any) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+
+	err = json.Unmarshal(data, v)
+	if err != nil {
+		return xerrors.Errorf("read %q: %w", path, err)
+	}
+
+	return nil
+}
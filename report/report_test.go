@@ -0,0 +1,245 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package report_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/blang/semver/v4"
+
+	"github.com/greenplum-db/gpupgrade/config"
+	"github.com/greenplum-db/gpupgrade/greenplum"
+	"github.com/greenplum-db/gpupgrade/history"
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/report"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils"
+)
+
+func TestReport(t *testing.T) {
+	dir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, dir)
+
+	stateDir := filepath.Join(dir, ".gpupgrade")
+	t.Setenv("GPUPGRADE_HOME", stateDir)
+	err := os.MkdirAll(stateDir, 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	logArchiveDir := filepath.Join(dir, "gpupgrade-AAAAAAAAAAA-20230501T100000")
+	err = os.MkdirAll(logArchiveDir, 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	source := greenplum.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 2, ContentID: 0, Hostname: "sdw1", DataDir: "/data/dbfast1/seg0", Port: 25432, Role: greenplum.PrimaryRole},
+		{DbID: 1, ContentID: -1, Hostname: "cdw", DataDir: "/data/qddir/seg-1", Port: 5432, Role: greenplum.PrimaryRole},
+	})
+	source.GPHome = "/usr/local/greenplum-db-6"
+	source.Version = semver.MustParse("6.25.0")
+
+	target := greenplum.MustCreateCluster(t, greenplum.SegConfigs{
+		{DbID: 1, ContentID: -1, Hostname: "cdw", DataDir: "/data/qddir/seg-1", Port: 5432, Role: greenplum.PrimaryRole},
+	})
+	target.GPHome = "/usr/local/greenplum-db-7"
+	target.Version = semver.MustParse("7.1.0")
+
+	conf := &config.Config{UpgradeID: "AAAAAAAAAAA", Mode: idl.Mode_link, Source: source}
+
+	before := []*idl.GetDiskUsageReply_FilesystemUsage{{Fs: "/data", Host: "cdw", Used: 3072, Available: 1024, Total: 4096}}
+	err = report.WriteDiskUsage(stateDir, before)
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	store := step.NewSubstepStoreUsingFile(filepath.Join(stateDir, step.SubstepsFileName))
+	duration := step.SubstepDuration{Step: "initialize", Substep: "check_upgrade", Status: "complete", Start: time.Now().UTC(), Duration: time.Minute}
+	err = store.RecordDuration(duration)
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	issue := step.Issue{Time: time.Now().UTC(), Step: "execute", Error: "upgrade primaries | failed", NextAction: "Fix it.\nRun again."}
+	err = store.RecordIssue(issue)
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	testutils.MustWriteToFile(t, filepath.Join(logArchiveDir, "apply_initialize.checkpoint"), "/scripts/initialize/a.sql\t4f2b\n/scripts/initialize/b.sql\t9c1e\n")
+
+	after := []*idl.GetDiskUsageReply_FilesystemUsage{{Fs: "/data", Host: "cdw", Used: 3584, Available: 512, Total: 4096}}
+	archived := []report.ArchivedPath{{Description: "Log directory", Path: logArchiveDir}}
+
+	t.Run("assembles the report from the configuration and recorded files", func(t *testing.T) {
+		r, err := report.New(conf, history.Finalized, target, stateDir, logArchiveDir, after, archived)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if r.UpgradeID != "AAAAAAAAAAA" || r.Outcome != history.Finalized || r.Mode != "link" {
+			t.Errorf("got report %+v", r)
+		}
+
+		if r.Source.Version != "6.25.0" || r.Target.Version != "7.1.0" || r.Target.GPHome != target.GPHome {
+			t.Errorf("got source %+v target %+v", r.Source, r.Target)
+		}
+
+		if len(r.Source.Segments) != 2 || r.Source.Segments[0].DbID != 1 {
+			t.Errorf("got source segments %+v want them ordered by dbid", r.Source.Segments)
+		}
+
+		expectedBefore := []report.DiskUsage{{Host: "cdw", Filesystem: "/data", UsedKB: 3072, AvailableKB: 1024, SizeKB: 4096}}
+		if !reflect.DeepEqual(r.DiskUsageBefore, expectedBefore) {
+			t.Errorf("got disk usage before %+v want %+v", r.DiskUsageBefore, expectedBefore)
+		}
+
+		expectedAfter := []report.DiskUsage{{Host: "cdw", Filesystem: "/data", UsedKB: 3584, AvailableKB: 512, SizeKB: 4096}}
+		if !reflect.DeepEqual(r.DiskUsageAfter, expectedAfter) {
+			t.Errorf("got disk usage after %+v want %+v", r.DiskUsageAfter, expectedAfter)
+		}
+
+		expectedScripts := []report.ScriptPhase{{Phase: "initialize", Scripts: []string{"/scripts/initialize/a.sql", "/scripts/initialize/b.sql"}}}
+		if !reflect.DeepEqual(r.DataMigrationScripts, expectedScripts) {
+			t.Errorf("got scripts %+v want %+v", r.DataMigrationScripts, expectedScripts)
+		}
+
+		if !reflect.DeepEqual(r.Substeps, []step.SubstepDuration{duration}) {
+			t.Errorf("got substeps %+v want %+v", r.Substeps, duration)
+		}
+
+		if !reflect.DeepEqual(r.Issues, []step.Issue{issue}) {
+			t.Errorf("got issues %+v want %+v", r.Issues, issue)
+		}
+
+		if !reflect.DeepEqual(r.ArchivedPaths, archived) {
+			t.Errorf("got archived paths %+v want %+v", r.ArchivedPaths, archived)
+		}
+	})
+
+	t.Run("writes the report data along with the markdown and html reports", func(t *testing.T) {
+		r, err := report.New(conf, history.Finalized, target, stateDir, logArchiveDir, after, archived)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		paths, err := report.Write(logArchiveDir, r)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := []string{
+			filepath.Join(logArchiveDir, report.MarkdownFileName),
+			filepath.Join(logArchiveDir, report.HTMLFileName),
+		}
+		if !reflect.DeepEqual(paths, expected) {
+			t.Errorf("got paths %v want %v", paths, expected)
+		}
+
+		markdown := testutils.MustReadFile(t, paths[0])
+		for _, expected := range []string{"| Upgrade ID | AAAAAAAAAAA |", "| Target version | 7.1.0 |", "- /scripts/initialize/b.sql",
+			`upgrade primaries \| failed`, "Fix it.<br>Run again.", "| 1 | -1 | p | cdw | 5432 | /data/qddir/seg-1 |"} {
+			if !strings.Contains(markdown, expected) {
+				t.Errorf("expected markdown report to contain %q got %s", expected, markdown)
+			}
+		}
+
+		html := testutils.MustReadFile(t, paths[1])
+		if !strings.Contains(html, "<td>AAAAAAAAAAA</td>") || !strings.Contains(html, "upgrade primaries | failed") {
+			t.Errorf("unexpected html report %s", html)
+		}
+
+		read, err := report.Read(logArchiveDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if read.UpgradeID != r.UpgradeID || !reflect.DeepEqual(read.DataMigrationScripts, r.DataMigrationScripts) {
+			t.Errorf("got report %+v want %+v", read, r)
+		}
+	})
+
+	t.Run("regenerates the report with scripts applied afterwards ordered by phase", func(t *testing.T) {
+		testutils.MustWriteToFile(t, filepath.Join(logArchiveDir, "apply_finalize.checkpoint"), "/scripts/finalize/c.sql\n")
+
+		_, err := report.Regenerate(logArchiveDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		r, err := report.Read(logArchiveDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := []report.ScriptPhase{
+			{Phase: "initialize", Scripts: []string{"/scripts/initialize/a.sql", "/scripts/initialize/b.sql"}},
+			{Phase: "finalize", Scripts: []string{"/scripts/finalize/c.sql"}},
+		}
+		if !reflect.DeepEqual(r.DataMigrationScripts, expected) {
+			t.Errorf("got scripts %+v want %+v", r.DataMigrationScripts, expected)
+		}
+
+		markdown := testutils.MustReadFile(t, filepath.Join(logArchiveDir, report.MarkdownFileName))
+		if !strings.Contains(markdown, "- /scripts/finalize/c.sql") {
+			t.Errorf("expected regenerated report to contain finalize scripts got %s", markdown)
+		}
+	})
+
+	t.Run("keeps the recorded substeps when the state directory is deleted", func(t *testing.T) {
+		testutils.MustRemoveAll(t, stateDir)
+
+		_, err := report.Regenerate(logArchiveDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		r, err := report.Read(logArchiveDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(r.Substeps) != 1 || len(r.Issues) != 1 {
+			t.Errorf("got substeps %+v and issues %+v", r.Substeps, r.Issues)
+		}
+	})
+
+	t.Run("errors when there is no report to regenerate", func(t *testing.T) {
+		_, err := report.Regenerate(dir)
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("got error %#v want not exist", err)
+		}
+	})
+}
+
+func TestRender(t *testing.T) {
+	t.Run("renders an empty report", func(t *testing.T) {
+		var b strings.Builder
+		err := report.Render(&b, report.Report{}, report.FormatMarkdown)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		for _, expected := range []string{"No substeps were recorded.", "No data migration scripts were applied.", "No issues were encountered.", "No paths were archived."} {
+			if !strings.Contains(b.String(), expected) {
+				t.Errorf("expected report to contain %q got %s", expected, b.String())
+			}
+		}
+	})
+
+	t.Run("errors on an invalid format", func(t *testing.T) {
+		var b strings.Builder
+		err := report.Render(&b, report.Report{}, "pdf")
+		if err == nil {
+			t.Errorf("expected error got nil")
+		}
+	})
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package report
+
+const markdownTemplate = `# gpupgrade report
+
+| | |
+|---|---|
+| Upgrade ID | {{.UpgradeID}} |
+{{- if .ClusterName}}
+| Cluster name | {{.ClusterName}} |
+{{- end}}
+| Outcome | {{.Outcome}} |
+| Mode | {{.Mode}} |
+| Source version | {{.Source.Version}} |
+| Target version | {{.Target.Version}} |
+| Source GPHOME | {{.Source.GPHome}} |
+| Target GPHOME | {{.Target.GPHome}} |
+| Generated | {{time .Generated}} |
+
+## Substep timeline
+
+{{if .Substeps -}}
+| Step | Substep | Status | Start | Duration |
+|---|---|---|---|---|
+{{- range .Substeps}}
+| {{.Step}} | {{.Substep}} | {{.Status}} | {{time .Start}} | {{duration .Duration}} |
+{{- end}}
+{{- else -}}
+No substeps were recorded.
+{{- end}}
+
+## Disk usage
+
+### Before
+{{template "markdownDiskUsage" .DiskUsageBefore}}
+
+### After
+{{template "markdownDiskUsage" .DiskUsageAfter}}
+
+## Data migration scripts applied
+
+{{range .DataMigrationScripts -}}
+### {{.Phase}}
+
+{{range .Scripts -}}
+- {{.}}
+{{end}}
+{{else -}}
+No data migration scripts were applied.
+
+{{end -}}
+## Warnings and next actions
+
+{{if .Issues -}}
+| Time | Step | Error | Next action |
+|---|---|---|---|
+{{- range .Issues}}
+| {{time .Time}} | {{.Step}} | {{cell .Error}} | {{cell .NextAction}} |
+{{- end}}
+{{- else -}}
+No issues were encountered.
+{{- end}}
+
+## Archived paths
+
+{{range .ArchivedPaths -}}
+- {{.Description}}: ` + "`{{.Path}}`" + `
+{{else -}}
+No paths were archived.
+{{end}}
+## Source topology
+{{template "markdownSegments" .Source.Segments}}
+
+## Target topology
+{{template "markdownSegments" .Target.Segments}}
+
+{{- define "markdownDiskUsage"}}
+{{if . -}}
+| Host | Filesystem | Used | Available | Size |
+|---|---|---|---|---|
+{{- range .}}
+| {{.Host}} | {{.Filesystem}} | {{bytes .UsedKB}} | {{bytes .AvailableKB}} | {{bytes .SizeKB}} |
+{{- end}}
+{{- else -}}
+Not recorded.
+{{- end}}
+{{- end}}
+
+{{- define "markdownSegments"}}
+| DbID | Content | Role | Hostname | Port | Data directory |
+|---|---|---|---|---|---|
+{{- range .}}
+| {{.DbID}} | {{.ContentID}} | {{.Role}} | {{.Hostname}} | {{.Port}} | {{.DataDir}} |
+{{- end}}
+{{- end}}
+`
+
+const htmlTemplate = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>gpupgrade report {{.UpgradeID}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; margin-bottom: 1em; }
+th, td { border: 1px solid #ccc; padding: 0.25em 0.5em; text-align: left; vertical-align: top; }
+th { background: #f0f0f0; }
+pre { margin: 0; white-space: pre-wrap; }
+</style>
+</head>
+<body>
+<h1>gpupgrade report</h1>
+<table>
+<tr><th>Upgrade ID</th><td>{{.UpgradeID}}</td></tr>
+{{- if .ClusterName}}
+<tr><th>Cluster name</th><td>{{.ClusterName}}</td></tr>
+{{- end}}
+<tr><th>Outcome</th><td>{{.Outcome}}</td></tr>
+<tr><th>Mode</th><td>{{.Mode}}</td></tr>
+<tr><th>Source version</th><td>{{.Source.Version}}</td></tr>
+<tr><th>Target version</th><td>{{.Target.Version}}</td></tr>
+<tr><th>Source GPHOME</th><td>{{.Source.GPHome}}</td></tr>
+<tr><th>Target GPHOME</th><td>{{.Target.GPHome}}</td></tr>
+<tr><th>Generated</th><td>{{time .Generated}}</td></tr>
+</table>
+
+<h2>Substep timeline</h2>
+{{if .Substeps -}}
+<table>
+<tr><th>Step</th><th>Substep</th><th>Status</th><th>Start</th><th>Duration</th></tr>
+{{- range .Substeps}}
+<tr><td>{{.Step}}</td><td>{{.Substep}}</td><td>{{.Status}}</td><td>{{time .Start}}</td><td>{{duration .Duration}}</td></tr>
+{{- end}}
+</table>
+{{- else -}}
+<p>No substeps were recorded.</p>
+{{- end}}
+
+<h2>Disk usage</h2>
+<h3>Before</h3>
+{{template "htmlDiskUsage" .DiskUsageBefore}}
+<h3>After</h3>
+{{template "htmlDiskUsage" .DiskUsageAfter}}
+
+<h2>Data migration scripts applied</h2>
+{{range .DataMigrationScripts -}}
+<h3>{{.Phase}}</h3>
+<ul>
+{{- range .Scripts}}
+<li>{{.}}</li>
+{{- end}}
+</ul>
+{{else -}}
+<p>No data migration scripts were applied.</p>
+{{end}}
+<h2>Warnings and next actions</h2>
+{{if .Issues -}}
+<table>
+<tr><th>Time</th><th>Step</th><th>Error</th><th>Next action</th></tr>
+{{- range .Issues}}
+<tr><td>{{time .Time}}</td><td>{{.Step}}</td><td><pre>{{.Error}}</pre></td><td><pre>{{.NextAction}}</pre></td></tr>
+{{- end}}
+</table>
+{{- else -}}
+<p>No issues were encountered.</p>
+{{- end}}
+
+<h2>Archived paths</h2>
+{{if .ArchivedPaths -}}
+<ul>
+{{- range .ArchivedPaths}}
+<li>{{.Description}}: <code>{{.Path}}</code></li>
+{{- end}}
+</ul>
+{{- else -}}
+<p>No paths were archived.</p>
+{{- end}}
+
+<h2>Source topology</h2>
+{{template "htmlSegments" .Source.Segments}}
+<h2>Target topology</h2>
+{{template "htmlSegments" .Target.Segments}}
+</body>
+</html>
+
+{{- define "htmlDiskUsage"}}
+{{- if .}}
+<table>
+<tr><th>Host</th><th>Filesystem</th><th>Used</th><th>Available</th><th>Size</th></tr>
+{{- range .}}
+<tr><td>{{.Host}}</td><td>{{.Filesystem}}</td><td>{{bytes .UsedKB}}</td><td>{{bytes .AvailableKB}}</td><td>{{bytes .SizeKB}}</td></tr>
+{{- end}}
+</table>
+{{- else}}
+<p>Not recorded.</p>
+{{- end}}
+{{- end}}
+
+{{- define "htmlSegments"}}
+<table>
+<tr><th>DbID</th><th>Content</th><th>Role</th><th>Hostname</th><th>Port</th><th>Data directory</th></tr>
+{{- range .}}
+<tr><td>{{.DbID}}</td><td>{{.ContentID}}</td><td>{{.Role}}</td><td>{{.Hostname}}</td><td>{{.Port}}</td><td>{{.DataDir}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+`
@@ -0,0 +1,123 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package step
+
+import (
+	"encoding/json"
+	"errors"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/schema"
+	"github.com/greenplum-db/gpupgrade/utils/stopwatch"
+)
+
+const DurationsFileName = "durations.json"
+
+// DurationsSchema is the versioned format of durations.json which was
+// originally written as a list of durations.
+var DurationsSchema = schema.Schema{
+	File: DurationsFileName,
+	Migrations: []schema.Migration{
+		schema.AddVersion,
+	},
+	ListKey: "Durations",
+}
+
+type durationsFile struct {
+	SchemaVersion int
+	Durations     []SubstepDuration
+}
+
+// SubstepDuration is a single run of a substep. Each run is recorded such that
+// retried substeps are visible in the upgrade history.
+type SubstepDuration struct {
+	Step     string
+	Substep  string
+	Status   string
+	Start    time.Time
+	Duration time.Duration
+}
+
+// DurationRecorder is implemented by substep stores that record how long each
+// substep ran.
+type DurationRecorder interface {
+	RecordDuration(SubstepDuration) error
+}
+
+// RecordDuration records the stopped timer of a substep if the store supports
+// it. Since the durations are only used for the upgrade history, failing to
+// record them is logged rather than failing the substep.
+func RecordDuration(store SubstepStore, step idl.Step, substep idl.Substep, timer *stopwatch.Stopwatch, err error) {
+	recorder, ok := store.(DurationRecorder)
+	if !ok {
+		return
+	}
+
+	status := idl.Status_complete
+	if err != nil {
+		status = idl.Status_failed
+	}
+
+	rErr := recorder.RecordDuration(SubstepDuration{
+		Step:     step.String(),
+		Substep:  substep.String(),
+		Status:   status.String(),
+		Start:    timer.StartTime(),
+		Duration: timer.Elapsed(),
+	})
+	if rErr != nil {
+		log.Printf("recording duration of substep %q: %v", substep, rErr)
+	}
+}
+
+// RecordDuration appends to the durations file next to the substeps file.
+func (f *SubstepFileStore) RecordDuration(duration SubstepDuration) error {
+	stateDir := filepath.Dir(f.path)
+
+	durations, err := ReadDurations(stateDir)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(durationsFile{SchemaVersion: DurationsSchema.Version(), Durations: append(durations, duration)}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return utils.AtomicallyWrite(filepath.Join(stateDir, DurationsFileName), data)
+}
+
+// ReadDurations returns the substep runs recorded in the state directory in
+// the order they ran.
+func ReadDurations(stateDir string) ([]SubstepDuration, error) {
+	path := filepath.Join(stateDir, DurationsFileName)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = DurationsSchema.Migrate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var durations durationsFile
+	err = json.Unmarshal(data, &durations)
+	if err != nil {
+		return nil, xerrors.Errorf("read %q: %w", path, err)
+	}
+
+	return durations.Durations, nil
+}
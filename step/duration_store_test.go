@@ -0,0 +1,101 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package step_test
+
+import (
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/utils/schema"
+	"github.com/greenplum-db/gpupgrade/utils/stopwatch"
+)
+
+func TestRecordDuration(t *testing.T) {
+	stateDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, stateDir)
+
+	store := step.NewSubstepStoreUsingFile(filepath.Join(stateDir, step.SubstepsFileName))
+
+	t.Run("returns no durations when none are recorded", func(t *testing.T) {
+		durations, err := step.ReadDurations(stateDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(durations) != 0 {
+			t.Errorf("got durations %v want none", durations)
+		}
+	})
+
+	t.Run("appends each run of a substep", func(t *testing.T) {
+		start := time.Now()
+
+		timer := stopwatch.NewTime(start.Add(-time.Minute)).Stop()
+		step.RecordDuration(store, idl.Step_initialize, idl.Substep_check_upgrade, timer, errors.New("failed"))
+
+		timer = stopwatch.NewTime(start).Stop()
+		step.RecordDuration(store, idl.Step_initialize, idl.Substep_check_upgrade, timer, nil)
+
+		durations, err := step.ReadDurations(stateDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(durations) != 2 {
+			t.Fatalf("got %d durations want 2", len(durations))
+		}
+
+		first := durations[0]
+		if first.Step != "initialize" || first.Substep != "check_upgrade" || first.Status != "failed" {
+			t.Errorf("got first duration %+v", first)
+		}
+
+		if first.Duration < time.Minute {
+			t.Errorf("got duration %s want at least %s", first.Duration, time.Minute)
+		}
+
+		if durations[1].Status != "complete" || !durations[1].Start.Equal(start) {
+			t.Errorf("got second duration %+v", durations[1])
+		}
+	})
+
+	t.Run("reads and appends to durations written as a list before schema versions were introduced", func(t *testing.T) {
+		path := filepath.Join(stateDir, step.DurationsFileName)
+		testutils.MustWriteToFile(t, path, `[{"Step": "initialize", "Substep": "check_upgrade", "Status": "complete"}]`)
+
+		step.RecordDuration(store, idl.Step_execute, idl.Substep_upgrade_primaries, stopwatch.Start().Stop(), nil)
+
+		durations, err := step.ReadDurations(stateDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(durations) != 2 || durations[0].Substep != "check_upgrade" || durations[1].Substep != "upgrade_primaries" {
+			t.Errorf("got durations %+v", durations)
+		}
+
+		if !strings.Contains(testutils.MustReadFile(t, path), `"SchemaVersion": 1`) {
+			t.Errorf("expected %s to be written with its schema version", path)
+		}
+	})
+
+	t.Run("refuses durations written by a newer version of gpupgrade", func(t *testing.T) {
+		testutils.MustWriteToFile(t, filepath.Join(stateDir, step.DurationsFileName), `{"SchemaVersion": 100}`)
+
+		_, err := step.ReadDurations(stateDir)
+		if !errors.Is(err, schema.ErrDowngrade) {
+			t.Errorf("got error %#v want %#v", err, schema.ErrDowngrade)
+		}
+	})
+
+	t.Run("ignores stores that do not record durations", func(t *testing.T) {
+		step.RecordDuration(nil, idl.Step_initialize, idl.Substep_check_upgrade, stopwatch.Start().Stop(), nil)
+	})
+}
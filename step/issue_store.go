@@ -0,0 +1,93 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package step
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/schema"
+)
+
+const IssuesFileName = "issues.json"
+
+// IssuesSchema is the versioned format of issues.json which was originally
+// written as a list of issues.
+var IssuesSchema = schema.Schema{
+	File: IssuesFileName,
+	Migrations: []schema.Migration{
+		schema.AddVersion,
+	},
+	ListKey: "Issues",
+}
+
+type issuesFile struct {
+	SchemaVersion int
+	Issues        []Issue
+}
+
+// Issue is an error a step failed with along with the next actions given to
+// the user.
+type Issue struct {
+	Time       time.Time
+	Step       string
+	Error      string
+	NextAction string
+}
+
+// IssueRecorder is implemented by substep stores that record the issues
+// encountered during the upgrade.
+type IssueRecorder interface {
+	RecordIssue(Issue) error
+}
+
+// RecordIssue appends to the issues file next to the substeps file.
+func (f *SubstepFileStore) RecordIssue(issue Issue) error {
+	stateDir := filepath.Dir(f.path)
+
+	issues, err := ReadIssues(stateDir)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(issuesFile{SchemaVersion: IssuesSchema.Version(), Issues: append(issues, issue)}, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return utils.AtomicallyWrite(filepath.Join(stateDir, IssuesFileName), data)
+}
+
+// ReadIssues returns the issues recorded in the state directory in the order
+// they were encountered.
+func ReadIssues(stateDir string) ([]Issue, error) {
+	path := filepath.Join(stateDir, IssuesFileName)
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	data, err = IssuesSchema.Migrate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var issues issuesFile
+	err = json.Unmarshal(data, &issues)
+	if err != nil {
+		return nil, xerrors.Errorf("read %q: %w", path, err)
+	}
+
+	return issues.Issues, nil
+}
@@ -0,0 +1,90 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package step_test
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/utils/schema"
+)
+
+func TestRecordIssue(t *testing.T) {
+	stateDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, stateDir)
+
+	store := step.NewSubstepStoreUsingFile(filepath.Join(stateDir, step.SubstepsFileName))
+
+	t.Run("returns no issues when none are recorded", func(t *testing.T) {
+		issues, err := step.ReadIssues(stateDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(issues) != 0 {
+			t.Errorf("got issues %v want none", issues)
+		}
+	})
+
+	t.Run("appends each issue in order", func(t *testing.T) {
+		now := time.Now().UTC()
+		expected := []step.Issue{
+			{Time: now, Step: "initialize", Error: "check upgrade failed", NextAction: "Fix the issues."},
+			{Time: now.Add(time.Minute), Step: "execute", Error: "upgrade primaries failed", NextAction: "Run gpupgrade execute again."},
+		}
+
+		for _, issue := range expected {
+			err := store.RecordIssue(issue)
+			if err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+		}
+
+		issues, err := step.ReadIssues(stateDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if !reflect.DeepEqual(issues, expected) {
+			t.Errorf("got issues %+v want %+v", issues, expected)
+		}
+	})
+
+	t.Run("reads issues written as a list before schema versions were introduced", func(t *testing.T) {
+		testutils.MustWriteToFile(t, filepath.Join(stateDir, step.IssuesFileName), `[{"Step": "initialize", "Error": "check upgrade failed"}]`)
+
+		issues, err := step.ReadIssues(stateDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := []step.Issue{{Step: "initialize", Error: "check upgrade failed"}}
+		if !reflect.DeepEqual(issues, expected) {
+			t.Errorf("got issues %+v want %+v", issues, expected)
+		}
+	})
+
+	t.Run("refuses issues written by a newer version of gpupgrade", func(t *testing.T) {
+		testutils.MustWriteToFile(t, filepath.Join(stateDir, step.IssuesFileName), `{"SchemaVersion": 100}`)
+
+		_, err := step.ReadIssues(stateDir)
+		if !errors.Is(err, schema.ErrDowngrade) {
+			t.Errorf("got error %#v want %#v", err, schema.ErrDowngrade)
+		}
+	})
+
+	t.Run("errors when the issues file is invalid", func(t *testing.T) {
+		testutils.MustWriteToFile(t, filepath.Join(stateDir, step.IssuesFileName), "{")
+
+		_, err := step.ReadIssues(stateDir)
+		if err == nil {
+			t.Errorf("expected error got nil")
+		}
+	})
+}
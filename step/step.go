@@ -76,6 +76,27 @@ func HasStarted(step idl.Step) (bool, error) {
 	return false, nil
 }
 
+// IsRunning returns whether any substep of the step is running.
+func IsRunning(step idl.Step) (bool, error) {
+	substepStore, err := NewSubstepFileStore()
+	if err != nil {
+		return false, err
+	}
+
+	substepsMap, err := substepStore.ReadStep(step)
+	if err != nil {
+		return false, err
+	}
+
+	for _, status := range substepsMap {
+		if status.Status == idl.Status_running {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
 func HasRun(step idl.Step, substep idl.Substep) (bool, error) {
 	return hasStatus(step, substep, func(status idl.Status) bool {
 		return status != idl.Status_unknown_status
@@ -200,10 +221,14 @@ func (s *Step) run(substep idl.Substep, f func(OutStreams) error, alwaysRun bool
 	}
 
 	timer := stopwatch.Start()
+	endSpan := TraceSubstep(s.name, substep)
 	defer func() {
 		if pErr := s.printDuration(substep, timer.Stop().String()); pErr != nil {
 			err = errorlist.Append(err, pErr)
 		}
+
+		RecordDuration(s.substepStore, s.name, substep, timer, err)
+		endSpan(err)
 	}()
 
 	err = s.write(substep, idl.Status_running)
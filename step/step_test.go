@@ -435,6 +435,42 @@ func TestHasStarted(t *testing.T) {
 	})
 }
 
+func TestIsRunning(t *testing.T) {
+	dir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, dir)
+
+	resetEnv := testutils.SetEnv(t, "GPUPGRADE_HOME", dir)
+	defer resetEnv()
+
+	path := filepath.Join(dir, step.SubstepsFileName)
+
+	cases := []struct {
+		name     string
+		status   idl.Status
+		expected bool
+	}{
+		{name: "returns true when a substep is running", status: idl.Status_running, expected: true},
+		{name: "returns false when no substep is running", status: idl.Status_failed, expected: false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			jsonContent := fmt.Sprintf("{\"%s\":{\"%s\":\"%s\",\"%s\":\"%s\"}}",
+				idl.Step_execute, idl.Substep_shutdown_source_cluster, idl.Status_complete, idl.Substep_upgrade_master, c.status)
+			testutils.MustWriteToFile(t, path, jsonContent)
+
+			isRunning, err := step.IsRunning(idl.Step_execute)
+			if err != nil {
+				t.Errorf("IsRunning returned error %+v", err)
+			}
+
+			if isRunning != c.expected {
+				t.Errorf("got %t want %t", isRunning, c.expected)
+			}
+		})
+	}
+}
+
 func TestHasRun(t *testing.T) {
 	stateDir, err := os.MkdirTemp("", "")
 	if err != nil {
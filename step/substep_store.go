@@ -12,8 +12,17 @@ import (
 
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/schema"
 )
 
+// SubstepsSchema is the versioned format of substeps.json and steps.json.
+var SubstepsSchema = schema.Schema{
+	File: SubstepsFileName,
+	Migrations: []schema.Migration{
+		schema.AddVersion,
+	},
+}
+
 type SubstepStore interface {
 	Read(idl.Step, idl.Substep) (idl.Status, error)
 	Write(idl.Step, idl.Substep, idl.Status) error
@@ -67,12 +76,30 @@ func (f *SubstepFileStore) load() (prettyMap, error) {
 		return nil, err
 	}
 
-	var substeps prettyMap
-	err = json.Unmarshal(data, &substeps)
+	data, err = SubstepsSchema.Migrate(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var steps map[string]json.RawMessage
+	err = json.Unmarshal(data, &steps)
 	if err != nil {
 		return nil, err
 	}
 
+	delete(steps, schema.VersionKey)
+
+	substeps := make(prettyMap)
+	for name, contents := range steps {
+		var section map[string]PrettyStatus
+		err = json.Unmarshal(contents, &section)
+		if err != nil {
+			return nil, err
+		}
+
+		substeps[name] = section
+	}
+
 	return substeps, nil
 }
 
@@ -118,7 +145,13 @@ func (f *SubstepFileStore) Write(step idl.Step, substep idl.Substep, status idl.
 	}
 	steps[step.String()][substep.String()] = PrettyStatus{status}
 
-	data, err := json.MarshalIndent(steps, "", "  ") // pretty print JSON
+	versioned := make(map[string]any)
+	for name, section := range steps {
+		versioned[name] = section
+	}
+	versioned[schema.VersionKey] = SubstepsSchema.Version()
+
+	data, err := json.MarshalIndent(versioned, "", "  ") // pretty print JSON
 	if err != nil {
 		return err
 	}
@@ -5,14 +5,17 @@ package step_test
 
 import (
 	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 	"testing"
 
 	"github.com/greenplum-db/gpupgrade/idl"
 	"github.com/greenplum-db/gpupgrade/step"
 	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/utils/schema"
 )
 
 func TestFileStore(t *testing.T) {
@@ -199,14 +202,46 @@ func TestFileStore(t *testing.T) {
 		defer f.Close()
 
 		dec := json.NewDecoder(f)
-		raw := make(map[string]map[string]string)
+		raw := make(map[string]json.RawMessage)
 		if err := dec.Decode(&raw); err != nil {
 			t.Fatalf("decoding statuses: %+v", err)
 		}
 
+		section := make(map[string]string)
+		if err := json.Unmarshal(raw[initialize.String()], &section); err != nil {
+			t.Fatalf("decoding statuses: %+v", err)
+		}
+
 		key := substep.String()
-		if raw[initialize.String()][key] != status.String() {
-			t.Errorf("status[%q][%q] = %q, want %q", initialize, key, raw[initialize.String()][key], status.String())
+		if section[key] != status.String() {
+			t.Errorf("status[%q][%q] = %q, want %q", initialize, key, section[key], status.String())
+		}
+
+		expected := strconv.Itoa(step.SubstepsSchema.Version())
+		if string(raw[schema.VersionKey]) != expected {
+			t.Errorf("got schema version %s want %s", raw[schema.VersionKey], expected)
+		}
+	})
+
+	t.Run("reads substeps written before schema versions were introduced", func(t *testing.T) {
+		testutils.MustWriteToFile(t, path, `{"initialize": {"init_target_cluster": "complete"}}`)
+
+		status, err := fs.Read(initialize, idl.Substep_init_target_cluster)
+		if err != nil {
+			t.Fatalf("Read(): %+v", err)
+		}
+
+		if status != idl.Status_complete {
+			t.Errorf("read %v, want %v", status, idl.Status_complete)
+		}
+	})
+
+	t.Run("refuses substeps written by a newer version of gpupgrade", func(t *testing.T) {
+		testutils.MustWriteToFile(t, path, `{"SchemaVersion": 1000, "initialize": {"init_target_cluster": "complete"}}`)
+
+		_, err := fs.Read(initialize, idl.Substep_init_target_cluster)
+		if !errors.Is(err, schema.ErrDowngrade) {
+			t.Errorf("got error %#v want %#v", err, schema.ErrDowngrade)
 		}
 	})
 }
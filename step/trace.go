@@ -0,0 +1,26 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package step
+
+import (
+	"context"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/tracing"
+)
+
+// TraceSubstep starts a span for the substep which is the parent of the spans
+// started while it runs including those of RPCs to the hub and agents. The
+// returned function ends the span with the result of the substep.
+func TraceSubstep(step idl.Step, substep idl.Substep) func(err error) {
+	_, span := tracing.Start(context.Background(), substep.String(), tracing.KindInternal)
+	span.SetBaggage(tracing.StepKey, step.String())
+	span.SetBaggage(tracing.SubstepKey, substep.String())
+	deactivate := tracing.Activate(span)
+
+	return func(err error) {
+		deactivate()
+		span.End(err)
+	}
+}
@@ -34,15 +34,18 @@ var SubstepDescriptions = map[idl.Substep]substepText{
 	idl.Substep_check_environment:                                             substepText{"Checking environment...", "Check environment"},
 	idl.Substep_create_backupdirs:                                             substepText{"Creating internal backup directories on the segments...", "Create internal backup directories on the segments"},
 	idl.Substep_check_disk_space:                                              substepText{"Checking disk space...", "Check disk space"},
+	idl.Substep_record_disk_usage:                                             substepText{"Recording disk usage...", "Record disk usage"},
 	idl.Substep_generate_target_config:                                        substepText{"Generating target cluster configuration...", "Generate target cluster configuration"},
 	idl.Substep_init_target_cluster:                                           substepText{"Creating target cluster...", "Create target cluster"},
 	idl.Substep_setting_dynamic_library_path_on_target_cluster:                substepText{"Setting dynamic library path on target cluster...", "Set dynamic library path on target cluster"},
 	idl.Substep_shutdown_target_cluster:                                       substepText{"Stopping target cluster...", "Stop target cluster"},
 	idl.Substep_backup_target_master:                                          substepText{"Backing up target master...", "Back up target master"},
+	idl.Substep_check_clone_support:                                           substepText{"Checking filesystems support cloning...", "Check filesystems support cloning"},
 	idl.Substep_check_upgrade:                                                 substepText{"Running pg_upgrade checks...", "Run pg_upgrade checks"},
 	idl.Substep_shutdown_source_cluster:                                       substepText{"Stopping source cluster...", "Stop source cluster"},
 	idl.Substep_upgrade_master:                                                substepText{"Upgrading master...", "Upgrade master"},
 	idl.Substep_copy_master:                                                   substepText{"Copying master catalog to primary segments...", "Copy master catalog to primary segments"},
+	idl.Substep_verify_master_backup:                                          substepText{"Verifying master catalog copies on primary segment hosts...", "Verify master catalog copies on primary segment hosts"},
 	idl.Substep_upgrade_primaries:                                             substepText{"Upgrading primary segments...", "Upgrade primary segments"},
 	idl.Substep_start_target_cluster:                                          substepText{"Starting target cluster...", "Start target cluster"},
 	idl.Substep_update_target_catalog:                                         substepText{"Updating target master catalog...", "Update target master catalog"},
@@ -58,6 +61,8 @@ var SubstepDescriptions = map[idl.Substep]substepText{
 	idl.Substep_delete_master_statedir:                                        substepText{"Deleting master state directory...", "Delete master state directory"},
 	idl.Substep_archive_log_directories:                                       substepText{"Archiving log directories...", "Archive log directories"},
 	idl.Substep_restore_source_cluster:                                        substepText{"Restoring source cluster...", "Restore source cluster"},
+	idl.Substep_snapshot_source_cluster:                                       substepText{"Taking snapshot of source cluster...", "Take snapshot of source cluster"},
+	idl.Substep_delete_source_cluster_snapshot:                                substepText{"Deleting snapshot of source cluster...", "Delete snapshot of source cluster"},
 	idl.Substep_start_source_cluster:                                          substepText{"Starting source cluster...", "Start source cluster"},
 	idl.Substep_restore_pgcontrol:                                             substepText{"Re-enabling source cluster...", "Re-enable source cluster"},
 	idl.Substep_recoverseg_source_cluster:                                     substepText{"Recovering source cluster mirrors...", "Recover source cluster mirrors"},
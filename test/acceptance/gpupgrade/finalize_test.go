@@ -138,6 +138,7 @@ func verifyFinalize(t *testing.T, source greenplum.Cluster, conf *config.Config,
 		conf.Target.Version,
 		fmt.Sprintf("%s.<contentID>%s", conf.UpgradeID, upgrade.OldSuffix),
 		conf.Intermediate.CoordinatorDataDir()+upgrade.OldSuffix,
+		conf.UpgradeID, conf.Target.GPHome, conf.Target.CoordinatorPort(),
 		logArchiveDir+`\d{5}`,
 		filepath.Join(conf.Target.GPHome, "greenplum_path.sh"),
 		filepath.Join(filepath.Dir(conf.Target.GPHome), "greenplum-db"), conf.Target.GPHome,
@@ -72,6 +72,12 @@ func (m *MockAgentServer) CheckDiskSpace(context.Context, *idl.CheckSegmentDiskS
 	return &idl.CheckDiskSpaceReply{}, nil
 }
 
+func (m *MockAgentServer) GetDiskUsage(context.Context, *idl.GetDiskUsageRequest) (*idl.GetDiskUsageReply, error) {
+	m.increaseCalls()
+
+	return &idl.GetDiskUsageReply{}, nil
+}
+
 func (m *MockAgentServer) UpgradePrimaries(ctx context.Context, in *idl.UpgradePrimariesRequest) (*idl.UpgradePrimariesReply, error) {
 	m.increaseCalls()
 
@@ -107,6 +113,11 @@ func (m *MockAgentServer) DeleteBackupDirectory(context.Context, *idl.DeleteBack
 	return &idl.DeleteBackupDirectoryReply{}, nil
 }
 
+func (m *MockAgentServer) MoveBackupDirectory(context.Context, *idl.MoveBackupDirectoryRequest) (*idl.MoveBackupDirectoryReply, error) {
+	m.increaseCalls()
+	return &idl.MoveBackupDirectoryReply{}, nil
+}
+
 func (m *MockAgentServer) DeleteTablespaceDirectories(context.Context, *idl.DeleteTablespaceRequest) (*idl.DeleteTablespaceReply, error) {
 	m.increaseCalls()
 	return &idl.DeleteTablespaceReply{}, nil
@@ -122,6 +133,16 @@ func (m *MockAgentServer) RsyncTablespaceDirectories(context.Context, *idl.Rsync
 	return &idl.RsyncReply{}, nil
 }
 
+func (m *MockAgentServer) VerifyRevertActions(_ context.Context, in *idl.VerifyRevertActionsRequest) (*idl.VerifyRevertActionsReply, error) {
+	m.increaseCalls()
+	return &idl.VerifyRevertActionsReply{Actions: in.GetActions()}, nil
+}
+
+func (m *MockAgentServer) FindLinkedDataDirectories(context.Context, *idl.FindLinkedDataDirectoriesRequest) (*idl.FindLinkedDataDirectoriesReply, error) {
+	m.increaseCalls()
+	return &idl.FindLinkedDataDirectoriesReply{}, nil
+}
+
 func (m *MockAgentServer) RsyncDataDirectories(context.Context, *idl.RsyncRequest) (*idl.RsyncReply, error) {
 	m.increaseCalls()
 	return &idl.RsyncReply{}, nil
@@ -169,3 +190,28 @@ func (m *MockAgentServer) CreateRecoveryConf(context context.Context, in *idl.Cr
 func (m *MockAgentServer) AddReplicationEntries(context context.Context, in *idl.AddReplicationEntriesRequest) (*idl.AddReplicationEntriesReply, error) {
 	return &idl.AddReplicationEntriesReply{}, nil
 }
+
+func (m *MockAgentServer) SnapshotDirectories(context.Context, *idl.SnapshotDirectoriesRequest) (*idl.SnapshotDirectoriesReply, error) {
+	m.increaseCalls()
+	return &idl.SnapshotDirectoriesReply{}, nil
+}
+
+func (m *MockAgentServer) VerifyCoordinatorBackup(stream idl.Agent_VerifyCoordinatorBackupServer) error {
+	m.increaseCalls()
+	return stream.SendAndClose(&idl.VerifyCoordinatorBackupReply{})
+}
+
+func (m *MockAgentServer) RelayCoordinatorBackup(context.Context, *idl.RelayCoordinatorBackupRequest) (*idl.RelayCoordinatorBackupReply, error) {
+	m.increaseCalls()
+	return &idl.RelayCoordinatorBackupReply{}, nil
+}
+
+func (m *MockAgentServer) CheckCloneSupport(context.Context, *idl.CheckCloneSupportRequest) (*idl.CheckCloneSupportReply, error) {
+	m.increaseCalls()
+	return &idl.CheckCloneSupportReply{}, nil
+}
+
+func (m *MockAgentServer) GetHostInfo(context.Context, *idl.GetHostInfoRequest) (*idl.GetHostInfoReply, error) {
+	m.increaseCalls()
+	return &idl.GetHostInfoReply{}, nil
+}
@@ -0,0 +1,147 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+const scopeName = "github.com/greenplum-db/gpupgrade"
+
+const statusError = 2
+
+type spanStatus struct {
+	Code    int    `json:"code,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
+type spanData struct {
+	TraceID           string      `json:"traceId"`
+	SpanID            string      `json:"spanId"`
+	ParentSpanID      string      `json:"parentSpanId,omitempty"`
+	Name              string      `json:"name"`
+	Kind              Kind        `json:"kind"`
+	StartTimeUnixNano string      `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string      `json:"endTimeUnixNano"`
+	Attributes        []Attribute `json:"attributes,omitempty"`
+	Status            spanStatus  `json:"status"`
+}
+
+// The OTLP/JSON ExportTraceServiceRequest written for each span.
+type exportRequest struct {
+	ResourceSpans []resourceSpans `json:"resourceSpans"`
+}
+
+type resourceSpans struct {
+	Resource   resource     `json:"resource"`
+	ScopeSpans []scopeSpans `json:"scopeSpans"`
+}
+
+type resource struct {
+	Attributes []Attribute `json:"attributes"`
+}
+
+type scopeSpans struct {
+	Scope scope      `json:"scope"`
+	Spans []spanData `json:"spans"`
+}
+
+type scope struct {
+	Name string `json:"name"`
+}
+
+var (
+	exportMutex sync.Mutex
+	output      io.Writer
+	exportedBy  resource
+)
+
+// Initialize exports the spans of the process to its trace file in the log
+// directory. Tracing never fails the upgrade so errors are only logged.
+func Initialize(process string) {
+	logDir, err := utils.GetLogDir()
+	if err != nil {
+		log.Printf("disabling tracing: %v", err)
+		return
+	}
+
+	err = os.MkdirAll(logDir, 0755)
+	if err != nil {
+		log.Printf("disabling tracing: %v", err)
+		return
+	}
+
+	f, err := os.OpenFile(TracePath(logDir, process), os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0644)
+	if err != nil {
+		log.Printf("disabling tracing: %v", err)
+		return
+	}
+
+	SetOutput(f, process)
+}
+
+// TracePath is next to the log of the process such that it is archived along
+// with the logs.
+func TracePath(logDir string, process string) string {
+	return filepath.Join(logDir, fmt.Sprintf("%s_traces_%s.jsonl", process, time.Now().Format("20060102")))
+}
+
+// SetOutput writes each finished span of the process as a line of OTLP/JSON to
+// the writer. A nil writer disables exporting. A previous output which is a
+// file is closed.
+func SetOutput(w io.Writer, process string) {
+	host, _ := os.Hostname()
+
+	exportMutex.Lock()
+	defer exportMutex.Unlock()
+
+	if f, ok := output.(*os.File); ok && output != w {
+		f.Close() //nolint
+	}
+
+	output = w
+	exportedBy = resource{Attributes: []Attribute{
+		String("service.name", "gpupgrade_"+process),
+		String("host.name", host),
+		Int("process.pid", os.Getpid()),
+	}}
+
+	if clusterName := utils.GetClusterName(); clusterName != "" {
+		exportedBy.Attributes = append(exportedBy.Attributes, String("gpupgrade.cluster_name", clusterName))
+	}
+}
+
+func export(span spanData) {
+	exportMutex.Lock()
+	defer exportMutex.Unlock()
+
+	if output == nil {
+		return
+	}
+
+	request := exportRequest{ResourceSpans: []resourceSpans{{
+		Resource:   exportedBy,
+		ScopeSpans: []scopeSpans{{Scope: scope{Name: scopeName}, Spans: []spanData{span}}},
+	}}}
+
+	line, err := json.Marshal(request)
+	if err != nil {
+		log.Printf("marshal span %q: %v", span.Name, err)
+		return
+	}
+
+	_, err = output.Write(append(line, '\n'))
+	if err != nil {
+		log.Printf("exporting span %q: %v", span.Name, err)
+	}
+}
@@ -0,0 +1,234 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"sort"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+const (
+	traceparentHeader = "traceparent"
+	baggageHeader     = "baggage"
+)
+
+// UnaryServerInterceptor records a span for each RPC as a child of the span
+// propagated by the client.
+func UnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, span := startServerSpan(ctx, info.FullMethod, req)
+	resp, err := handler(ctx, req)
+	span.End(err)
+	return resp, err
+}
+
+// StreamServerInterceptor records a span for each streaming RPC as a child of
+// the span propagated by the client. Streaming RPCs run steps one at a time
+// so the span is also activated for the duration of the RPC.
+func StreamServerInterceptor(srv interface{}, stream grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, span := startServerSpan(stream.Context(), info.FullMethod, nil)
+	deactivate := Activate(span)
+
+	err := handler(srv, &serverStream{ServerStream: stream, ctx: ctx})
+
+	deactivate()
+	span.End(err)
+	return err
+}
+
+func startServerSpan(ctx context.Context, method string, req interface{}) (context.Context, *Span) {
+	attributes := []Attribute{String(RPCMethodKey, method)}
+	if ids := contentIDs(req); len(ids) > 0 {
+		attributes = append(attributes, Ints(ContentIDKey, ids))
+	}
+
+	// Only use the propagated span as the parent since a request without one
+	// is unrelated to any step running in this process.
+	return start(ctx, extract(ctx), spanName(method), KindServer, attributes...)
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
+
+// UnaryClientInterceptor records a span for each RPC to the host and
+// propagates it to the server.
+func UnaryClientInterceptor(host string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		attributes := []Attribute{String(RPCMethodKey, method), String(ServerAddressKey, host)}
+		if ids := contentIDs(req); len(ids) > 0 {
+			attributes = append(attributes, Ints(ContentIDKey, ids))
+		}
+
+		ctx, span := Start(ctx, spanName(method), KindClient, attributes...)
+		err := invoker(inject(ctx, span), method, req, reply, cc, opts...)
+		span.End(err)
+		return err
+	}
+}
+
+// StreamClientInterceptor records a span for each streaming RPC to the host
+// which ends once the stream is finished and propagates it to the server.
+// Client streaming RPCs such as VerifyCoordinatorBackup are finished once
+// their single reply is received.
+func StreamClientInterceptor(host string) grpc.StreamClientInterceptor {
+	return func(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string, streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+		ctx, span := Start(ctx, spanName(method), KindClient, String(RPCMethodKey, method), String(ServerAddressKey, host))
+		stream, err := streamer(inject(ctx, span), desc, cc, method, opts...)
+		if err != nil {
+			span.End(err)
+			return nil, err
+		}
+
+		return &clientStream{ClientStream: stream, span: span, serverStreams: desc.ServerStreams}, nil
+	}
+}
+
+type clientStream struct {
+	grpc.ClientStream
+	span          *Span
+	serverStreams bool
+}
+
+func (c *clientStream) RecvMsg(m interface{}) error {
+	err := c.ClientStream.RecvMsg(m)
+	if err == io.EOF || (err == nil && !c.serverStreams) {
+		c.span.End(nil)
+	} else if err != nil {
+		c.span.End(err)
+	}
+
+	return err
+}
+
+// inject propagates the span and its baggage in the outgoing metadata.
+func inject(ctx context.Context, span *Span) context.Context {
+	sc := span.context()
+	pairs := []string{traceparentHeader, fmt.Sprintf("00-%s-%s-01", sc.traceID, sc.spanID)}
+
+	var keys []string
+	for key := range sc.baggage {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var members []string
+	for _, key := range keys {
+		members = append(members, key+"="+url.PathEscape(sc.baggage[key]))
+	}
+
+	if len(members) > 0 {
+		pairs = append(pairs, baggageHeader, strings.Join(members, ","))
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, pairs...)
+}
+
+// extract returns the span propagated in the incoming metadata or nil.
+func extract(ctx context.Context) *spanContext {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return nil
+	}
+
+	values := md.Get(traceparentHeader)
+	if len(values) == 0 {
+		return nil
+	}
+
+	parts := strings.Split(values[0], "-")
+	if len(parts) != 4 || parts[0] != "00" || !validID(parts[1], 16) || !validID(parts[2], 8) {
+		return nil
+	}
+
+	sc := &spanContext{traceID: parts[1], spanID: parts[2], baggage: make(map[string]string)}
+	for _, value := range md.Get(baggageHeader) {
+		for _, member := range strings.Split(value, ",") {
+			key, value, ok := strings.Cut(strings.TrimSpace(member), "=")
+			if !ok {
+				continue
+			}
+
+			unescaped, err := url.PathUnescape(value)
+			if err != nil {
+				continue
+			}
+
+			sc.baggage[strings.TrimSpace(key)] = unescaped
+		}
+	}
+
+	return sc
+}
+
+func validID(id string, size int) bool {
+	decoded, err := hex.DecodeString(id)
+	if err != nil || len(decoded) != size {
+		return false
+	}
+
+	for _, b := range decoded {
+		if b != 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+// spanName is the RPC method without its leading slash such as
+// idl.Agent/UpgradePrimaries.
+func spanName(method string) string {
+	return strings.TrimPrefix(method, "/")
+}
+
+// contentIDs returns the sorted content IDs of the segments in the request
+// such as those of each pg_upgrade of the primaries.
+func contentIDs(req interface{}) []int {
+	msg, ok := req.(proto.Message)
+	if !ok {
+		return nil
+	}
+
+	var ids []int
+	collectContentIDs(msg.ProtoReflect(), &ids)
+	sort.Ints(ids)
+	return ids
+}
+
+func collectContentIDs(msg protoreflect.Message, ids *[]int) {
+	fields := msg.Descriptor().Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+
+		switch {
+		case field.IsMap():
+			continue
+		case field.Kind() == protoreflect.Int32Kind && !field.IsList() && field.Name() == "contentID":
+			// Read the field even when unset since content 0 is the default.
+			*ids = append(*ids, int(msg.Get(field).Int()))
+		case field.Message() != nil && field.IsList():
+			list := msg.Get(field).List()
+			for j := 0; j < list.Len(); j++ {
+				collectContentIDs(list.Get(j).Message(), ids)
+			}
+		case field.Message() != nil && msg.Has(field):
+			collectContentIDs(msg.Get(field).Message(), ids)
+		}
+	}
+}
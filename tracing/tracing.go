@@ -0,0 +1,281 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+// Package tracing records spans of the steps, substeps, and RPCs of an upgrade
+// across the CLI, hub, and agents such that a whole step can be viewed as one
+// trace rather than correlating the timestamps of each log by hand. The trace
+// context is propagated between processes using the W3C traceparent and
+// baggage gRPC metadata, and each process exports its finished spans to an
+// OTLP/JSON file in its log directory.
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"strconv"
+	"sync"
+	"time"
+)
+
+const (
+	StepKey          = "gpupgrade.step"
+	SubstepKey       = "gpupgrade.substep"
+	ContentIDKey     = "gpupgrade.content_id"
+	RPCMethodKey     = "rpc.method"
+	ServerAddressKey = "server.address"
+)
+
+// Kind is the OTLP span kind.
+type Kind int
+
+const (
+	KindInternal Kind = 1
+	KindServer   Kind = 2
+	KindClient   Kind = 3
+)
+
+// Attribute is an OTLP key value pair describing a span.
+type Attribute struct {
+	Key   string `json:"key"`
+	Value Value  `json:"value"`
+}
+
+// Value is an OTLP attribute value. Integers are strings in OTLP/JSON.
+type Value struct {
+	StringValue *string     `json:"stringValue,omitempty"`
+	IntValue    *string     `json:"intValue,omitempty"`
+	ArrayValue  *ArrayValue `json:"arrayValue,omitempty"`
+}
+
+type ArrayValue struct {
+	Values []Value `json:"values"`
+}
+
+func String(key string, value string) Attribute {
+	return Attribute{Key: key, Value: Value{StringValue: &value}}
+}
+
+func Int(key string, value int) Attribute {
+	return Attribute{Key: key, Value: intValue(value)}
+}
+
+func Ints(key string, values []int) Attribute {
+	array := &ArrayValue{Values: []Value{}}
+	for _, value := range values {
+		array.Values = append(array.Values, intValue(value))
+	}
+
+	return Attribute{Key: key, Value: Value{ArrayValue: array}}
+}
+
+func intValue(value int) Value {
+	s := strconv.Itoa(value)
+	return Value{IntValue: &s}
+}
+
+// spanContext identifies a span along with the baggage inherited by its
+// children, which may be in another process.
+type spanContext struct {
+	traceID string
+	spanID  string
+	baggage map[string]string
+}
+
+// Span is a timed operation within a trace.
+type Span struct {
+	spanContext
+	parentID string
+	name     string
+	kind     Kind
+	start    time.Time
+
+	mutex      sync.Mutex
+	attributes []Attribute
+	ended      bool
+}
+
+type spanKey struct{}
+
+type remoteKey struct{}
+
+var (
+	activeMutex sync.Mutex
+	activeSpans []*Span
+)
+
+// Start starts a span which is a child of the span in the context. When the
+// context has no span the active span is used since most calls are made with
+// a background context. Otherwise, the span starts a new trace.
+func Start(ctx context.Context, name string, kind Kind, attributes ...Attribute) (context.Context, *Span) {
+	return start(ctx, parent(ctx), name, kind, attributes...)
+}
+
+func start(ctx context.Context, parent *spanContext, name string, kind Kind, attributes ...Attribute) (context.Context, *Span) {
+	span := &Span{
+		spanContext: spanContext{spanID: newID(8), baggage: make(map[string]string)},
+		name:        name,
+		kind:        kind,
+		start:       time.Now(),
+	}
+
+	if parent == nil {
+		span.traceID = newID(16)
+	} else {
+		span.traceID = parent.traceID
+		span.parentID = parent.spanID
+		for key, value := range parent.baggage {
+			span.SetBaggage(key, value)
+		}
+	}
+
+	span.SetAttributes(attributes...)
+	return context.WithValue(ctx, spanKey{}, span), span
+}
+
+func parent(ctx context.Context) *spanContext {
+	if span, ok := ctx.Value(spanKey{}).(*Span); ok {
+		return span.context()
+	}
+
+	if remote, ok := ctx.Value(remoteKey{}).(*spanContext); ok {
+		return remote
+	}
+
+	if span := Active(); span != nil {
+		return span.context()
+	}
+
+	return nil
+}
+
+// FromContext returns the span of the context or nil.
+func FromContext(ctx context.Context) *Span {
+	span, _ := ctx.Value(spanKey{}).(*Span)
+	return span
+}
+
+// Active returns the span of the running step or substep or nil.
+func Active() *Span {
+	activeMutex.Lock()
+	defer activeMutex.Unlock()
+
+	if len(activeSpans) == 0 {
+		return nil
+	}
+
+	return activeSpans[len(activeSpans)-1]
+}
+
+// Activate makes the span the parent of spans started without a span in their
+// context until the returned function is called, which restores the previous
+// active span. Since steps and their substeps run one at a time this lets
+// their spans be the parents of RPCs made deep within them. Overlapping
+// streams may deactivate their spans in any order, so deactivating removes
+// only its own span leaving any span activated since then active.
+func Activate(span *Span) func() {
+	activeMutex.Lock()
+	defer activeMutex.Unlock()
+
+	activeSpans = append(activeSpans, span)
+
+	return func() {
+		activeMutex.Lock()
+		defer activeMutex.Unlock()
+
+		for i := len(activeSpans) - 1; i >= 0; i-- {
+			if activeSpans[i] == span {
+				activeSpans = append(activeSpans[:i], activeSpans[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// SetAttributes adds the attributes to the span replacing any with the same
+// key.
+func (s *Span) SetAttributes(attributes ...Attribute) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	for _, attribute := range attributes {
+		replaced := false
+		for i := range s.attributes {
+			if s.attributes[i].Key == attribute.Key {
+				s.attributes[i] = attribute
+				replaced = true
+			}
+		}
+
+		if !replaced {
+			s.attributes = append(s.attributes, attribute)
+		}
+	}
+}
+
+// SetBaggage sets an attribute which is also set on all descendants of the
+// span including those in other processes.
+func (s *Span) SetBaggage(key string, value string) {
+	s.SetAttributes(String(key, value))
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.baggage[key] = value
+}
+
+// End finishes the span and exports it. Only the first call has an effect.
+func (s *Span) End(err error) {
+	end := time.Now()
+
+	s.mutex.Lock()
+	if s.ended {
+		s.mutex.Unlock()
+		return
+	}
+
+	s.ended = true
+	data := spanData{
+		TraceID:           s.traceID,
+		SpanID:            s.spanID,
+		ParentSpanID:      s.parentID,
+		Name:              s.name,
+		Kind:              s.kind,
+		StartTimeUnixNano: strconv.FormatInt(s.start.UnixNano(), 10),
+		EndTimeUnixNano:   strconv.FormatInt(end.UnixNano(), 10),
+		Attributes:        append([]Attribute(nil), s.attributes...),
+	}
+	s.mutex.Unlock()
+
+	if err != nil {
+		data.Status = spanStatus{Code: statusError, Message: err.Error()}
+	}
+
+	export(data)
+}
+
+func (s *Span) TraceID() string {
+	return s.traceID
+}
+
+func (s *Span) SpanID() string {
+	return s.spanID
+}
+
+func (s *Span) context() *spanContext {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	baggage := make(map[string]string, len(s.baggage))
+	for key, value := range s.baggage {
+		baggage[key] = value
+	}
+
+	return &spanContext{traceID: s.traceID, spanID: s.spanID, baggage: baggage}
+}
+
+func newID(size int) string {
+	id := make([]byte, size)
+	rand.Read(id) //nolint
+	return hex.EncodeToString(id)
+}
@@ -0,0 +1,439 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package tracing_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/tracing"
+)
+
+type exportedSpan struct {
+	TraceID      string `json:"traceId"`
+	SpanID       string `json:"spanId"`
+	ParentSpanID string `json:"parentSpanId"`
+	Name         string `json:"name"`
+	Kind         int    `json:"kind"`
+	Attributes   []tracing.Attribute
+	Status       struct {
+		Code    int
+		Message string
+	}
+	Resource []tracing.Attribute
+}
+
+func (s exportedSpan) attribute(key string) interface{} {
+	for _, attribute := range s.Attributes {
+		if attribute.Key != key {
+			continue
+		}
+
+		switch {
+		case attribute.Value.StringValue != nil:
+			return *attribute.Value.StringValue
+		case attribute.Value.IntValue != nil:
+			return *attribute.Value.IntValue
+		case attribute.Value.ArrayValue != nil:
+			var values []string
+			for _, value := range attribute.Value.ArrayValue.Values {
+				values = append(values, *value.IntValue)
+			}
+			return values
+		}
+	}
+
+	return nil
+}
+
+// parseSpans parses the OTLP/JSON lines written by the exporter.
+func parseSpans(t *testing.T, output *bytes.Buffer) []exportedSpan {
+	t.Helper()
+
+	var spans []exportedSpan
+	for _, line := range strings.Split(strings.TrimSpace(output.String()), "\n") {
+		var request struct {
+			ResourceSpans []struct {
+				Resource struct {
+					Attributes []tracing.Attribute
+				}
+				ScopeSpans []struct {
+					Spans []exportedSpan
+				}
+			}
+		}
+
+		err := json.Unmarshal([]byte(line), &request)
+		if err != nil {
+			t.Fatalf("unmarshal %q: %v", line, err)
+		}
+
+		for _, resourceSpans := range request.ResourceSpans {
+			for _, scopeSpans := range resourceSpans.ScopeSpans {
+				for _, span := range scopeSpans.Spans {
+					span.Resource = resourceSpans.Resource.Attributes
+					spans = append(spans, span)
+				}
+			}
+		}
+	}
+
+	return spans
+}
+
+func findSpan(t *testing.T, spans []exportedSpan, name string, kind tracing.Kind) exportedSpan {
+	t.Helper()
+
+	for _, span := range spans {
+		if span.Name == name && span.Kind == int(kind) {
+			return span
+		}
+	}
+
+	t.Fatalf("span %q of kind %d not found in %+v", name, kind, spans)
+	return exportedSpan{}
+}
+
+func TestSpans(t *testing.T) {
+	output := &bytes.Buffer{}
+	tracing.SetOutput(output, "hub")
+	defer tracing.SetOutput(nil, "")
+
+	ctx, stepSpan := tracing.Start(context.Background(), "execute", tracing.KindInternal)
+	stepSpan.SetBaggage(tracing.StepKey, "execute")
+
+	deactivate := tracing.Activate(stepSpan)
+	_, substepSpan := tracing.Start(context.Background(), "upgrade_master", tracing.KindInternal, tracing.Int(tracing.ContentIDKey, -1))
+	substepSpan.End(errors.New("pg_upgrade failed"))
+	deactivate()
+
+	if tracing.Active() != nil {
+		t.Errorf("expected no active span after deactivating")
+	}
+
+	_, childSpan := tracing.Start(ctx, "child", tracing.KindInternal)
+	childSpan.End(nil)
+	childSpan.End(errors.New("ignored"))
+
+	_, unrelated := tracing.Start(context.Background(), "unrelated", tracing.KindInternal)
+	unrelated.End(nil)
+
+	stepSpan.End(nil)
+
+	spans := parseSpans(t, output)
+	if len(spans) != 4 {
+		t.Fatalf("got spans %+v want 4", spans)
+	}
+
+	step := findSpan(t, spans, "execute", tracing.KindInternal)
+	if step.TraceID != stepSpan.TraceID() || step.SpanID != stepSpan.SpanID() || step.ParentSpanID != "" || step.Kind != int(tracing.KindInternal) {
+		t.Errorf("got step span %+v", step)
+	}
+
+	if step.Status.Code != 0 {
+		t.Errorf("got status %+v want unset", step.Status)
+	}
+
+	substep := findSpan(t, spans, "upgrade_master", tracing.KindInternal)
+	if substep.TraceID != step.TraceID || substep.ParentSpanID != step.SpanID {
+		t.Errorf("expected the active span to be the parent got %+v", substep)
+	}
+
+	if substep.attribute(tracing.StepKey) != "execute" || substep.attribute(tracing.ContentIDKey) != "-1" {
+		t.Errorf("got attributes %+v", substep.Attributes)
+	}
+
+	if substep.Status.Code != 2 || substep.Status.Message != "pg_upgrade failed" {
+		t.Errorf("got status %+v", substep.Status)
+	}
+
+	child := findSpan(t, spans, "child", tracing.KindInternal)
+	if child.ParentSpanID != step.SpanID || child.Status.Code != 0 {
+		t.Errorf("expected the span of the context to be the parent got %+v", child)
+	}
+
+	other := findSpan(t, spans, "unrelated", tracing.KindInternal)
+	if other.TraceID == step.TraceID || other.ParentSpanID != "" {
+		t.Errorf("expected a new trace got %+v", other)
+	}
+
+	resource := map[string]bool{}
+	for _, attribute := range step.Resource {
+		resource[attribute.Key] = true
+	}
+
+	if !resource["service.name"] || !resource["host.name"] || !resource["process.pid"] {
+		t.Errorf("got resource %+v", step.Resource)
+	}
+
+	if *step.Resource[0].Value.StringValue != "gpupgrade_hub" {
+		t.Errorf("got service name %q want gpupgrade_hub", *step.Resource[0].Value.StringValue)
+	}
+}
+
+type agentServer struct {
+	idl.UnimplementedAgentServer
+}
+
+func (a *agentServer) UpgradePrimaries(ctx context.Context, req *idl.UpgradePrimariesRequest) (*idl.UpgradePrimariesReply, error) {
+	_, span := tracing.Start(ctx, "pg_upgrade", tracing.KindInternal)
+	span.End(nil)
+
+	return &idl.UpgradePrimariesReply{}, nil
+}
+
+func (a *agentServer) VerifyCoordinatorBackup(stream idl.Agent_VerifyCoordinatorBackupServer) error {
+	for {
+		_, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&idl.VerifyCoordinatorBackupReply{})
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+}
+
+type hubServer struct {
+	idl.UnimplementedCliToHubServer
+	active *tracing.Span
+}
+
+func (h *hubServer) Execute(req *idl.ExecuteRequest, stream idl.CliToHub_ExecuteServer) error {
+	h.active = tracing.Active()
+	return stream.Send(&idl.Message{Contents: &idl.Message_Chunk{Chunk: &idl.Chunk{Buffer: []byte("output")}}})
+}
+
+func dial(t *testing.T, register func(server *grpc.Server), opts ...grpc.DialOption) *grpc.ClientConn {
+	t.Helper()
+
+	listener := bufconn.Listen(1024 * 1024)
+	server := grpc.NewServer(grpc.UnaryInterceptor(tracing.UnaryServerInterceptor), grpc.StreamInterceptor(tracing.StreamServerInterceptor))
+	register(server)
+
+	go server.Serve(listener) //nolint
+	t.Cleanup(server.Stop)
+
+	dialer := func(ctx context.Context, _ string) (net.Conn, error) {
+		return listener.DialContext(ctx)
+	}
+
+	opts = append(opts, grpc.WithContextDialer(dialer), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	conn, err := grpc.Dial("bufnet", opts...)
+	if err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+	t.Cleanup(func() { conn.Close() })
+
+	return conn
+}
+
+func TestActivate(t *testing.T) {
+	_, first := tracing.Start(context.Background(), "execute", tracing.KindInternal)
+	_, second := tracing.Start(context.Background(), "revert", tracing.KindInternal)
+
+	deactivateFirst := tracing.Activate(first)
+	deactivateSecond := tracing.Activate(second)
+
+	// the first stream finishing before the second does not restore a span
+	// that is no longer running
+	deactivateFirst()
+	if tracing.Active() != second {
+		t.Errorf("got active span %+v want %+v", tracing.Active(), second)
+	}
+
+	deactivateSecond()
+	if tracing.Active() != nil {
+		t.Errorf("expected no active span after deactivating got %+v", tracing.Active())
+	}
+
+	deactivateFirst()
+	if tracing.Active() != nil {
+		t.Errorf("expected deactivating twice to have no effect got %+v", tracing.Active())
+	}
+}
+
+func TestInterceptors(t *testing.T) {
+	t.Run("propagates the active span and its baggage to the agent", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		tracing.SetOutput(output, "hub")
+		defer tracing.SetOutput(nil, "")
+
+		conn := dial(t, func(server *grpc.Server) {
+			idl.RegisterAgentServer(server, &agentServer{})
+		}, grpc.WithUnaryInterceptor(tracing.UnaryClientInterceptor("sdw1")))
+
+		_, substepSpan := tracing.Start(context.Background(), "upgrade_primaries", tracing.KindInternal)
+		substepSpan.SetBaggage(tracing.SubstepKey, "upgrade_primaries")
+		deactivate := tracing.Activate(substepSpan)
+
+		request := &idl.UpgradePrimariesRequest{Opts: []*idl.PgOptions{{ContentID: 1}, {ContentID: 0}}}
+		_, err := idl.NewAgentClient(conn).UpgradePrimaries(context.Background(), request)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		deactivate()
+		substepSpan.End(nil)
+
+		spans := parseSpans(t, output)
+		client := findSpan(t, spans, "idl.Agent/UpgradePrimaries", tracing.KindClient)
+		if client.ParentSpanID != substepSpan.SpanID() || client.TraceID != substepSpan.TraceID() {
+			t.Errorf("expected the substep to be the parent of the client span got %+v", client)
+		}
+
+		if client.attribute(tracing.ServerAddressKey) != "sdw1" {
+			t.Errorf("got attributes %+v", client.Attributes)
+		}
+
+		server := findSpan(t, spans, "idl.Agent/UpgradePrimaries", tracing.KindServer)
+		if server.ParentSpanID != client.SpanID || server.TraceID != client.TraceID {
+			t.Errorf("expected the client span to be the parent of the server span got %+v", server)
+		}
+
+		if server.attribute(tracing.SubstepKey) != "upgrade_primaries" {
+			t.Errorf("expected the substep baggage to be propagated got %+v", server.Attributes)
+		}
+
+		if !reflect.DeepEqual(server.attribute(tracing.ContentIDKey), []string{"0", "1"}) {
+			t.Errorf("got content ids %v want [0 1]", server.attribute(tracing.ContentIDKey))
+		}
+
+		pgUpgrade := findSpan(t, spans, "pg_upgrade", tracing.KindInternal)
+		if pgUpgrade.ParentSpanID != server.SpanID || pgUpgrade.attribute(tracing.SubstepKey) != "upgrade_primaries" {
+			t.Errorf("expected the server span to be the parent of spans started by the handler got %+v", pgUpgrade)
+		}
+	})
+
+	t.Run("activates the span of a streaming step", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		tracing.SetOutput(output, "cli")
+		defer tracing.SetOutput(nil, "")
+
+		hub := &hubServer{}
+		conn := dial(t, func(server *grpc.Server) {
+			idl.RegisterCliToHubServer(server, hub)
+		}, grpc.WithStreamInterceptor(tracing.StreamClientInterceptor("localhost")))
+
+		ctx, stepSpan := tracing.Start(context.Background(), "execute", tracing.KindInternal)
+		stream, err := idl.NewCliToHubClient(conn).Execute(ctx, &idl.ExecuteRequest{})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		for {
+			_, err := stream.Recv()
+			if err == io.EOF {
+				break
+			}
+
+			if err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+		}
+
+		stepSpan.End(nil)
+
+		if hub.active == nil || hub.active.TraceID() != stepSpan.TraceID() {
+			t.Fatalf("expected the server span to be active during the step got %+v", hub.active)
+		}
+
+		if tracing.Active() != nil {
+			t.Errorf("expected no active span after the step")
+		}
+
+		spans := parseSpans(t, output)
+		client := findSpan(t, spans, "idl.CliToHub/Execute", tracing.KindClient)
+		server := findSpan(t, spans, "idl.CliToHub/Execute", tracing.KindServer)
+		if client.ParentSpanID != stepSpan.SpanID() || server.ParentSpanID != client.SpanID || server.SpanID != hub.active.SpanID() {
+			t.Errorf("got client span %+v and server span %+v", client, server)
+		}
+	})
+
+	t.Run("records client and server spans for a client streaming rpc to the agent", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		tracing.SetOutput(output, "hub")
+		defer tracing.SetOutput(nil, "")
+
+		conn := dial(t, func(server *grpc.Server) {
+			idl.RegisterAgentServer(server, &agentServer{})
+		}, grpc.WithStreamInterceptor(tracing.StreamClientInterceptor("sdw1")))
+
+		_, substepSpan := tracing.Start(context.Background(), "copy_coordinator", tracing.KindInternal)
+		deactivate := tracing.Activate(substepSpan)
+
+		stream, err := idl.NewAgentClient(conn).VerifyCoordinatorBackup(context.Background())
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		err = stream.Send(&idl.VerifyCoordinatorBackupRequest{BackupDir: "/data/.gpupgrade"})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		_, err = stream.CloseAndRecv()
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		deactivate()
+		substepSpan.End(nil)
+
+		spans := parseSpans(t, output)
+		client := findSpan(t, spans, "idl.Agent/VerifyCoordinatorBackup", tracing.KindClient)
+		if client.ParentSpanID != substepSpan.SpanID() || client.attribute(tracing.ServerAddressKey) != "sdw1" {
+			t.Errorf("expected the substep to be the parent of the client span got %+v", client)
+		}
+
+		server := findSpan(t, spans, "idl.Agent/VerifyCoordinatorBackup", tracing.KindServer)
+		if server.ParentSpanID != client.SpanID || server.TraceID != client.TraceID {
+			t.Errorf("expected the client span to be the parent of the server span got %+v", server)
+		}
+	})
+
+	t.Run("does not parent requests without a propagated span to the active span", func(t *testing.T) {
+		output := &bytes.Buffer{}
+		tracing.SetOutput(output, "agent")
+		defer tracing.SetOutput(nil, "")
+
+		conn := dial(t, func(server *grpc.Server) {
+			idl.RegisterAgentServer(server, &agentServer{})
+		})
+
+		_, active := tracing.Start(context.Background(), "execute", tracing.KindInternal)
+		deactivate := tracing.Activate(active)
+		defer deactivate()
+
+		_, err := idl.NewAgentClient(conn).UpgradePrimaries(context.Background(), &idl.UpgradePrimariesRequest{})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		server := findSpan(t, parseSpans(t, output), "idl.Agent/UpgradePrimaries", tracing.KindServer)
+		if server.ParentSpanID != "" || server.TraceID == active.TraceID() {
+			t.Errorf("expected a new trace got %+v", server)
+		}
+	})
+}
+
+func TestTracePath(t *testing.T) {
+	path := tracing.TracePath("/home/gpadmin/gpAdminLogs/gpupgrade", "agent")
+	if !strings.HasPrefix(path, "/home/gpadmin/gpAdminLogs/gpupgrade/agent_traces_") || !strings.HasSuffix(path, ".jsonl") {
+		t.Errorf("got path %q", path)
+	}
+}
@@ -0,0 +1,232 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"syscall"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+// ArchivedSegment identifies the data directory of an upgraded segment and the
+// archived source data directory finalize renamed it from.
+type ArchivedSegment struct {
+	DataDir        string `json:"dataDir"`
+	ArchiveDataDir string `json:"archiveDataDir"`
+}
+
+// Archive is an archived source data directory or tablespace directory left
+// behind by finalize.
+type Archive struct {
+	Path       string `json:"path"`
+	Tablespace bool   `json:"tablespace"`
+	// Bytes is the total size of the archive. ReclaimableBytes excludes files
+	// which are hard linked with the upgraded cluster in link mode, and is
+	// the space freed by deleting the archive.
+	Bytes            int64  `json:"bytes"`
+	ReclaimableBytes int64  `json:"reclaimableBytes"`
+	Error            string `json:"error,omitempty"`
+}
+
+// FindArchives returns the archived source data directories and tablespace
+// directories of the segments on the current host. Only archived data
+// directories that exist and look like a postgres data directory are
+// returned. Archived tablespace directories are only returned for segments
+// that have an archived data directory. An archive that fails verification is
+// returned with its error set.
+//
+// Archived tablespace directories are found by following the tablespace links
+// of the upgraded data directory. For a 6X or later source they are the
+// GPDB_<majorVersion>_<catalogVersion> directories of an older major version
+// than the target. For a 5X source they are the dbOID directories of the
+// tablespace location.
+func FindArchives(segments []ArchivedSegment, targetMajorVersion uint64) []Archive {
+	var archives []Archive
+	for _, seg := range segments {
+		exist, err := PathExist(seg.ArchiveDataDir)
+		if err != nil {
+			archives = append(archives, Archive{Path: seg.ArchiveDataDir, Error: err.Error()})
+			continue
+		}
+
+		if !exist {
+			continue
+		}
+
+		if err := VerifyDataDirectory(seg.ArchiveDataDir); err != nil {
+			archives = append(archives, Archive{Path: seg.ArchiveDataDir, Error: err.Error()})
+			continue
+		}
+
+		archives = append(archives, Archive{Path: seg.ArchiveDataDir})
+
+		tablespaceDirs, err := archivedTablespaceDirectories(seg.DataDir, targetMajorVersion)
+		if err != nil {
+			archives = append(archives, Archive{Path: seg.DataDir, Tablespace: true, Error: err.Error()})
+			continue
+		}
+
+		for _, dir := range tablespaceDirs {
+			archives = append(archives, Archive{Path: dir, Tablespace: true})
+		}
+	}
+
+	for i := range archives {
+		if archives[i].Error != "" {
+			continue
+		}
+
+		bytes, reclaimable, err := directorySize(archives[i].Path)
+		if err != nil {
+			archives[i].Error = err.Error()
+			continue
+		}
+
+		archives[i].Bytes = bytes
+		archives[i].ReclaimableBytes = reclaimable
+	}
+
+	return archives
+}
+
+func archivedTablespaceDirectories(dataDir string, targetMajorVersion uint64) ([]string, error) {
+	tblspc := filepath.Join(dataDir, "pg_tblspc")
+	entries, err := os.ReadDir(tblspc)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	var dirs []string
+	for _, entry := range entries {
+		// The tablespace link points to /dir/<fsname>/<datadir>/<tablespaceOID>/<dbID>
+		dbIDDir, err := filepath.EvalSymlinks(filepath.Join(tblspc, entry.Name()))
+		if err != nil {
+			return nil, xerrors.Errorf("resolve tablespace link: %w", err)
+		}
+
+		versionDirs, err := os.ReadDir(dbIDDir)
+		if err != nil {
+			return nil, err
+		}
+
+		targetPrefix := fmt.Sprintf("GPDB_%d_", targetMajorVersion)
+		for _, versionDir := range versionDirs {
+			if versionDir.IsDir() && strings.HasPrefix(versionDir.Name(), "GPDB_") && !strings.HasPrefix(versionDir.Name(), targetPrefix) {
+				dirs = append(dirs, filepath.Join(dbIDDir, versionDir.Name()))
+			}
+		}
+
+		// A 5X source stores its tablespace as dbOID directories alongside
+		// the dbID directory of the upgraded tablespace.
+		tsLocation := filepath.Dir(dbIDDir)
+		locationEntries, err := os.ReadDir(tsLocation)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, locationEntry := range locationEntries {
+			if !locationEntry.IsDir() || locationEntry.Name() == filepath.Base(dbIDDir) {
+				continue
+			}
+
+			legacy, err := VerifyLegacyTablespaceDbOIDDirectory(utils.System.DirFS(tsLocation), locationEntry.Name())
+			if err != nil {
+				return nil, err
+			}
+
+			if legacy {
+				dirs = append(dirs, filepath.Join(tsLocation, locationEntry.Name()))
+			}
+		}
+	}
+
+	sort.Strings(dirs)
+	return dirs, nil
+}
+
+// directorySize returns the total size of the files in dir and the size of
+// those that are not hard linked elsewhere.
+func directorySize(dir string) (int64, int64, error) {
+	var total, reclaimable int64
+	err := filepath.WalkDir(dir, func(path string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if !entry.Type().IsRegular() {
+			return nil
+		}
+
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+
+		total += info.Size()
+		if stat, ok := info.Sys().(*syscall.Stat_t); !ok || stat.Nlink <= 1 {
+			reclaimable += info.Size()
+		}
+
+		return nil
+	})
+
+	return total, reclaimable, err
+}
+
+// DeleteArchives deletes the archives in parallel that passed verification,
+// and sets the error of each archive that failed to be deleted.
+func DeleteArchives(archives []Archive) []Archive {
+	var wg sync.WaitGroup
+	for i := range archives {
+		if archives[i].Error != "" {
+			continue
+		}
+
+		wg.Add(1)
+		go func(archive *Archive) {
+			defer wg.Done()
+
+			if err := utils.System.RemoveAll(archive.Path); err != nil && !errors.Is(err, os.ErrNotExist) {
+				archive.Error = err.Error()
+			}
+		}(&archives[i])
+	}
+
+	wg.Wait()
+	return archives
+}
+
+// ArchivesRequest is sent to each host to find, and optionally delete, the
+// archives of the segments on that host.
+type ArchivesRequest struct {
+	Segments           []ArchivedSegment `json:"segments"`
+	TargetMajorVersion uint64            `json:"targetMajorVersion"`
+	Delete             bool              `json:"delete"`
+}
+
+// Archives finds the archives of the request, and deletes them when requested.
+// The archives are found again rather than trusting the caller such that only
+// verified directories are deleted.
+func Archives(request ArchivesRequest) []Archive {
+	archives := FindArchives(request.Segments, request.TargetMajorVersion)
+	if request.Delete {
+		archives = DeleteArchives(archives)
+	}
+
+	return archives
+}
@@ -0,0 +1,119 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade_test
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+)
+
+func TestArchives(t *testing.T) {
+	t.Run("finds the archived data directory and older tablespace directories", func(t *testing.T) {
+		archive, dataDir, cleanup := testutils.MustCreateDataDirs(t)
+		defer cleanup(t)
+
+		tsLocation := testutils.GetTempDir(t, "tablespace")
+		defer testutils.MustRemoveAll(t, tsLocation)
+
+		dbIDDir := filepath.Join(tsLocation, "2")
+		testutils.MustCreateDir(t, filepath.Join(dbIDDir, "GPDB_6_301908232"))
+		testutils.MustCreateDir(t, filepath.Join(dbIDDir, "GPDB_7_302307241"))
+		testutils.MustWriteToFile(t, filepath.Join(dbIDDir, "GPDB_6_301908232", "16389"), "1234")
+
+		testutils.MustCreateDir(t, filepath.Join(dataDir, "pg_tblspc"))
+		if err := os.Symlink(dbIDDir, filepath.Join(dataDir, "pg_tblspc", "16385")); err != nil {
+			t.Fatalf("creating tablespace link: %v", err)
+		}
+
+		archives := upgrade.Archives(upgrade.ArchivesRequest{
+			Segments:           []upgrade.ArchivedSegment{{DataDir: dataDir, ArchiveDataDir: archive}},
+			TargetMajorVersion: 7,
+		})
+
+		expected := []upgrade.Archive{
+			{Path: archive},
+			{Path: filepath.Join(dbIDDir, "GPDB_6_301908232"), Tablespace: true, Bytes: 4, ReclaimableBytes: 4},
+		}
+		if !reflect.DeepEqual(archives, expected) {
+			t.Errorf("got %+v want %+v", archives, expected)
+		}
+
+		testutils.PathMustExist(t, archive)
+	})
+
+	t.Run("finds the legacy tablespace directories of a 5X source", func(t *testing.T) {
+		archive, dataDir, cleanup := testutils.MustCreateDataDirs(t)
+		defer cleanup(t)
+
+		tsLocation := testutils.GetTempDir(t, "tablespace")
+		defer testutils.MustRemoveAll(t, tsLocation)
+
+		dbIDDir := filepath.Join(tsLocation, "2")
+		testutils.MustCreateDir(t, filepath.Join(dbIDDir, "GPDB_6_301908232"))
+		testutils.MustCreateDir(t, filepath.Join(tsLocation, "12094"))
+		testutils.MustWriteToFile(t, filepath.Join(tsLocation, "12094", upgrade.PGVersion), "")
+
+		testutils.MustCreateDir(t, filepath.Join(dataDir, "pg_tblspc"))
+		if err := os.Symlink(dbIDDir, filepath.Join(dataDir, "pg_tblspc", "16385")); err != nil {
+			t.Fatalf("creating tablespace link: %v", err)
+		}
+
+		archives := upgrade.FindArchives([]upgrade.ArchivedSegment{{DataDir: dataDir, ArchiveDataDir: archive}}, 6)
+
+		expected := []upgrade.Archive{
+			{Path: archive},
+			{Path: filepath.Join(tsLocation, "12094"), Tablespace: true},
+		}
+		if !reflect.DeepEqual(archives, expected) {
+			t.Errorf("got %+v want %+v", archives, expected)
+		}
+	})
+
+	t.Run("skips segments without an archived data directory", func(t *testing.T) {
+		archives := upgrade.FindArchives([]upgrade.ArchivedSegment{{DataDir: "/does/not/exist", ArchiveDataDir: "/does/not/exist.ABC.0.old"}}, 7)
+		if len(archives) != 0 {
+			t.Errorf("expected no archives got %+v", archives)
+		}
+	})
+
+	t.Run("does not delete archived directories that fail verification", func(t *testing.T) {
+		archive := testutils.GetTempDir(t, "archive")
+		defer testutils.MustRemoveAll(t, archive)
+
+		archives := upgrade.Archives(upgrade.ArchivesRequest{
+			Segments:           []upgrade.ArchivedSegment{{DataDir: "/does/not/exist", ArchiveDataDir: archive}},
+			TargetMajorVersion: 7,
+			Delete:             true,
+		})
+
+		if len(archives) != 1 || archives[0].Error == "" {
+			t.Errorf("expected a verification error got %+v", archives)
+		}
+
+		testutils.PathMustExist(t, archive)
+	})
+
+	t.Run("deletes the archived directories", func(t *testing.T) {
+		archive, dataDir, cleanup := testutils.MustCreateDataDirs(t)
+		defer cleanup(t)
+
+		archives := upgrade.Archives(upgrade.ArchivesRequest{
+			Segments:           []upgrade.ArchivedSegment{{DataDir: dataDir, ArchiveDataDir: archive}},
+			TargetMajorVersion: 7,
+			Delete:             true,
+		})
+
+		if len(archives) != 1 || archives[0].Error != "" {
+			t.Errorf("unexpected archives %+v", archives)
+		}
+
+		testutils.PathMustNotExist(t, archive)
+		testutils.PathMustExist(t, dataDir)
+	})
+}
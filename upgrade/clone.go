@@ -0,0 +1,69 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+var cloneCommand = exec.Command
+
+// XXX: for internal testing only
+func SetCloneCommand(command exectest.Command) {
+	cloneCommand = command
+}
+
+// XXX: for internal testing only
+func ResetCloneCommand() {
+	cloneCommand = exec.Command
+}
+
+// CheckCloneSupport verifies that files in each source directory can be cloned
+// into its target directory as pg_upgrade does in clone mode. This requires
+// both directories to be on the same filesystem with reflink support such as
+// XFS or btrfs. A temporary file is cloned since not every filesystem reports
+// whether it supports reflinks.
+func CheckCloneSupport(dirs []*idl.CloneDirectories) error {
+	var err error
+	for _, dir := range dirs {
+		err = errorlist.Append(err, checkClone(dir.GetSource(), dir.GetTarget()))
+	}
+
+	return err
+}
+
+func checkClone(sourceDir string, targetDir string) error {
+	source, err := os.CreateTemp(sourceDir, ".gpupgrade_clone_check")
+	if err != nil {
+		return xerrors.Errorf("check clone support: %w", err)
+	}
+	defer os.Remove(source.Name())
+
+	_, err = source.WriteString("gpupgrade clone check\n")
+	if cErr := source.Close(); cErr != nil {
+		err = errorlist.Append(err, cErr)
+	}
+	if err != nil {
+		return xerrors.Errorf("check clone support: %w", err)
+	}
+
+	target := filepath.Join(targetDir, filepath.Base(source.Name())+".clone")
+	defer os.Remove(target)
+
+	output, err := cloneCommand("cp", "--reflink=always", source.Name(), target).CombinedOutput()
+	if err != nil {
+		return xerrors.Errorf("%q cannot be cloned into %q: %s: %w", sourceDir, targetDir, strings.TrimSpace(string(output)), err)
+	}
+
+	return nil
+}
@@ -0,0 +1,89 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+)
+
+func CloneNotSupported() {
+	os.Stderr.WriteString("cp: failed to clone: Operation not supported")
+	os.Exit(1)
+}
+
+func init() {
+	exectest.RegisterMains(
+		CloneNotSupported,
+	)
+}
+
+func TestCheckCloneSupport(t *testing.T) {
+	source := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, source)
+
+	target := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, target)
+
+	dirs := []*idl.CloneDirectories{{Source: source, Target: target}}
+
+	t.Run("clones a temporary file into the target directory and removes it", func(t *testing.T) {
+		var args []string
+		upgrade.SetCloneCommand(exectest.NewCommandWithVerifier(exectest.Success, func(name string, arg ...string) {
+			args = append([]string{name}, arg...)
+		}))
+		defer upgrade.ResetCloneCommand()
+
+		err := upgrade.CheckCloneSupport(dirs)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(args) != 4 || args[0] != "cp" || args[1] != "--reflink=always" {
+			t.Fatalf("got args %q", args)
+		}
+
+		if filepath.Dir(args[2]) != source || filepath.Dir(args[3]) != target {
+			t.Errorf("got args %q want a file in %q cloned into %q", args, source, target)
+		}
+
+		testutils.PathMustNotExist(t, args[2])
+	})
+
+	t.Run("reports each directory that cannot be cloned", func(t *testing.T) {
+		upgrade.SetCloneCommand(exectest.NewCommand(CloneNotSupported))
+		defer upgrade.ResetCloneCommand()
+
+		err := upgrade.CheckCloneSupport(append(dirs, &idl.CloneDirectories{Source: source, Target: source}))
+
+		var errs errorlist.Errors
+		if !errors.As(err, &errs) {
+			t.Fatalf("got error %#v want type %T", err, errs)
+		}
+
+		if len(errs) != 2 {
+			t.Fatalf("got %d errors want 2", len(errs))
+		}
+
+		if !strings.Contains(errs[0].Error(), "Operation not supported") {
+			t.Errorf("expected error %q to contain the output of cp", errs[0].Error())
+		}
+	})
+
+	t.Run("errors when the source directory does not exist", func(t *testing.T) {
+		err := upgrade.CheckCloneSupport([]*idl.CloneDirectories{{Source: filepath.Join(source, "does-not-exist"), Target: target}})
+		if !errors.Is(err, os.ErrNotExist) {
+			t.Errorf("got error %#v want %#v", err, os.ErrNotExist)
+		}
+	})
+}
@@ -93,6 +93,26 @@ func RenameDirectories(source, target string) error {
 	return nil
 }
 
+// ArchiveDirectory archives the source directory without replacing it. It is
+// used for segments such as deferred mirrors that have no upgraded counterpart
+// to rename in their place. For example:
+// source '/data/dbfast_mirror1/demoDataDir0' becomes archive '/data/dbfast_mirror1/demoDataDir.123ABC.0.old'
+// given target '/data/dbfast_mirror1/demoDataDir.123ABC.0'
+func ArchiveDirectory(source, target string) error {
+	archive := target + OldSuffix
+
+	alreadyArchived, err := AlreadyRenamed(source, archive)
+	if err != nil {
+		return err
+	}
+
+	if alreadyArchived {
+		return nil
+	}
+
+	return renameDataDirectory(source, archive)
+}
+
 func renameDataDirectory(src, dst string) error {
 	if err := VerifyDataDirectory(src); err != nil {
 		return err
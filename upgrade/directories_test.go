@@ -275,6 +275,57 @@ func setup(t *testing.T) (teardown func(), directories []string, requiredPaths [
 	return teardown, directories, requiredPaths
 }
 
+func TestArchiveDirectory(t *testing.T) {
+	testlog.SetupTestLogger()
+
+	t.Run("archives the source without renaming a target in its place", func(t *testing.T) {
+		source, target, cleanup := testutils.MustCreateDataDirs(t)
+		defer cleanup(t)
+		testutils.MustRemoveAll(t, target) // deferred mirrors have no upgraded target
+		defer testutils.MustRemoveAll(t, target+upgrade.OldSuffix)
+
+		err := upgrade.ArchiveDirectory(source, target)
+		if err != nil {
+			t.Errorf("unexpected error: %#v", err)
+		}
+
+		testutils.PathMustNotExist(t, source)
+		testutils.PathMustNotExist(t, target)
+		testutils.PathMustExist(t, target+upgrade.OldSuffix)
+	})
+
+	t.Run("returns early if already archived", func(t *testing.T) {
+		source, target, cleanup := testutils.MustCreateDataDirs(t)
+		defer cleanup(t)
+		testutils.MustRemoveAll(t, target)
+
+		archive := target + upgrade.OldSuffix
+		err := os.Rename(source, archive)
+		if err != nil {
+			t.Fatalf("unexpected error: %#v", err)
+		}
+		defer testutils.MustRemoveAll(t, archive)
+
+		called := false
+		utils.System.Rename = func(old, new string) error {
+			called = true
+			return nil
+		}
+		defer func() {
+			utils.System.Rename = os.Rename
+		}()
+
+		err = upgrade.ArchiveDirectory(source, target)
+		if err != nil {
+			t.Errorf("unexpected error: %#v", err)
+		}
+
+		if called {
+			t.Errorf("expected rename to not be called")
+		}
+	})
+}
+
 func TestDeleteDirectories(t *testing.T) {
 	testlog.SetupTestLogger()
 
@@ -0,0 +1,153 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+)
+
+// maxReportedMismatches limits the files listed when verifying a manifest
+// fails such that a badly corrupted copy does not produce a huge error.
+const maxReportedMismatches = 20
+
+// CreateManifest returns the size and checksum of each file, and the target
+// of each symbolic link, within path. Paths are relative to path and joined
+// with prefix. Path may also be a single file which is recorded as prefix.
+func CreateManifest(path string, prefix string) ([]*idl.ManifestEntry, error) {
+	var entries []*idl.ManifestEntry
+	err := filepath.WalkDir(path, func(current string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if d.IsDir() {
+			return nil
+		}
+
+		rel, err := filepath.Rel(path, current)
+		if err != nil {
+			return err
+		}
+
+		entry, err := manifestEntry(current, d)
+		if err != nil {
+			return err
+		}
+
+		if entry == nil {
+			return nil
+		}
+
+		entry.Path = filepath.Join(prefix, rel)
+		entries = append(entries, entry)
+		return nil
+	})
+	if err != nil {
+		return nil, xerrors.Errorf("create manifest of %q: %w", path, err)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].GetPath() < entries[j].GetPath()
+	})
+
+	return entries, nil
+}
+
+// manifestEntry returns the entry of a file or symbolic link, and nil for
+// anything else such as sockets.
+func manifestEntry(path string, d fs.DirEntry) (*idl.ManifestEntry, error) {
+	if d.Type()&fs.ModeSymlink != 0 {
+		link, err := os.Readlink(path)
+		if err != nil {
+			return nil, err
+		}
+
+		return &idl.ManifestEntry{Link: link}, nil
+	}
+
+	if !d.Type().IsRegular() {
+		return nil, nil
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	hash := sha256.New()
+	size, err := io.Copy(hash, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return &idl.ManifestEntry{Size: size, Checksum: hex.EncodeToString(hash.Sum(nil))}, nil
+}
+
+// VerifyManifest compares the contents of dir against the manifest. Files that
+// are missing, differ in size or checksum, or are not in the manifest are
+// reported.
+func VerifyManifest(dir string, manifest []*idl.ManifestEntry) error {
+	actual, err := CreateManifest(dir, "")
+	if errors.Is(err, fs.ErrNotExist) {
+		return xerrors.Errorf("directory %q does not exist", dir)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	actualEntries := make(map[string]*idl.ManifestEntry)
+	for _, entry := range actual {
+		actualEntries[entry.GetPath()] = entry
+	}
+
+	var mismatches []string
+	for _, expected := range manifest {
+		entry, ok := actualEntries[expected.GetPath()]
+		delete(actualEntries, expected.GetPath())
+
+		switch {
+		case !ok:
+			mismatches = append(mismatches, fmt.Sprintf("%s: missing", expected.GetPath()))
+		case entry.GetLink() != expected.GetLink():
+			mismatches = append(mismatches, fmt.Sprintf("%s: link to %q want %q", expected.GetPath(), entry.GetLink(), expected.GetLink()))
+		case entry.GetSize() != expected.GetSize():
+			mismatches = append(mismatches, fmt.Sprintf("%s: size %d want %d", expected.GetPath(), entry.GetSize(), expected.GetSize()))
+		case entry.GetChecksum() != expected.GetChecksum():
+			mismatches = append(mismatches, fmt.Sprintf("%s: checksum mismatch", expected.GetPath()))
+		}
+	}
+
+	var unexpected []string
+	for path := range actualEntries {
+		unexpected = append(unexpected, fmt.Sprintf("%s: not in manifest", path))
+	}
+	sort.Strings(unexpected)
+	mismatches = append(mismatches, unexpected...)
+
+	if len(mismatches) == 0 {
+		return nil
+	}
+
+	total := len(mismatches)
+	if total > maxReportedMismatches {
+		mismatches = append(mismatches[:maxReportedMismatches], fmt.Sprintf("... and %d more", total-maxReportedMismatches))
+	}
+
+	return xerrors.Errorf("%d files in %q do not match the manifest:\n  %s", total, dir, strings.Join(mismatches, "\n  "))
+}
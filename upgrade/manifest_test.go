@@ -0,0 +1,137 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+)
+
+func TestCreateManifest(t *testing.T) {
+	dir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, dir)
+
+	testutils.MustCreateDir(t, filepath.Join(dir, "global"))
+	testutils.MustCreateDir(t, filepath.Join(dir, "pg_tblspc"))
+	testutils.MustWriteToFile(t, filepath.Join(dir, "PG_VERSION"), "9.4")
+	testutils.MustWriteToFile(t, filepath.Join(dir, "global", "pg_control"), "control")
+	if err := os.Symlink("/tablespace/16384", filepath.Join(dir, "pg_tblspc", "16384")); err != nil {
+		t.Fatalf("unexpected error %#v", err)
+	}
+
+	t.Run("records the size and checksum of files and the target of links", func(t *testing.T) {
+		entries, err := upgrade.CreateManifest(dir, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(entries) != 3 {
+			t.Fatalf("got %d entries want 3", len(entries))
+		}
+
+		expectedPaths := []string{"PG_VERSION", "global/pg_control", "pg_tblspc/16384"}
+		for i, entry := range entries {
+			if entry.GetPath() != expectedPaths[i] {
+				t.Errorf("got path %q want %q", entry.GetPath(), expectedPaths[i])
+			}
+		}
+
+		if entries[0].GetSize() != 3 || entries[0].GetChecksum() == "" {
+			t.Errorf("got size %d and checksum %q for PG_VERSION", entries[0].GetSize(), entries[0].GetChecksum())
+		}
+
+		if entries[2].GetLink() != "/tablespace/16384" {
+			t.Errorf("got link %q want %q", entries[2].GetLink(), "/tablespace/16384")
+		}
+	})
+
+	t.Run("prefixes a single file with the prefix", func(t *testing.T) {
+		entries, err := upgrade.CreateManifest(filepath.Join(dir, "PG_VERSION"), "version")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if len(entries) != 1 || entries[0].GetPath() != "version" {
+			t.Errorf("got entries %v", entries)
+		}
+	})
+}
+
+func TestVerifyManifest(t *testing.T) {
+	createCopy := func(t *testing.T) (string, string) {
+		source := testutils.GetTempDir(t, "")
+		testutils.MustCreateDir(t, filepath.Join(source, "global"))
+		testutils.MustWriteToFile(t, filepath.Join(source, "PG_VERSION"), "9.4")
+		testutils.MustWriteToFile(t, filepath.Join(source, "global", "pg_control"), "control")
+
+		backup := testutils.GetTempDir(t, "")
+		testutils.MustCreateDir(t, filepath.Join(backup, "global"))
+		testutils.MustWriteToFile(t, filepath.Join(backup, "PG_VERSION"), "9.4")
+		testutils.MustWriteToFile(t, filepath.Join(backup, "global", "pg_control"), "control")
+
+		return source, backup
+	}
+
+	t.Run("succeeds when the copy matches", func(t *testing.T) {
+		source, backup := createCopy(t)
+		defer testutils.MustRemoveAll(t, source)
+		defer testutils.MustRemoveAll(t, backup)
+
+		manifest, err := upgrade.CreateManifest(source, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		err = upgrade.VerifyManifest(backup, manifest)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+	})
+
+	t.Run("reports corrupted, missing, and unexpected files", func(t *testing.T) {
+		source, backup := createCopy(t)
+		defer testutils.MustRemoveAll(t, source)
+		defer testutils.MustRemoveAll(t, backup)
+
+		manifest, err := upgrade.CreateManifest(source, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		testutils.MustWriteToFile(t, filepath.Join(backup, "PG_VERSION"), "9.5")
+		testutils.MustRemoveAll(t, filepath.Join(backup, "global", "pg_control"))
+		testutils.MustWriteToFile(t, filepath.Join(backup, "postmaster.pid"), "1234")
+
+		err = upgrade.VerifyManifest(backup, manifest)
+		if err == nil {
+			t.Fatal("expected an error")
+		}
+
+		for _, expected := range []string{
+			"3 files in",
+			"PG_VERSION: checksum mismatch",
+			"global/pg_control: missing",
+			"postmaster.pid: not in manifest",
+		} {
+			if !strings.Contains(err.Error(), expected) {
+				t.Errorf("expected error %q to contain %q", err.Error(), expected)
+			}
+		}
+	})
+
+	t.Run("errors when the copy does not exist", func(t *testing.T) {
+		dir := testutils.GetTempDir(t, "")
+		defer testutils.MustRemoveAll(t, dir)
+
+		err := upgrade.VerifyManifest(filepath.Join(dir, "does-not-exist"), nil)
+		if err == nil || !strings.Contains(err.Error(), "does not exist") {
+			t.Errorf("got error %v", err)
+		}
+	})
+}
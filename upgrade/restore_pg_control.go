@@ -45,3 +45,11 @@ func RestorePgControl(dataDir string, streams step.OutStreams) error {
 
 	return utils.System.Rename(src, dst)
 }
+
+// IsLinked returns whether pg_upgrade started linking the data directory in
+// link mode. pg_upgrade renames pg_control to pg_control.old just before
+// linking such that the source can no longer be started. If linking never
+// started the data directory was not modified by pg_upgrade.
+func IsLinked(dataDir string) (bool, error) {
+	return PathExist(filepath.Join(dataDir, "global", "pg_control.old"))
+}
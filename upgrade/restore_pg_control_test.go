@@ -125,3 +125,37 @@ func TestRestorePgControl(t *testing.T) {
 		}
 	})
 }
+
+func TestIsLinked(t *testing.T) {
+	t.Run("returns true when pg_control was renamed", func(t *testing.T) {
+		dataDir := setupGlobalDir(t)
+		defer testutils.MustRemoveAll(t, dataDir)
+
+		testutils.MustWriteToFile(t, filepath.Join(dataDir, "global", "pg_control.old"), "")
+
+		linked, err := upgrade.IsLinked(dataDir)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		if !linked {
+			t.Errorf("expected %q to be linked", dataDir)
+		}
+	})
+
+	t.Run("returns false when pg_control was not renamed", func(t *testing.T) {
+		dataDir := setupGlobalDir(t)
+		defer testutils.MustRemoveAll(t, dataDir)
+
+		testutils.MustWriteToFile(t, filepath.Join(dataDir, "global", "pg_control"), "")
+
+		linked, err := upgrade.IsLinked(dataDir)
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+
+		if linked {
+			t.Errorf("expected %q to not be linked", dataDir)
+		}
+	})
+}
@@ -0,0 +1,106 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+// VerifyRevertAction runs the same safety checks revert runs before deleting
+// or rsyncing the directories of the action that are on the current host.
+// Directories that are to be deleted but do not exist are skipped by revert
+// and are thus valid.
+func VerifyRevertAction(action *idl.RevertAction) error {
+	switch action.GetType() {
+	case idl.RevertAction_delete_data_directory:
+		return verifyIfExists(action.GetPath(), func() error {
+			return VerifyDataDirectory(action.GetPath())
+		})
+
+	case idl.RevertAction_delete_tablespace_directory:
+		return verifyIfExists(filepath.Dir(action.GetPath()), func() error {
+			valid, err := VerifyTablespaceDirectory(filepath.Dir(action.GetPath()))
+			if err != nil {
+				return err
+			}
+
+			if !valid {
+				return xerrors.Errorf("Invalid tablespace directory %q", action.GetPath())
+			}
+
+			return nil
+		})
+
+	case idl.RevertAction_delete_backup_directory:
+		return verifyIfExists(action.GetPath(), func() error {
+			info, err := utils.System.Stat(action.GetPath())
+			if err != nil {
+				return err
+			}
+
+			if !info.IsDir() {
+				return xerrors.Errorf("backup directory %q is not a directory", action.GetPath())
+			}
+
+			return nil
+		})
+
+	case idl.RevertAction_rsync_data_directory:
+		var dirs []string
+		if action.GetSourceHost() == action.GetHost() {
+			dirs = append(dirs, action.GetSource())
+		}
+
+		if action.GetDestinationHost() == action.GetHost() {
+			dirs = append(dirs, action.GetDestination())
+		}
+
+		return VerifyDataDirectory(dirs...)
+
+	case idl.RevertAction_rsync_tablespace_directory:
+		// Only the source can be verified since the destination tablespace
+		// location is expected to be missing the upgraded contents.
+		if action.GetSourceHost() != action.GetHost() {
+			return nil
+		}
+
+		return VerifyTablespaceLocation(utils.System.DirFS(action.GetSource()), action.GetSource())
+
+	case idl.RevertAction_restore_snapshot:
+		// The snapshot is opaque to gpupgrade as it is taken by the snapshot
+		// provider, and the directory is restored as a whole.
+		return nil
+	}
+
+	return xerrors.Errorf("unknown revert action %q", action.GetType())
+}
+
+func verifyIfExists(path string, verify func() error) error {
+	_, err := utils.System.Stat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	return verify()
+}
+
+// VerifyRevertActions sets the verification error of each action.
+func VerifyRevertActions(actions []*idl.RevertAction) {
+	for _, action := range actions {
+		if err := VerifyRevertAction(action); err != nil {
+			action.VerificationError = err.Error()
+		}
+	}
+}
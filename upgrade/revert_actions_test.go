@@ -0,0 +1,106 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package upgrade_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/testutils/testlog"
+	"github.com/greenplum-db/gpupgrade/upgrade"
+)
+
+func TestVerifyRevertAction(t *testing.T) {
+	testlog.SetupTestLogger()
+
+	source, target, cleanup := testutils.MustCreateDataDirs(t)
+	defer cleanup(t)
+
+	notDataDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, notDataDir)
+
+	tablespaceDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, tablespaceDir)
+	testutils.MustCreateDir(t, filepath.Join(tablespaceDir, "16386", "1", "GPDB_6_301908232"))
+
+	invalidTablespaceDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, invalidTablespaceDir)
+	testutils.MustCreateDir(t, filepath.Join(invalidTablespaceDir, "16386", "1", "other"))
+
+	cases := []struct {
+		name   string
+		action *idl.RevertAction
+		valid  bool
+	}{
+		{
+			name:   "deleting a data directory",
+			action: &idl.RevertAction{Type: idl.RevertAction_delete_data_directory, Host: "sdw1", Path: target},
+			valid:  true,
+		},
+		{
+			name:   "deleting a data directory that does not exist",
+			action: &idl.RevertAction{Type: idl.RevertAction_delete_data_directory, Host: "sdw1", Path: filepath.Join(notDataDir, "missing")},
+			valid:  true,
+		},
+		{
+			name:   "deleting a directory that is not a data directory",
+			action: &idl.RevertAction{Type: idl.RevertAction_delete_data_directory, Host: "sdw1", Path: notDataDir},
+			valid:  false,
+		},
+		{
+			name:   "deleting a tablespace directory",
+			action: &idl.RevertAction{Type: idl.RevertAction_delete_tablespace_directory, Host: "sdw1", Path: filepath.Join(tablespaceDir, "16386", "1", "GPDB_6_301908232")},
+			valid:  true,
+		},
+		{
+			name:   "deleting an invalid tablespace directory",
+			action: &idl.RevertAction{Type: idl.RevertAction_delete_tablespace_directory, Host: "sdw1", Path: filepath.Join(invalidTablespaceDir, "16386", "1", "other")},
+			valid:  false,
+		},
+		{
+			name:   "rsyncing from a local data directory",
+			action: &idl.RevertAction{Type: idl.RevertAction_rsync_data_directory, Host: "sdw1", SourceHost: "sdw1", Source: source + string(os.PathSeparator), DestinationHost: "sdw2", Destination: "/does/not/matter"},
+			valid:  true,
+		},
+		{
+			name:   "rsyncing to a local directory that is not a data directory",
+			action: &idl.RevertAction{Type: idl.RevertAction_rsync_data_directory, Host: "cdw", SourceHost: "smdw", Source: "/does/not/matter", DestinationHost: "cdw", Destination: notDataDir},
+			valid:  false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := upgrade.VerifyRevertAction(c.action)
+			if c.valid && err != nil {
+				t.Errorf("unexpected error %#v", err)
+			}
+
+			if !c.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+
+	t.Run("sets the verification error of each action", func(t *testing.T) {
+		actions := []*idl.RevertAction{
+			{Type: idl.RevertAction_delete_data_directory, Path: target},
+			{Type: idl.RevertAction_delete_data_directory, Path: notDataDir},
+		}
+
+		upgrade.VerifyRevertActions(actions)
+
+		if actions[0].GetVerificationError() != "" {
+			t.Errorf("unexpected verification error %q", actions[0].GetVerificationError())
+		}
+
+		err := upgrade.VerifyDataDirectory(notDataDir)
+		if actions[1].GetVerificationError() != err.Error() {
+			t.Errorf("got verification error %q want %q", actions[1].GetVerificationError(), err.Error())
+		}
+	})
+}
@@ -20,6 +20,31 @@ const DefaultHubPort = 7527
 const DefaultAgentPort = 6416
 const DefaultDynamicLibraryPath = "$libdir"
 
+// Named clusters default to ports above the commonly used segment port bases
+// such as 6000 and 7000. The hub ports start above the last agent port.
+const namedClusterHubPortBase = 17527
+const namedClusterAgentPortBase = 16416
+
+// HubPort returns the default hub port of the selected cluster.
+func HubPort() int {
+	offset := utils.ClusterPortOffset()
+	if offset == 0 {
+		return DefaultHubPort
+	}
+
+	return namedClusterHubPortBase + offset
+}
+
+// AgentPort returns the default agent port of the selected cluster.
+func AgentPort() int {
+	offset := utils.ClusterPortOffset()
+	if offset == 0 {
+		return DefaultAgentPort
+	}
+
+	return namedClusterAgentPortBase + offset
+}
+
 var pgupgradeCmd = exec.Command
 
 func Run(stdout, stderr io.Writer, opts *idl.PgOptions) error {
@@ -64,8 +89,11 @@ func Run(stdout, stderr io.Writer, opts *idl.PgOptions) error {
 		args = append(args, "--continue-check-on-fatal")
 	}
 
-	if opts.GetMode() == idl.Mode_link {
+	switch opts.GetMode() {
+	case idl.Mode_link:
 		args = append(args, "--link")
+	case idl.Mode_clone:
+		args = append(args, "--clone")
 	}
 
 	if opts.GetOldOptions() != "" {
@@ -57,6 +57,30 @@ func init() {
 	)
 }
 
+func TestDefaultPorts(t *testing.T) {
+	t.Run("uses the default ports for the default cluster", func(t *testing.T) {
+		t.Setenv(utils.ClusterNameEnv, "")
+
+		if upgrade.HubPort() != upgrade.DefaultHubPort || upgrade.AgentPort() != upgrade.DefaultAgentPort {
+			t.Errorf("got hub port %d and agent port %d want %d and %d", upgrade.HubPort(), upgrade.AgentPort(), upgrade.DefaultHubPort, upgrade.DefaultAgentPort)
+		}
+	})
+
+	t.Run("uses ports above the common segment port bases for named clusters", func(t *testing.T) {
+		for _, name := range []string{"prod", "staging", "gp6_to_gp7"} {
+			t.Setenv(utils.ClusterNameEnv, name)
+
+			if hubPort := upgrade.HubPort(); hubPort < 17528 || hubPort > 18527 {
+				t.Errorf("got hub port %d for %q want between 17528 and 18527", hubPort, name)
+			}
+
+			if agentPort := upgrade.AgentPort(); agentPort < 16417 || agentPort > 17416 {
+				t.Errorf("got agent port %d for %q want between 16417 and 17416", agentPort, name)
+			}
+		}
+	})
+}
+
 func TestRun(t *testing.T) {
 	testlog.SetupTestLogger()
 
@@ -570,6 +594,30 @@ func TestRun(t *testing.T) {
 				PgUpgradeTimestamp: "RandomTimestamp",
 			},
 		},
+		{
+			name:        "sets --clone when Mode is clone",
+			expectedCmd: "pg_upgrade",
+			expectedArgs: []string{"--retain", "--progress",
+				"--old-bindir", "",
+				"--new-bindir", "",
+				"--old-datadir", "",
+				"--new-datadir", "",
+				"--old-port", "",
+				"--new-port", "",
+				"--mode", "unknown_pgUpgradeMode",
+				"--jobs", "",
+				"--output-dir", filepath.Join(logDir, "pg_upgrade_RandomTimestamp", "p3"),
+				"--clone",
+			},
+			opts: &idl.PgOptions{
+				BackupDir:          backupDir,
+				Role:               greenplum.PrimaryRole,
+				ContentID:          3,
+				Mode:               idl.Mode_clone,
+				TargetVersion:      "7.0.0",
+				PgUpgradeTimestamp: "RandomTimestamp",
+			},
+		},
 		{
 			name:        "does not set --link when Mode is copy",
 			expectedCmd: "pg_upgrade",
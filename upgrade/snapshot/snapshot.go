@@ -0,0 +1,240 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+// Package snapshot takes and restores filesystem snapshots of the source
+// cluster directories. In link mode pg_upgrade modifies the source data
+// directories in place, so a cluster without mirrors and a standby can only
+// be reverted by restoring such a snapshot.
+package snapshot
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"golang.org/x/xerrors"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/utils"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+	"github.com/greenplum-db/gpupgrade/utils/rsync"
+)
+
+const (
+	// CommandProvider runs user supplied commands for each directory.
+	CommandProvider = "command"
+
+	// RsyncProvider copies each directory into the backup directory.
+	RsyncProvider = "rsync"
+)
+
+// DirectoryEnv and NameEnv are set when running the commands of the command
+// provider to the directory being snapshotted and a name for its snapshot that
+// is unique on the host.
+const (
+	DirectoryEnv = "GPUPGRADE_SNAPSHOT_DIRECTORY"
+	NameEnv      = "GPUPGRADE_SNAPSHOT_NAME"
+)
+
+// Provider snapshots a directory and restores it to the snapshot.
+type Provider interface {
+	Snapshot(streams step.OutStreams, dir *idl.SnapshotDirectory) error
+	Restore(streams step.OutStreams, dir *idl.SnapshotDirectory) error
+	Delete(streams step.OutStreams, dir *idl.SnapshotDirectory) error
+}
+
+// Settings configures the snapshot provider. An empty provider disables
+// snapshots.
+type Settings struct {
+	Provider        string
+	SnapshotCommand string
+	RestoreCommand  string
+	DeleteCommand   string
+}
+
+func (s Settings) Enabled() bool {
+	return s.Provider != ""
+}
+
+func (s Settings) Validate() error {
+	switch s.Provider {
+	case "", RsyncProvider:
+		return nil
+	case CommandProvider:
+		if s.SnapshotCommand == "" || s.RestoreCommand == "" {
+			return xerrors.Errorf("snapshot provider %q requires both snapshot_command and snapshot_restore_command", CommandProvider)
+		}
+
+		return nil
+	}
+
+	return xerrors.Errorf("invalid snapshot provider %q. Please specify either %q or %q.", s.Provider, CommandProvider, RsyncProvider)
+}
+
+func (s Settings) Proto() *idl.SnapshotSettings {
+	return &idl.SnapshotSettings{
+		Provider:        s.Provider,
+		SnapshotCommand: s.SnapshotCommand,
+		RestoreCommand:  s.RestoreCommand,
+		DeleteCommand:   s.DeleteCommand,
+	}
+}
+
+// NewProvider returns the provider of the settings. Snapshots of the rsync
+// provider are stored within the backup directory of the host.
+func NewProvider(settings *idl.SnapshotSettings, backupDir string) (Provider, error) {
+	switch settings.GetProvider() {
+	case CommandProvider:
+		return &commandProvider{settings: settings}, nil
+	case RsyncProvider:
+		return &rsyncProvider{dir: filepath.Join(backupDir, "snapshots")}, nil
+	}
+
+	return nil, xerrors.Errorf("invalid snapshot provider %q", settings.GetProvider())
+}
+
+// Run runs the action on each directory in parallel. The output of each
+// directory is buffered so that it is not interleaved, and the stderr of a
+// failed action is included in its error.
+func Run(streams step.OutStreams, provider Provider, action idl.SnapshotDirectoriesRequest_Action, dirs []*idl.SnapshotDirectory) error {
+	var f func(step.OutStreams, *idl.SnapshotDirectory) error
+	switch action {
+	case idl.SnapshotDirectoriesRequest_snapshot:
+		f = provider.Snapshot
+	case idl.SnapshotDirectoriesRequest_restore:
+		f = provider.Restore
+	case idl.SnapshotDirectoriesRequest_delete:
+		f = provider.Delete
+	default:
+		return xerrors.Errorf("unknown snapshot action %q", action)
+	}
+
+	type result struct {
+		stdout bytes.Buffer
+		stderr bytes.Buffer
+		err    error
+	}
+
+	var wg sync.WaitGroup
+	results := make(chan *result, len(dirs))
+
+	for _, dir := range dirs {
+		dir := dir
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			stream := &step.BufferedStreams{}
+			err := f(stream, dir)
+			if stderr := strings.TrimSpace(stream.StderrBuf.String()); err != nil && stderr != "" {
+				err = xerrors.Errorf("%s snapshot %q of %q: %s: %w", action, dir.GetName(), dir.GetPath(), stderr, err)
+			} else if err != nil {
+				err = xerrors.Errorf("%s snapshot %q of %q: %w", action, dir.GetName(), dir.GetPath(), err)
+			}
+
+			results <- &result{stdout: stream.StdoutBuf, stderr: stream.StderrBuf, err: err}
+		}()
+	}
+
+	wg.Wait()
+	close(results)
+
+	var errs error
+	for result := range results {
+		if _, err := io.Copy(streams.Stdout(), &result.stdout); err != nil {
+			errs = errorlist.Append(errs, err)
+		}
+
+		if _, err := io.Copy(streams.Stderr(), &result.stderr); err != nil {
+			errs = errorlist.Append(errs, err)
+		}
+
+		if result.err != nil {
+			errs = errorlist.Append(errs, result.err)
+		}
+	}
+
+	return errs
+}
+
+var bashCommand = exec.Command
+
+// XXX: for internal testing only
+func SetBashCommand(command exectest.Command) {
+	bashCommand = command
+}
+
+// XXX: for internal testing only
+func ResetBashCommand() {
+	bashCommand = exec.Command
+}
+
+// commandProvider runs a command for each directory such as a ZFS or LVM
+// snapshot. The delete command is optional.
+type commandProvider struct {
+	settings *idl.SnapshotSettings
+}
+
+func (p *commandProvider) Snapshot(streams step.OutStreams, dir *idl.SnapshotDirectory) error {
+	return p.run(streams, p.settings.GetSnapshotCommand(), dir)
+}
+
+func (p *commandProvider) Restore(streams step.OutStreams, dir *idl.SnapshotDirectory) error {
+	return p.run(streams, p.settings.GetRestoreCommand(), dir)
+}
+
+func (p *commandProvider) Delete(streams step.OutStreams, dir *idl.SnapshotDirectory) error {
+	if p.settings.GetDeleteCommand() == "" {
+		return nil
+	}
+
+	return p.run(streams, p.settings.GetDeleteCommand(), dir)
+}
+
+func (p *commandProvider) run(streams step.OutStreams, command string, dir *idl.SnapshotDirectory) error {
+	cmd := bashCommand("bash", "-c", command)
+	cmd.Env = append(os.Environ(),
+		fmt.Sprintf("%s=%s", DirectoryEnv, dir.GetPath()),
+		fmt.Sprintf("%s=%s", NameEnv, dir.GetName()),
+	)
+	cmd.Stdout = streams.Stdout()
+	cmd.Stderr = streams.Stderr()
+
+	return cmd.Run()
+}
+
+// rsyncProvider copies each directory into the backup directory. It requires
+// enough disk space for a full copy of the directories.
+type rsyncProvider struct {
+	dir string
+}
+
+func (p *rsyncProvider) Snapshot(streams step.OutStreams, dir *idl.SnapshotDirectory) error {
+	return rsync.Rsync(
+		rsync.WithSources(dir.GetPath()+string(os.PathSeparator)),
+		rsync.WithDestination(filepath.Join(p.dir, dir.GetName())),
+		rsync.WithOptions("--archive", "--delete"),
+		rsync.WithStream(streams),
+	)
+}
+
+func (p *rsyncProvider) Restore(streams step.OutStreams, dir *idl.SnapshotDirectory) error {
+	return rsync.Rsync(
+		rsync.WithSources(filepath.Join(p.dir, dir.GetName())+string(os.PathSeparator)),
+		rsync.WithDestination(dir.GetPath()),
+		rsync.WithOptions("--archive", "--delete"),
+		rsync.WithStream(streams),
+	)
+}
+
+func (p *rsyncProvider) Delete(_ step.OutStreams, dir *idl.SnapshotDirectory) error {
+	return utils.System.RemoveAll(filepath.Join(p.dir, dir.GetName()))
+}
@@ -0,0 +1,274 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package snapshot_test
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/idl"
+	"github.com/greenplum-db/gpupgrade/step"
+	"github.com/greenplum-db/gpupgrade/testutils"
+	"github.com/greenplum-db/gpupgrade/testutils/exectest"
+	"github.com/greenplum-db/gpupgrade/upgrade/snapshot"
+	"github.com/greenplum-db/gpupgrade/utils/errorlist"
+	"github.com/greenplum-db/gpupgrade/utils/rsync"
+)
+
+func PrintEnvironment() {
+	fmt.Printf("%s %s", os.Getenv(snapshot.DirectoryEnv), os.Getenv(snapshot.NameEnv))
+}
+
+func FailWithStderr() {
+	fmt.Fprint(os.Stderr, "no space left on device")
+	os.Exit(1)
+}
+
+func init() {
+	exectest.RegisterMains(
+		PrintEnvironment,
+		FailWithStderr,
+	)
+}
+
+func TestMain(m *testing.M) {
+	os.Exit(exectest.Run(m))
+}
+
+func TestSettingsValidate(t *testing.T) {
+	cases := []struct {
+		name     string
+		settings snapshot.Settings
+		valid    bool
+	}{
+		{"no provider", snapshot.Settings{}, true},
+		{"rsync", snapshot.Settings{Provider: snapshot.RsyncProvider}, true},
+		{"command", snapshot.Settings{Provider: snapshot.CommandProvider, SnapshotCommand: "snap", RestoreCommand: "restore"}, true},
+		{"command without a restore command", snapshot.Settings{Provider: snapshot.CommandProvider, SnapshotCommand: "snap"}, false},
+		{"command without a snapshot command", snapshot.Settings{Provider: snapshot.CommandProvider, RestoreCommand: "restore"}, false},
+		{"unknown provider", snapshot.Settings{Provider: "zfs"}, false},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := c.settings.Validate()
+			if c.valid && err != nil {
+				t.Errorf("unexpected error %#v", err)
+			}
+
+			if !c.valid && err == nil {
+				t.Errorf("expected an error")
+			}
+		})
+	}
+}
+
+func TestCommandProvider(t *testing.T) {
+	settings := &idl.SnapshotSettings{
+		Provider:        snapshot.CommandProvider,
+		SnapshotCommand: "snapshot.sh create",
+		RestoreCommand:  "snapshot.sh restore",
+		DeleteCommand:   "snapshot.sh delete",
+	}
+
+	dir := &idl.SnapshotDirectory{Path: "/data/primary/seg0", Name: "datadir-2"}
+
+	cases := []struct {
+		name     string
+		action   idl.SnapshotDirectoriesRequest_Action
+		expected string
+	}{
+		{"snapshot", idl.SnapshotDirectoriesRequest_snapshot, "snapshot.sh create"},
+		{"restore", idl.SnapshotDirectoriesRequest_restore, "snapshot.sh restore"},
+		{"delete", idl.SnapshotDirectoriesRequest_delete, "snapshot.sh delete"},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("runs the %s command with the directory in the environment", c.name), func(t *testing.T) {
+			var args []string
+			snapshot.SetBashCommand(exectest.NewCommandWithVerifier(PrintEnvironment, func(name string, arg ...string) {
+				args = append([]string{name}, arg...)
+			}))
+			defer snapshot.ResetBashCommand()
+
+			provider, err := snapshot.NewProvider(settings, "")
+			if err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+
+			streams := &step.BufferedStreams{}
+			err = snapshot.Run(streams, provider, c.action, []*idl.SnapshotDirectory{dir})
+			if err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+
+			expected := []string{"bash", "-c", c.expected}
+			if !reflect.DeepEqual(args, expected) {
+				t.Errorf("got %q want %q", args, expected)
+			}
+
+			if streams.StdoutBuf.String() != "/data/primary/seg0 datadir-2" {
+				t.Errorf("got environment %q", streams.StdoutBuf.String())
+			}
+		})
+	}
+
+	t.Run("does not delete when there is no delete command", func(t *testing.T) {
+		snapshot.SetBashCommand(exectest.NewCommandWithVerifier(exectest.Failure, func(string, ...string) {
+			t.Errorf("expected no command to be run")
+		}))
+		defer snapshot.ResetBashCommand()
+
+		provider, err := snapshot.NewProvider(&idl.SnapshotSettings{Provider: snapshot.CommandProvider}, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		err = snapshot.Run(step.DevNullStream, provider, idl.SnapshotDirectoriesRequest_delete, []*idl.SnapshotDirectory{dir})
+		if err != nil {
+			t.Errorf("unexpected error %#v", err)
+		}
+	})
+
+	t.Run("returns an error for each directory that failed", func(t *testing.T) {
+		snapshot.SetBashCommand(exectest.NewCommand(exectest.Failure))
+		defer snapshot.ResetBashCommand()
+
+		provider, err := snapshot.NewProvider(settings, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		dirs := []*idl.SnapshotDirectory{dir, {Path: "/data/primary/seg1", Name: "datadir-3"}}
+		err = snapshot.Run(step.DevNullStream, provider, idl.SnapshotDirectoriesRequest_snapshot, dirs)
+
+		var errs errorlist.Errors
+		if !errors.As(err, &errs) {
+			t.Fatalf("got error %#v want type %T", err, errs)
+		}
+
+		if len(errs) != len(dirs) {
+			t.Fatalf("got %d errors want %d", len(errs), len(dirs))
+		}
+
+		for _, err := range errs {
+			var exitErr *exec.ExitError
+			if !errors.As(err, &exitErr) {
+				t.Errorf("got error %#v want type %T", err, exitErr)
+			}
+		}
+	})
+
+	t.Run("includes the stderr of the failed command in the error", func(t *testing.T) {
+		snapshot.SetBashCommand(exectest.NewCommand(FailWithStderr))
+		defer snapshot.ResetBashCommand()
+
+		provider, err := snapshot.NewProvider(settings, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		streams := &step.BufferedStreams{}
+		err = snapshot.Run(streams, provider, idl.SnapshotDirectoriesRequest_snapshot, []*idl.SnapshotDirectory{dir})
+
+		var exitErr *exec.ExitError
+		if !errors.As(err, &exitErr) {
+			t.Errorf("got error %#v want type %T", err, exitErr)
+		}
+
+		if !strings.Contains(err.Error(), ": no space left on device: ") {
+			t.Errorf("expected error %q to contain the stderr", err)
+		}
+
+		if streams.StderrBuf.String() != "no space left on device" {
+			t.Errorf("got stderr %q", streams.StderrBuf.String())
+		}
+	})
+}
+
+func TestRsyncProvider(t *testing.T) {
+	backupDir := testutils.GetTempDir(t, "")
+	defer testutils.MustRemoveAll(t, backupDir)
+
+	settings := &idl.SnapshotSettings{Provider: snapshot.RsyncProvider}
+	dir := &idl.SnapshotDirectory{Path: "/data/primary/seg0", Name: "datadir-2"}
+	snapshotDir := filepath.Join(backupDir, "snapshots", "datadir-2")
+
+	cases := []struct {
+		name     string
+		action   idl.SnapshotDirectoriesRequest_Action
+		expected []string
+	}{
+		{"snapshot", idl.SnapshotDirectoriesRequest_snapshot, []string{"--archive", "--delete", "/data/primary/seg0/", snapshotDir}},
+		{"restore", idl.SnapshotDirectoriesRequest_restore, []string{"--archive", "--delete", snapshotDir + "/", "/data/primary/seg0"}},
+	}
+
+	for _, c := range cases {
+		t.Run(fmt.Sprintf("rsyncs for %s", c.name), func(t *testing.T) {
+			var args []string
+			rsync.SetRsyncCommand(exectest.NewCommandWithVerifier(exectest.Success, func(_ string, arg ...string) {
+				args = arg
+			}))
+			defer rsync.SetRsyncCommand(exec.Command)
+
+			provider, err := snapshot.NewProvider(settings, backupDir)
+			if err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+
+			err = snapshot.Run(step.DevNullStream, provider, c.action, []*idl.SnapshotDirectory{dir})
+			if err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+
+			if !reflect.DeepEqual(args, c.expected) {
+				t.Errorf("got %q want %q", args, c.expected)
+			}
+		})
+	}
+
+	t.Run("deletes the snapshot", func(t *testing.T) {
+		testutils.MustCreateDir(t, snapshotDir)
+		testutils.MustWriteToFile(t, filepath.Join(snapshotDir, "PG_VERSION"), "")
+
+		provider, err := snapshot.NewProvider(settings, backupDir)
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		err = snapshot.Run(step.DevNullStream, provider, idl.SnapshotDirectoriesRequest_delete, []*idl.SnapshotDirectory{dir})
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		testutils.PathMustNotExist(t, snapshotDir)
+	})
+}
+
+func TestRun(t *testing.T) {
+	t.Run("errors for an unknown action", func(t *testing.T) {
+		provider, err := snapshot.NewProvider(&idl.SnapshotSettings{Provider: snapshot.RsyncProvider}, "")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		err = snapshot.Run(step.DevNullStream, provider, idl.SnapshotDirectoriesRequest_unknown_action, nil)
+		if err == nil {
+			t.Errorf("expected an error")
+		}
+	})
+}
+
+func TestNewProvider(t *testing.T) {
+	_, err := snapshot.NewProvider(&idl.SnapshotSettings{Provider: "zfs"}, "")
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -0,0 +1,87 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package utils
+
+import (
+	"fmt"
+	"hash/fnv"
+	"os"
+	"path/filepath"
+	"regexp"
+)
+
+// ClusterNameEnv selects an independent gpupgrade instance such that several
+// clusters owned by the same user can be upgraded on the same hosts. The state
+// directory, log directory, backup directories, and default hub and agent
+// ports are namespaced by the cluster name. When unset the default instance is
+// used.
+const ClusterNameEnv = "GPUPGRADE_CLUSTER_NAME"
+
+// ClusterNameFlag is passed to the hub and agents so that their processes can
+// be found for a particular cluster.
+const ClusterNameFlag = "cluster-name"
+
+// clusterPortRange is the number of hub and agent ports used by named
+// clusters. It is kept small enough such that their agent ports do not overlap
+// their hub ports.
+const clusterPortRange = 1000
+
+var validClusterName = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
+
+func GetClusterName() string {
+	return os.Getenv(ClusterNameEnv)
+}
+
+func ValidateClusterName(name string) error {
+	if !validClusterName.MatchString(name) {
+		return fmt.Errorf("invalid cluster name %q: must contain only letters, digits, underscores, or dashes", name)
+	}
+
+	return nil
+}
+
+// Namespace suffixes name with the selected cluster name, if any.
+func Namespace(name string) string {
+	clusterName := GetClusterName()
+	if clusterName == "" {
+		return name
+	}
+
+	return name + "-" + clusterName
+}
+
+// ClusterPortOffset returns a stable offset into the hub and agent ports of
+// named clusters for the selected cluster, or zero for the default instance.
+// Different names may still hash to the same offset which initialize detects
+// using OtherStateDirs.
+func ClusterPortOffset() int {
+	clusterName := GetClusterName()
+	if clusterName == "" {
+		return 0
+	}
+
+	hash := fnv.New32a()
+	hash.Write([]byte(clusterName)) //nolint:errcheck // never returns an error
+	return int(hash.Sum32()%clusterPortRange) + 1
+}
+
+// OtherStateDirs returns the state directories of the default instance and
+// the named clusters other than the selected cluster, whether or not they
+// exist.
+func OtherStateDirs() ([]string, error) {
+	base := filepath.Clean(baseStateDir())
+	named, err := filepath.Glob(base + "-*")
+	if err != nil {
+		return nil, err
+	}
+
+	var dirs []string
+	for _, dir := range append([]string{base}, named...) {
+		if dir != filepath.Clean(GetStateDir()) {
+			dirs = append(dirs, dir)
+		}
+	}
+
+	return dirs, nil
+}
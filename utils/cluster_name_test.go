@@ -0,0 +1,75 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package utils_test
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/utils"
+)
+
+func TestValidateClusterName(t *testing.T) {
+	for _, name := range []string{"prod", "gp6_to_gp7", "cluster-2"} {
+		if err := utils.ValidateClusterName(name); err != nil {
+			t.Errorf("unexpected error %#v for %q", err, name)
+		}
+	}
+
+	for _, name := range []string{"", "a/b", "has space", "../up", "quote\""} {
+		if err := utils.ValidateClusterName(name); err == nil {
+			t.Errorf("expected an error for %q", name)
+		}
+	}
+}
+
+func TestNamespace(t *testing.T) {
+	t.Run("leaves the default cluster unchanged", func(t *testing.T) {
+		t.Setenv(utils.ClusterNameEnv, "")
+		t.Setenv("GPUPGRADE_HOME", "/home/gpadmin/.gpupgrade")
+
+		if stateDir := utils.GetStateDir(); stateDir != "/home/gpadmin/.gpupgrade" {
+			t.Errorf("got state dir %q", stateDir)
+		}
+
+		if offset := utils.ClusterPortOffset(); offset != 0 {
+			t.Errorf("got port offset %d want 0", offset)
+		}
+	})
+
+	t.Run("suffixes the state and log directories with the cluster name", func(t *testing.T) {
+		t.Setenv(utils.ClusterNameEnv, "prod")
+		t.Setenv("GPUPGRADE_HOME", "/home/gpadmin/.gpupgrade")
+
+		if stateDir := utils.GetStateDir(); stateDir != "/home/gpadmin/.gpupgrade-prod" {
+			t.Errorf("got state dir %q", stateDir)
+		}
+
+		logDir, err := utils.GetLogDir()
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		if filepath.Base(logDir) != "gpupgrade-prod" {
+			t.Errorf("got log dir %q", logDir)
+		}
+	})
+
+	t.Run("offsets the ports by a stable amount per cluster name", func(t *testing.T) {
+		t.Setenv(utils.ClusterNameEnv, "prod")
+		prod := utils.ClusterPortOffset()
+		if prod < 1 || prod > 1000 {
+			t.Errorf("got port offset %d want between 1 and 1000", prod)
+		}
+
+		if again := utils.ClusterPortOffset(); again != prod {
+			t.Errorf("got port offset %d want %d", again, prod)
+		}
+
+		t.Setenv(utils.ClusterNameEnv, "staging")
+		if staging := utils.ClusterPortOffset(); staging == prod {
+			t.Errorf("expected clusters prod and staging to have different port offsets, both got %d", prod)
+		}
+	})
+}
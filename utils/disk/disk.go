@@ -69,24 +69,9 @@ func CheckUsage(streams step.OutStreams, d Disk, diskFreeRatio float64, paths ..
 
 	// Find the device ID for every filesystem. We'll use these to map data
 	// directories to filesystems later.
-	fs, err := d.Filesystems()
+	fsByID, err := filesystemsByDevice(d, hostname)
 	if err != nil {
-		return nil, xerrors.Errorf("enumerating filesystems: %w", err)
-	}
-
-	fsByID := make(map[uint64]string)
-	for _, f := range fs.List {
-		stat, err := d.Stat(f.DirName)
-		if os.IsPermission(err) {
-			log.Printf("Ignoring filesystem %s on host %s when checking disk space. Unable to stat filesystem due to %v.", f.DirName, hostname, err)
-			continue
-		}
-
-		if err != nil {
-			return nil, xerrors.Errorf("stat'ing %s: %w", f.DirName, err)
-		}
-
-		fsByID[uint64(stat.Dev)] = f.DirName
+		return nil, err
 	}
 
 	for _, path := range paths {
@@ -104,16 +89,9 @@ func CheckUsage(streams step.OutStreams, d Disk, diskFreeRatio float64, paths ..
 
 		if usage.Avail < required {
 			// Get the filesystem that this path belongs to.
-			stat, err := d.Stat(path)
+			fs, err := filesystemOf(d, fsByID, path)
 			if err != nil {
-				return nil, xerrors.Errorf("stat'ing %s: %w", path, err)
-			}
-
-			fs, ok := fsByID[uint64(stat.Dev)]
-			if !ok {
-				// Rather than blow up if we can't associate a path with a
-				// filesystem, just use the path itself.
-				fs = path
+				return nil, err
 			}
 
 			failures[fs] = &idl.CheckDiskSpaceReply_DiskUsage{
@@ -136,6 +114,100 @@ func CheckUsage(streams step.OutStreams, d Disk, diskFreeRatio float64, paths ..
 	return usage, nil
 }
 
+type UsagesType func(d Disk, paths ...string) ([]*idl.GetDiskUsageReply_FilesystemUsage, error)
+
+// Usages returns the used, available, and total space of each filesystem
+// holding the paths along with the paths it holds. Like CheckUsage it excludes
+// space reserved for the superuser such that the total is the sum of the used
+// and available space.
+func Usages(d Disk, paths ...string) ([]*idl.GetDiskUsageReply_FilesystemUsage, error) {
+	hostname, err := utils.System.Hostname()
+	if err != nil {
+		return nil, xerrors.Errorf("determining hostname: %w", err)
+	}
+
+	fsByID, err := filesystemsByDevice(d, hostname)
+	if err != nil {
+		return nil, err
+	}
+
+	var usages []*idl.GetDiskUsageReply_FilesystemUsage
+	usageByFs := make(map[string]*idl.GetDiskUsageReply_FilesystemUsage)
+	for _, path := range paths {
+		fs, err := filesystemOf(d, fsByID, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if usage, ok := usageByFs[fs]; ok {
+			usage.Dirs = append(usage.Dirs, path)
+			continue
+		}
+
+		usage, err := d.Usage(path)
+		if err != nil {
+			return nil, xerrors.Errorf("getting fs usage for %s: %w", path, err)
+		}
+
+		fsUsage := &idl.GetDiskUsageReply_FilesystemUsage{
+			Fs:        fs,
+			Host:      hostname,
+			Used:      usage.Used,
+			Available: usage.Avail,
+			Total:     usage.Used + usage.Avail,
+			Dirs:      []string{path},
+		}
+
+		usageByFs[fs] = fsUsage
+		usages = append(usages, fsUsage)
+	}
+
+	return usages, nil
+}
+
+// filesystemsByDevice returns the mount point of each filesystem by its device
+// ID ignoring filesystems that cannot be accessed.
+func filesystemsByDevice(d Disk, hostname string) (map[uint64]string, error) {
+	fs, err := d.Filesystems()
+	if err != nil {
+		return nil, xerrors.Errorf("enumerating filesystems: %w", err)
+	}
+
+	fsByID := make(map[uint64]string)
+	for _, f := range fs.List {
+		stat, err := d.Stat(f.DirName)
+		if os.IsPermission(err) {
+			log.Printf("Ignoring filesystem %s on host %s when checking disk space. Unable to stat filesystem due to %v.", f.DirName, hostname, err)
+			continue
+		}
+
+		if err != nil {
+			return nil, xerrors.Errorf("stat'ing %s: %w", f.DirName, err)
+		}
+
+		fsByID[uint64(stat.Dev)] = f.DirName
+	}
+
+	return fsByID, nil
+}
+
+// filesystemOf returns the mount point of the filesystem holding the path.
+func filesystemOf(d Disk, fsByID map[uint64]string, path string) (string, error) {
+	stat, err := d.Stat(path)
+	if err != nil {
+		return "", xerrors.Errorf("stat'ing %s: %w", path, err)
+	}
+
+	fs, ok := fsByID[uint64(stat.Dev)]
+	if !ok {
+		// Rather than blow up if we can't associate a path with a
+		// filesystem, just use the path itself.
+		fs = path
+	}
+
+	return fs, nil
+}
+
 // Local is a standard implementation of the Disk interface that uses gosigar
 // and unix.Stat to obtain statistics for the local machine.
 var Local = local{}
@@ -347,6 +347,76 @@ func TestCheckUsage(t *testing.T) {
 	})
 }
 
+func TestUsages(t *testing.T) {
+	testlog.SetupTestLogger()
+
+	host := "localhost"
+	utils.System.Hostname = func() (string, error) {
+		return host, nil
+	}
+	defer func() {
+		utils.System.Hostname = os.Hostname
+	}()
+
+	d := testDisk{
+		err: errors.New("should never happen"),
+
+		filesystems: func() (sigar.FileSystemList, error) {
+			return sigar.FileSystemList{List: []sigar.FileSystem{
+				{DirName: "/"},
+				{DirName: "/tmp"},
+			}}, nil
+		},
+
+		usage: func(path string) (sigar.FileSystemUsage, error) {
+			// 10 bytes are reserved for the superuser.
+			if strings.HasPrefix(path, "/tmp") {
+				return sigar.FileSystemUsage{Total: 110, Used: 100, Free: 10, Avail: 0}, nil
+			}
+
+			return sigar.FileSystemUsage{Total: 110, Used: 0, Free: 110, Avail: 100}, nil
+		},
+
+		stat: func(path string) (*unix.Stat_t, error) {
+			stat := new(unix.Stat_t)
+			stat.Dev = 1
+			if strings.HasPrefix(path, "/tmp") {
+				stat.Dev = 2
+			}
+
+			return stat, nil
+		},
+	}
+
+	t.Run("returns the usage of each filesystem including full and empty ones", func(t *testing.T) {
+		usages, err := disk.Usages(d, "/data/seg1", "/tmp/seg2", "/data/seg3")
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := []*idl.GetDiskUsageReply_FilesystemUsage{
+			{Fs: "/", Host: host, Used: 0, Available: 100, Total: 100, Dirs: []string{"/data/seg1", "/data/seg3"}},
+			{Fs: "/tmp", Host: host, Used: 100, Available: 0, Total: 100, Dirs: []string{"/tmp/seg2"}},
+		}
+		if !reflect.DeepEqual(usages, expected) {
+			t.Errorf("got %v want %v", usages, expected)
+		}
+	})
+
+	t.Run("errors when failing to get the usage", func(t *testing.T) {
+		expected := errors.New("permission denied")
+		failing := d
+		failing.usage = func(string) (sigar.FileSystemUsage, error) {
+			return sigar.FileSystemUsage{}, expected
+		}
+
+		_, err := disk.Usages(failing, "/data/seg1")
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v want %#v", err, expected)
+		}
+	})
+}
+
 func TestLocal(t *testing.T) {
 	// disk.Local is a passthrough to more complicated implementations. Rather
 	// than duplicate the tests for those implementations, just verify simple
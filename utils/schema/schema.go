@@ -0,0 +1,138 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+// Package schema versions the gpupgrade state files such as config.json and
+// substeps.json. The gpupgrade RPM may be upgraded between initialize and
+// finalize, for example to get a bug fix, in which case state files written
+// by the older gpupgrade are migrated to the current format when read.
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"golang.org/x/xerrors"
+)
+
+// VersionKey is the top level key holding the schema version of a state file.
+const VersionKey = "SchemaVersion"
+
+// Migration upgrades a decoded state file by a single schema version. For
+// example, by renaming a field or setting a default for a new field.
+type Migration func(doc map[string]any) error
+
+// Schema is the versioned format of a state file. Migrations[i] upgrades a file
+// from schema version i to i+1, where version 0 is a file written before
+// schema versions were introduced. Thus, the current version is the number of
+// migrations. Migrations must never be removed or reordered.
+//
+// State files such as durations.json were originally written as a JSON list.
+// For those ListKey is the top level key the list is moved under when
+// migrating them from version 0.
+type Schema struct {
+	File       string
+	Migrations []Migration
+	ListKey    string
+}
+
+// Version returns the current schema version.
+func (s Schema) Version() int {
+	return len(s.Migrations)
+}
+
+// AddVersion upgrades files written before schema versions were introduced.
+// The format is otherwise unchanged.
+func AddVersion(_ map[string]any) error {
+	return nil
+}
+
+var ErrDowngrade = errors.New("state file was written by a newer version of gpupgrade")
+
+type DowngradeError struct {
+	File      string
+	Version   int
+	Supported int
+}
+
+func (d *DowngradeError) Error() string {
+	return fmt.Sprintf(`%s has schema version %d which was written by a newer version of gpupgrade. This version of gpupgrade supports up to schema version %d.
+Downgrading gpupgrade during an upgrade is not supported. Reinstall the newer version of gpupgrade to continue.`, d.File, d.Version, d.Supported)
+}
+
+func (d *DowngradeError) Is(err error) bool {
+	return err == ErrDowngrade
+}
+
+// Migrate upgrades the contents of a state file to the current schema version.
+// Files from a newer schema version are refused with a DowngradeError.
+func (s Schema) Migrate(contents []byte) ([]byte, error) {
+	// Use json.Number to preserve large integers such as oids.
+	decoder := json.NewDecoder(bytes.NewReader(contents))
+	decoder.UseNumber()
+
+	var value any
+	err := decoder.Decode(&value)
+	if err != nil {
+		return nil, xerrors.Errorf("unmarshal %s: %w", s.File, err)
+	}
+
+	var doc map[string]any
+	switch value := value.(type) {
+	case nil:
+		doc = make(map[string]any)
+	case map[string]any:
+		doc = value
+	case []any:
+		if s.ListKey == "" {
+			return nil, xerrors.Errorf("unmarshal %s: expected an object but found a list", s.File)
+		}
+
+		doc = map[string]any{s.ListKey: value}
+	default:
+		return nil, xerrors.Errorf("unmarshal %s: expected an object but found %v", s.File, value)
+	}
+
+	version, err := s.versionOf(doc)
+	if err != nil {
+		return nil, err
+	}
+
+	if version > s.Version() {
+		return nil, &DowngradeError{File: s.File, Version: version, Supported: s.Version()}
+	}
+
+	if version == s.Version() {
+		return contents, nil
+	}
+
+	for v := version; v < s.Version(); v++ {
+		err = s.Migrations[v](doc)
+		if err != nil {
+			return nil, xerrors.Errorf("migrate %s from schema version %d to %d: %w", s.File, v, v+1, err)
+		}
+	}
+
+	doc[VersionKey] = s.Version()
+	return json.Marshal(doc)
+}
+
+func (s Schema) versionOf(doc map[string]any) (int, error) {
+	value, ok := doc[VersionKey]
+	if !ok {
+		return 0, nil
+	}
+
+	number, ok := value.(json.Number)
+	if !ok {
+		return 0, xerrors.Errorf("invalid %s %v in %s", VersionKey, value, s.File)
+	}
+
+	version, err := number.Int64()
+	if err != nil || version < 0 {
+		return 0, xerrors.Errorf("invalid %s %v in %s", VersionKey, value, s.File)
+	}
+
+	return int(version), nil
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2017-2023 VMware, Inc. or its affiliates
+// SPDX-License-Identifier: Apache-2.0
+
+package schema_test
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/greenplum-db/gpupgrade/utils/schema"
+)
+
+func TestMigrate(t *testing.T) {
+	s := schema.Schema{
+		File: "state.json",
+		Migrations: []schema.Migration{
+			schema.AddVersion,
+			func(doc map[string]any) error {
+				doc["Renamed"] = doc["Original"]
+				delete(doc, "Original")
+				return nil
+			},
+			func(doc map[string]any) error {
+				if _, ok := doc["Added"]; !ok {
+					doc["Added"] = "default"
+				}
+				return nil
+			},
+		},
+	}
+
+	cases := []struct {
+		name     string
+		contents string
+		expected map[string]any
+	}{
+		{
+			name:     "migrates files written before schema versions were introduced",
+			contents: `{"Original": 12345678901234567}`,
+			expected: map[string]any{"Renamed": json.Number("12345678901234567"), "Added": "default", "SchemaVersion": json.Number("3")},
+		},
+		{
+			name:     "applies only the migrations after the version of the file",
+			contents: `{"SchemaVersion": 2, "Original": "kept"}`,
+			expected: map[string]any{"Original": "kept", "Added": "default", "SchemaVersion": json.Number("3")},
+		},
+		{
+			name:     "leaves current files unchanged",
+			contents: `{"SchemaVersion": 3, "Renamed": "value", "Added": "value"}`,
+			expected: map[string]any{"Renamed": "value", "Added": "value", "SchemaVersion": json.Number("3")},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			contents, err := s.Migrate([]byte(c.contents))
+			if err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+
+			decoder := json.NewDecoder(strings.NewReader(string(contents)))
+			decoder.UseNumber()
+
+			var actual map[string]any
+			err = decoder.Decode(&actual)
+			if err != nil {
+				t.Fatalf("unexpected error %#v", err)
+			}
+
+			if !reflect.DeepEqual(actual, c.expected) {
+				t.Errorf("got %v want %v", actual, c.expected)
+			}
+		})
+	}
+
+	t.Run("refuses files written by a newer version of gpupgrade", func(t *testing.T) {
+		_, err := s.Migrate([]byte(`{"SchemaVersion": 4}`))
+		if !errors.Is(err, schema.ErrDowngrade) {
+			t.Errorf("got error %#v want %#v", err, schema.ErrDowngrade)
+		}
+
+		var downgradeErr *schema.DowngradeError
+		if !errors.As(err, &downgradeErr) || downgradeErr.Version != 4 || downgradeErr.Supported != 3 {
+			t.Errorf("got error %#v want a DowngradeError from version 4 to 3", err)
+		}
+	})
+
+	t.Run("errors on an invalid schema version", func(t *testing.T) {
+		_, err := s.Migrate([]byte(`{"SchemaVersion": "one"}`))
+		if err == nil || !strings.Contains(err.Error(), "invalid SchemaVersion") {
+			t.Errorf("got error %#v want invalid SchemaVersion", err)
+		}
+	})
+
+	t.Run("moves a list written before schema versions were introduced under the list key", func(t *testing.T) {
+		list := schema.Schema{File: "list.json", Migrations: []schema.Migration{schema.AddVersion}, ListKey: "Items"}
+
+		contents, err := list.Migrate([]byte(`[{"Name": "a"}, {"Name": "b"}]`))
+		if err != nil {
+			t.Fatalf("unexpected error %#v", err)
+		}
+
+		expected := `{"Items":[{"Name":"a"},{"Name":"b"}],"SchemaVersion":1}`
+		if string(contents) != expected {
+			t.Errorf("got %s want %s", contents, expected)
+		}
+	})
+
+	t.Run("errors on a list when the schema has no list key", func(t *testing.T) {
+		_, err := s.Migrate([]byte(`[]`))
+		if err == nil || !strings.Contains(err.Error(), "expected an object") {
+			t.Errorf("got error %#v want expected an object", err)
+		}
+	})
+
+	t.Run("errors when a migration fails", func(t *testing.T) {
+		expected := errors.New("permission denied")
+		failing := schema.Schema{File: "state.json", Migrations: []schema.Migration{
+			func(doc map[string]any) error { return expected },
+		}}
+
+		_, err := failing.Migrate([]byte(`{}`))
+		if !errors.Is(err, expected) {
+			t.Errorf("got error %#v want %#v", err, expected)
+		}
+	})
+}
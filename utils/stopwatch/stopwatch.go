@@ -33,8 +33,21 @@ func (s *Stopwatch) Stop() *Stopwatch {
 	return s
 }
 
+func (s *Stopwatch) StartTime() time.Time {
+	return s.startTime
+}
+
+func (s *Stopwatch) Elapsed() time.Duration {
+	return s.elapsedTime
+}
+
 func (s *Stopwatch) String() string {
-	return round(s.elapsedTime).String()
+	return Format(s.elapsedTime)
+}
+
+// Format rounds the duration the same way as a Stopwatch.
+func Format(duration time.Duration) string {
+	return round(duration).String()
 }
 
 // round returns a pretty-printable duration. This may omit precision and
@@ -99,6 +99,17 @@ func ResetSystemFunctions() {
 }
 
 func GetStateDir() string {
+	return Namespace(baseStateDir())
+}
+
+// GetHistoryDir returns the directory of the upgrade history. It is next to
+// the state directory since finalize and revert delete the state directory,
+// and is shared by all clusters.
+func GetHistoryDir() string {
+	return filepath.Join(filepath.Dir(filepath.Clean(baseStateDir())), ".gpupgrade_history")
+}
+
+func baseStateDir() string {
 	stateDir := os.Getenv("GPUPGRADE_HOME")
 	if stateDir == "" {
 		stateDir = filepath.Join(os.Getenv("HOME"), ".gpupgrade")
@@ -113,7 +124,7 @@ func GetLogDir() (string, error) {
 		return "", err
 	}
 
-	logDir := filepath.Join(currentUser.HomeDir, "gpAdminLogs", "gpupgrade")
+	logDir := filepath.Join(currentUser.HomeDir, "gpAdminLogs", Namespace("gpupgrade"))
 	return logDir, nil
 }
 